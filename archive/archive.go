@@ -0,0 +1,165 @@
+// Package archive stores the rows purged from the database tables in gzip
+// compressed NDJSON files, one file per table per day, and loads them back.
+//
+// The files are named <directory>/<table>/<table>-<YYYY-MM-DD>.ndjson.gz,
+// the day being the date of the row retention column. Each archived batch
+// is appended to the file as a new gzip member, so a file is a valid
+// multi-member gzip stream readable by gzip -dc.
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+)
+
+type (
+	// Sink writes archived rows under Dir.
+	Sink struct {
+		Dir string
+	}
+)
+
+const (
+	dayLayout      = "2006-01-02"
+	datetimeLayout = "2006-01-02 15:04:05.999999"
+	zeroDatetime   = "0000-00-00 00:00:00"
+
+	fileSuffix = ".ndjson.gz"
+)
+
+var (
+	validTableName = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+)
+
+// ValidateTableName returns an error if name is not usable as a table name
+// in a query or as a file name.
+func ValidateTableName(name string) error {
+	if !validTableName.MatchString(name) {
+		return fmt.Errorf("invalid table name: %q", name)
+	}
+	return nil
+}
+
+// Path returns the archive file of a table day.
+func Path(dir, table string, day time.Time) string {
+	return filepath.Join(dir, table, table+"-"+day.Format(dayLayout)+fileSuffix)
+}
+
+// Write appends rows to the archive file of the table day, and syncs the
+// file to disk before returning.
+func (s *Sink) Write(table string, day time.Time, rows []map[string]any) error {
+	if err := ValidateTableName(table); err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	enc := json.NewEncoder(zw)
+	for _, row := range rows {
+		if err := enc.Encode(encodeRow(row)); err != nil {
+			return fmt.Errorf("encode %s row: %w", table, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	tableDir := filepath.Join(s.Dir, table)
+	if err := os.MkdirAll(tableDir, 0750); err != nil {
+		return err
+	}
+	filename := Path(s.Dir, table, day)
+	_, statErr := os.Stat(filename)
+	isNew := errors.Is(statErr, os.ErrNotExist)
+
+	f, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0640)
+	if err != nil {
+		return err
+	}
+	// write the gzip member at once, to limit the risk of a truncated
+	// member on crash.
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("write %s: %w", filename, err)
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("sync %s: %w", filename, err)
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if isNew {
+		return syncDir(tableDir)
+	}
+	return nil
+}
+
+// syncDir syncs a directory, so a new file entry survives a crash.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = d.Close() }()
+	if err := d.Sync(); err != nil {
+		return fmt.Errorf("sync %s: %w", dir, err)
+	}
+	return nil
+}
+
+// encodeRow returns a row with the time values formatted as mysql
+// datetimes, so they can be inserted back as is.
+func encodeRow(row map[string]any) map[string]any {
+	m := make(map[string]any, len(row))
+	for k, v := range row {
+		switch v := v.(type) {
+		case time.Time:
+			if v.IsZero() {
+				m[k] = zeroDatetime
+			} else {
+				m[k] = v.Format(datetimeLayout)
+			}
+		case []byte:
+			m[k] = string(v)
+		default:
+			m[k] = v
+		}
+	}
+	return m
+}
+
+// Read calls fn for each row of an archive file.
+func Read(filename string, fn func(map[string]any) error) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+	zr, err := gzip.NewReader(bufio.NewReader(f))
+	if err != nil {
+		return fmt.Errorf("%s: %w", filename, err)
+	}
+	defer func() { _ = zr.Close() }()
+	dec := json.NewDecoder(zr)
+	dec.UseNumber()
+	for {
+		var row map[string]any
+		if err := dec.Decode(&row); errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("%s: %w", filename, err)
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package archive
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/opensvc/oc3/cdb"
+)
+
+type (
+	// Restorer loads the archived rows of a table into a side table.
+	Restorer struct {
+		DB  *sql.DB
+		Dir string
+
+		// Table is the archived table name
+		Table string
+
+		// Into is the side table name, created like Table if it does not
+		// exist.
+		Into string
+
+		// BatchSize is the maximum number of rows per insert query
+		BatchSize int
+	}
+
+	restoreBatch struct {
+		cols []string
+		rows [][]any
+	}
+)
+
+const (
+	DefaultRestoreBatchSize = 500
+)
+
+// Restore loads the rows archived from the from day to the to day included,
+// and returns the number of rows inserted. The rows already present in the
+// side table are ignored, so a day can be restored more than once.
+func (r *Restorer) Restore(ctx context.Context, from, to time.Time) (int64, error) {
+	if err := ValidateTableName(r.Table); err != nil {
+		return 0, err
+	}
+	if err := ValidateTableName(r.Into); err != nil {
+		return 0, err
+	}
+	if r.Into == r.Table {
+		return 0, fmt.Errorf("the side table must differ from the archived table")
+	}
+	if to.Before(from) {
+		return 0, fmt.Errorf("the end day is before the begin day")
+	}
+	if r.BatchSize <= 0 {
+		r.BatchSize = DefaultRestoreBatchSize
+	}
+	query := fmt.Sprintf("CREATE TABLE IF NOT EXISTS `%s` LIKE `%s`", r.Into, r.Table)
+	if _, err := r.DB.ExecContext(ctx, query); err != nil {
+		return 0, fmt.Errorf("create %s: %w", r.Into, err)
+	}
+
+	var total int64
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		filename := Path(r.Dir, r.Table, day)
+		if _, err := os.Stat(filename); errors.Is(err, os.ErrNotExist) {
+			slog.Debug(fmt.Sprintf("restore %s: no archive for %s", r.Table, day.Format(dayLayout)))
+			continue
+		}
+		n, err := r.restoreFile(ctx, filename)
+		total += n
+		if err != nil {
+			return total, err
+		}
+		slog.Info(fmt.Sprintf("restore %s: %s: %d rows inserted into %s", r.Table, filename, n, r.Into))
+	}
+	return total, nil
+}
+
+func (r *Restorer) restoreFile(ctx context.Context, filename string) (int64, error) {
+	var (
+		total int64
+		batch restoreBatch
+	)
+	flush := func() error {
+		n, err := r.insert(ctx, batch)
+		total += n
+		batch.rows = batch.rows[:0]
+		return err
+	}
+	err := Read(filename, func(row map[string]any) error {
+		cols := make([]string, 0, len(row))
+		for k := range row {
+			cols = append(cols, k)
+		}
+		slices.Sort(cols)
+		if !slices.Equal(cols, batch.cols) {
+			// the table schema changed between the archived rows
+			if err := flush(); err != nil {
+				return err
+			}
+			batch.cols = cols
+		}
+		values := make([]any, len(cols))
+		for i, col := range cols {
+			values[i] = row[col]
+		}
+		batch.rows = append(batch.rows, values)
+		if len(batch.rows) >= r.BatchSize {
+			return flush()
+		}
+		return nil
+	})
+	if err != nil {
+		return total, err
+	}
+	return total, flush()
+}
+
+func (r *Restorer) insert(ctx context.Context, batch restoreBatch) (int64, error) {
+	if len(batch.rows) == 0 {
+		return 0, nil
+	}
+	quotedCols := make([]string, len(batch.cols))
+	for i, col := range batch.cols {
+		quotedCols[i] = "`" + strings.ReplaceAll(col, "`", "``") + "`"
+	}
+	rowPlaceholders := "(" + cdb.Placeholders(len(batch.cols)) + ")"
+	placeholders := make([]string, len(batch.rows))
+	args := make([]any, 0, len(batch.rows)*len(batch.cols))
+	for i, values := range batch.rows {
+		placeholders[i] = rowPlaceholders
+		args = append(args, values...)
+	}
+	query := fmt.Sprintf("INSERT IGNORE INTO `%s` (%s) VALUES %s", r.Into, strings.Join(quotedCols, ","), strings.Join(placeholders, ","))
+	result, err := r.DB.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("insert into %s: %w", r.Into, err)
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,214 @@
+// Package availability measures the service and instance availability
+// from the services_log and svcmon_log status intervals, excluding the
+// acknowledged unavailability periods.
+package availability
+
+import (
+	"slices"
+	"time"
+)
+
+type (
+	// Interval is a status period of a service or an instance.
+	Interval struct {
+		Status string
+		Begin  time.Time
+		End    time.Time
+	}
+
+	// Window is an acknowledged unavailability period, excluded from the
+	// availability measures.
+	Window struct {
+		Begin time.Time
+		End   time.Time
+	}
+
+	// Durations are the time spent in each status during a period. The
+	// Excluded duration is the time spent in acknowledged periods,
+	// whatever the status, and the Unknown duration the time not covered
+	// by any status interval or with an undetermined status.
+	Durations struct {
+		Up       time.Duration
+		Down     time.Duration
+		Degraded time.Duration
+		Excluded time.Duration
+		Unknown  time.Duration
+	}
+
+	// Outage is a down period.
+	Outage struct {
+		Begin time.Time
+		End   time.Time
+
+		// Acked is true if the outage is entirely in acknowledged periods
+		Acked bool
+	}
+)
+
+const (
+	StatusUp       = "up"
+	StatusDown     = "down"
+	StatusDegraded = "degraded"
+	StatusUnknown  = "unknown"
+)
+
+// ServiceStatus returns the availability status of a services_log
+// svc_availstatus value.
+func ServiceStatus(availStatus string) string {
+	switch availStatus {
+	case "up", "stdby up":
+		return StatusUp
+	case "warn":
+		return StatusDegraded
+	case "down", "stdby down":
+		return StatusDown
+	default:
+		return StatusUnknown
+	}
+}
+
+// InstanceStatus returns the availability status of a svcmon_log
+// mon_availstatus and mon_overallstatus pair. An up instance with a
+// non-up overall status is degraded.
+func InstanceStatus(availStatus, overallStatus string) string {
+	s := ServiceStatus(availStatus)
+	if s == StatusUp && overallStatus != "" && overallStatus != "up" && overallStatus != "n/a" {
+		return StatusDegraded
+	}
+	return s
+}
+
+// Add returns the sum of the durations.
+func (d Durations) Add(o Durations) Durations {
+	return Durations{
+		Up:       d.Up + o.Up,
+		Down:     d.Down + o.Down,
+		Degraded: d.Degraded + o.Degraded,
+		Excluded: d.Excluded + o.Excluded,
+		Unknown:  d.Unknown + o.Unknown,
+	}
+}
+
+// Percent returns the percentage of time the service was up or degraded,
+// over the time its status was known and not excluded. It returns false if
+// no such time was measured.
+func (d Durations) Percent() (float64, bool) {
+	measured := d.Up + d.Degraded + d.Down
+	if measured <= 0 {
+		return 0, false
+	}
+	return 100 * float64(d.Up+d.Degraded) / float64(measured), true
+}
+
+// Measure returns the durations of the intervals in the [begin, end)
+// period, not overlapping the excluded windows. The intervals must not
+// overlap each other.
+func Measure(intervals []Interval, excluded []Window, begin, end time.Time) Durations {
+	var d Durations
+	if !end.After(begin) {
+		return d
+	}
+	windows := mergeWindows(excluded, begin, end)
+	for _, w := range windows {
+		d.Excluded += w.End.Sub(w.Begin)
+	}
+	var covered time.Duration
+	for _, i := range intervals {
+		b, e := clip(i.Begin, i.End, begin, end)
+		if !e.After(b) {
+			continue
+		}
+		length := e.Sub(b) - overlap(windows, b, e)
+		covered += length
+		switch i.Status {
+		case StatusUp:
+			d.Up += length
+		case StatusDown:
+			d.Down += length
+		case StatusDegraded:
+			d.Degraded += length
+		default:
+			d.Unknown += length
+		}
+	}
+	if uncovered := end.Sub(begin) - d.Excluded - covered; uncovered > 0 {
+		d.Unknown += uncovered
+	}
+	return d
+}
+
+// Outages returns the down periods in the [begin, end) period, merging the
+// consecutive down intervals.
+func Outages(intervals []Interval, excluded []Window, begin, end time.Time) []Outage {
+	sorted := slices.Clone(intervals)
+	slices.SortFunc(sorted, func(a, b Interval) int { return a.Begin.Compare(b.Begin) })
+	windows := mergeWindows(excluded, begin, end)
+	var l []Outage
+	for _, i := range sorted {
+		if i.Status != StatusDown {
+			continue
+		}
+		b, e := clip(i.Begin, i.End, begin, end)
+		if !e.After(b) {
+			continue
+		}
+		if n := len(l); n > 0 && !b.After(l[n-1].End) {
+			if e.After(l[n-1].End) {
+				l[n-1].End = e
+			}
+			continue
+		}
+		l = append(l, Outage{Begin: b, End: e})
+	}
+	for n, o := range l {
+		l[n].Acked = overlap(windows, o.Begin, o.End) == o.End.Sub(o.Begin)
+	}
+	return l
+}
+
+// mergeWindows returns the windows clipped to the [begin, end) period,
+// sorted and merged when they overlap.
+func mergeWindows(l []Window, begin, end time.Time) []Window {
+	var clipped []Window
+	for _, w := range l {
+		b, e := clip(w.Begin, w.End, begin, end)
+		if e.After(b) {
+			clipped = append(clipped, Window{Begin: b, End: e})
+		}
+	}
+	slices.SortFunc(clipped, func(a, b Window) int { return a.Begin.Compare(b.Begin) })
+	var merged []Window
+	for _, w := range clipped {
+		if n := len(merged); n > 0 && !w.Begin.After(merged[n-1].End) {
+			if w.End.After(merged[n-1].End) {
+				merged[n-1].End = w.End
+			}
+			continue
+		}
+		merged = append(merged, w)
+	}
+	return merged
+}
+
+// overlap returns the duration of the [begin, end) period covered by the
+// merged windows.
+func overlap(windows []Window, begin, end time.Time) time.Duration {
+	var d time.Duration
+	for _, w := range windows {
+		b, e := clip(w.Begin, w.End, begin, end)
+		if e.After(b) {
+			d += e.Sub(b)
+		}
+	}
+	return d
+}
+
+func clip(b, e, begin, end time.Time) (time.Time, time.Time) {
+	if b.Before(begin) {
+		b = begin
+	}
+	if e.After(end) {
+		e = end
+	}
+	return b, e
+}
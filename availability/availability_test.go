@@ -0,0 +1,148 @@
+package availability
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestMeasure(t *testing.T) {
+	begin := time.Date(2024, 9, 2, 0, 0, 0, 0, time.UTC)
+	end := begin.Add(24 * time.Hour)
+	at := func(hour int) time.Time { return begin.Add(time.Duration(hour) * time.Hour) }
+	cases := []struct {
+		name      string
+		intervals []Interval
+		excluded  []Window
+		begin     time.Time
+		end       time.Time
+		want      Durations
+	}{
+		{
+			name:  "no interval",
+			begin: begin, end: end,
+			want: Durations{Unknown: 24 * time.Hour},
+		},
+		{
+			name:  "empty period",
+			begin: end, end: begin,
+			intervals: []Interval{{Status: StatusUp, Begin: begin, End: end}},
+		},
+		{
+			name:  "up all day",
+			begin: begin, end: end,
+			intervals: []Interval{{Status: StatusUp, Begin: begin, End: end}},
+			want:      Durations{Up: 24 * time.Hour},
+		},
+		{
+			name:  "clipped to the period",
+			begin: begin, end: end,
+			intervals: []Interval{
+				{Status: StatusUp, Begin: at(-5), End: at(2)},
+				{Status: StatusDown, Begin: at(22), End: at(30)},
+			},
+			want: Durations{Up: 2 * time.Hour, Down: 2 * time.Hour, Unknown: 20 * time.Hour},
+		},
+		{
+			name:  "all statuses",
+			begin: begin, end: end,
+			intervals: []Interval{
+				{Status: StatusUp, Begin: at(0), End: at(10)},
+				{Status: StatusDegraded, Begin: at(10), End: at(12)},
+				{Status: StatusDown, Begin: at(12), End: at(15)},
+				{Status: StatusUnknown, Begin: at(15), End: at(16)},
+				{Status: StatusUp, Begin: at(18), End: at(24)},
+			},
+			want: Durations{
+				Up:       16 * time.Hour,
+				Degraded: 2 * time.Hour,
+				Down:     3 * time.Hour,
+				Unknown:  3 * time.Hour,
+			},
+		},
+		{
+			name:  "excluded down",
+			begin: begin, end: end,
+			intervals: []Interval{
+				{Status: StatusUp, Begin: at(0), End: at(12)},
+				{Status: StatusDown, Begin: at(12), End: at(24)},
+			},
+			excluded: []Window{{Begin: at(12), End: at(20)}},
+			want:     Durations{Up: 12 * time.Hour, Down: 4 * time.Hour, Excluded: 8 * time.Hour},
+		},
+		{
+			name:  "excluded overlapping windows counted once",
+			begin: begin, end: end,
+			intervals: []Interval{{Status: StatusDown, Begin: begin, End: end}},
+			excluded: []Window{
+				{Begin: at(2), End: at(6)},
+				{Begin: at(4), End: at(8)},
+				{Begin: at(23), End: at(30)},
+			},
+			want: Durations{Down: 17 * time.Hour, Excluded: 7 * time.Hour},
+		},
+		{
+			name:  "excluded without status",
+			begin: begin, end: end,
+			intervals: []Interval{{Status: StatusUp, Begin: at(0), End: at(6)}},
+			excluded:  []Window{{Begin: at(10), End: at(12)}},
+			want:      Durations{Up: 6 * time.Hour, Excluded: 2 * time.Hour, Unknown: 16 * time.Hour},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Measure(tc.intervals, tc.excluded, tc.begin, tc.end); got != tc.want {
+				t.Errorf("Measure = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMergeWindows(t *testing.T) {
+	begin := time.Date(2024, 9, 2, 0, 0, 0, 0, time.UTC)
+	end := begin.Add(24 * time.Hour)
+	at := func(hour int) time.Time { return begin.Add(time.Duration(hour) * time.Hour) }
+	cases := []struct {
+		name string
+		l    []Window
+		want []Window
+	}{
+		{name: "none"},
+		{
+			name: "disjoint sorted",
+			l:    []Window{{at(8), at(10)}, {at(2), at(4)}},
+			want: []Window{{at(2), at(4)}, {at(8), at(10)}},
+		},
+		{
+			name: "overlapping",
+			l:    []Window{{at(2), at(6)}, {at(4), at(8)}},
+			want: []Window{{at(2), at(8)}},
+		},
+		{
+			name: "contiguous",
+			l:    []Window{{at(2), at(4)}, {at(4), at(6)}},
+			want: []Window{{at(2), at(6)}},
+		},
+		{
+			name: "contained",
+			l:    []Window{{at(2), at(10)}, {at(4), at(6)}},
+			want: []Window{{at(2), at(10)}},
+		},
+		{
+			name: "clipped",
+			l:    []Window{{at(-4), at(2)}, {at(20), at(28)}},
+			want: []Window{{at(0), at(2)}, {at(20), at(24)}},
+		},
+		{
+			name: "out of period or empty",
+			l:    []Window{{at(-4), at(-2)}, {at(25), at(28)}, {at(5), at(5)}, {at(7), at(6)}},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mergeWindows(tc.l, begin, end); !slices.Equal(got, tc.want) {
+				t.Errorf("mergeWindows = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -8,9 +8,11 @@ const (
 	FeedDaemonPingPendingH = "oc3:h:feed_daemon_ping_pending"
 
 	FeedDaemonStatusChangesH = "oc3:h:feed_daemon_status_changes"
+	FeedDaemonStatusFullH    = "oc3:h:feed_daemon_status_full"
 	FeedDaemonStatusH        = "oc3:h:feed_daemon_status"
 	FeedDaemonStatusQ        = "oc3:q:feed_daemon_status"
 	FeedDaemonStatusPendingH = "oc3:h:feed_daemon_status_pending"
+	FeedDaemonStatusReportH  = "oc3:h:feed_daemon_status_report"
 
 	FeedInstanceResourceInfoH        = "oc3:h:feed_instance_resource_info"
 	FeedInstanceResourceInfoQ        = "oc3:q:feed_instance_resource_info"
@@ -43,4 +45,7 @@ const (
 
 	FeedChecksH = "oc3:h:feed_checks"
 	FeedChecksQ = "oc3:q:feed_checks"
+
+	SchedulerLeaderLock     = "oc3:lock:scheduler_leader"
+	SchedulerTaskLockPrefix = "oc3:lock:scheduler_task:"
 )
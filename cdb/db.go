@@ -191,6 +191,70 @@ func (oDb *DB) DeleteBatched(ctx context.Context, table, dateCol, orderbyCol str
 	}
 }
 
+// ArchiveBatched deletes the rows older than retention days like
+// DeleteBatched, passing each batch of rows to the archive func before
+// deleting it. A batch is not deleted if archive returns an error.
+//
+// The rows are selected and deleted by their orderbyCol value, which must
+// be unique.
+func (oDb *DB) ArchiveBatched(ctx context.Context, table, dateCol, orderbyCol string, batchSize int64, retention int, where string, archive func([]map[string]any) error) (totalDeleted int64, batchCount int64, err error) {
+	query := fmt.Sprintf("SELECT * FROM `%s` WHERE `%s` < DATE_SUB(NOW(), INTERVAL ? DAY) %s ORDER BY `%s` LIMIT ?",
+		table, dateCol, where, orderbyCol)
+
+	for {
+		batchCount++
+
+		ctx, cancel := context.WithTimeout(ctx, time.Minute)
+		count, n, err := oDb.archiveBatch(ctx, table, orderbyCol, query, retention, batchSize, archive)
+		cancel()
+		if err != nil {
+			return totalDeleted, batchCount, fmt.Errorf("%s: error executing batch %d: %w", table, batchCount, err)
+		}
+
+		totalDeleted += count
+		if count > 0 {
+			slog.Debug(fmt.Sprintf("ArchiveBatched: %s: batch %d: archived %d rows, deleted %d rows. total deleted: %d", table, batchCount, n, count, totalDeleted))
+		}
+
+		if n < batchSize {
+			return totalDeleted, batchCount, nil
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// archiveBatch selects a batch of rows, archives them and deletes them. It
+// returns the number of rows deleted and selected.
+func (oDb *DB) archiveBatch(ctx context.Context, table, orderbyCol, query string, retention int, batchSize int64, archive func([]map[string]any) error) (int64, int64, error) {
+	rows, err := oDb.DB.QueryContext(ctx, query, retention, batchSize)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() { _ = rows.Close() }()
+	cols, err := rows.Columns()
+	if err != nil {
+		return 0, 0, err
+	}
+	l, err := scanRowsToMaps(rows, cols)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(l) == 0 {
+		return 0, 0, nil
+	}
+	if err := archive(l); err != nil {
+		return 0, int64(len(l)), fmt.Errorf("archive: %w", err)
+	}
+	ids := make([]any, len(l))
+	for i, row := range l {
+		ids[i] = row[orderbyCol]
+	}
+	deleteQuery := fmt.Sprintf("DELETE FROM `%s` WHERE `%s` IN (%s)", table, orderbyCol, Placeholders(len(ids)))
+	count, err := oDb.execCountContext(ctx, deleteQuery, ids...)
+	return count, int64(len(l)), err
+}
+
 // ExecContextAndCountRowsAffected executes the oDb.DB.ExecContext query with the provided context, returning the number of rows affected and an error.
 func (oDb *DB) ExecContextAndCountRowsAffected(ctx context.Context, query string, args ...any) (int64, error) {
 	return oDb.execCountContext(ctx, query, args...)
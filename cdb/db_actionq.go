@@ -3,9 +3,13 @@ package cdb
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
+
+	"github.com/opensvc/oc3/schema"
 )
 
 type (
@@ -26,6 +30,21 @@ type (
 		SvcId        string
 		Fqdn         *string
 		ListenerPort int
+
+		// App is the action object app, or the node app for node actions
+		App *string
+
+		// Transport is the runner transport selected for the entry, nil to
+		// use the runner app or default transport.
+		Transport *string
+
+		// Action is the verb of a structured action, nil for free-form
+		// command actions.
+		Action       *string
+		ActionParams *string
+		Svcname      *string
+		AgentVersion *string
+		ClusterID    string
 	}
 
 	/*
@@ -53,6 +72,19 @@ type (
 			  KEY `k_node_id` (`node_id`),
 			  KEY `k_svc_id` (`svc_id`)
 			) ENGINE=InnoDB AUTO_INCREMENT=640 DEFAULT CHARSET=utf8 COLLATE=utf8_general_ci
+
+		The runner and the api read the optional columns below only when they
+		exist, so they can be upgraded before the database. Queueing a
+		structured action requires the action and action_params columns:
+
+			ALTER TABLE `action_queue`
+			  ADD COLUMN `action` varchar(32) DEFAULT NULL,
+			  ADD COLUMN `action_params` text DEFAULT NULL,
+			  ADD COLUMN `transport` varchar(16) DEFAULT NULL;
+
+		The action column is the verb of a structured action, NULL for a
+		free-form command action. The action_params column is the json
+		object of the structured action parameters.
 	*/
 	ActionQueue struct {
 		ID           int
@@ -69,6 +101,8 @@ type (
 		ConnectTo    *string
 		NodeId       string
 		SvcId        string
+		Action       *string
+		ActionParams *string
 	}
 
 	// ActionQueueNamed is a db ActionQueue with explicit Nodename and Svcname fields
@@ -78,6 +112,24 @@ type (
 		Nodename string
 		Svcname  string
 	}
+
+	// ActionQueueColumns tells which optional action_queue columns exist in
+	// the database.
+	ActionQueueColumns struct {
+		Action       bool
+		ActionParams bool
+		Transport    bool
+	}
+)
+
+var (
+	// ErrActionQColumns is returned when queueing a structured action in an
+	// action_queue table without the action and action_params columns.
+	ErrActionQColumns = errors.New("the action_queue table has no action and action_params columns")
+
+	reActionQAction       = regexp.MustCompile(`\baction_queue\.action\b`)
+	reActionQActionParams = regexp.MustCompile(`\baction_queue\.action_params\b`)
+	reActionQTransport    = regexp.MustCompile(`\baction_queue\.transport\b`)
 )
 
 const (
@@ -245,10 +297,69 @@ func (oDb *DB) ActionQSetDoneForNodeID(ctx context.Context, nodeID string, a Act
 	return err
 }
 
-func (oDb *DB) ActionQPushPullGetQueued(ctx context.Context) (lines []ActionQueueEntry, err error) {
-	const query = `SELECT
-    	a.id, a.command, a.action_type, a.connect_to, n.fqdn, n.listener_port, a.form_id 
-		FROM action_queue a JOIN nodes n ON a.node_id=n.node_id WHERE a.status='W' AND a.action_type IN (?, ?) AND a.date_dequeued=@now`
+// ActionQColumns returns the optional action_queue columns existing in the
+// database.
+func (oDb *DB) ActionQColumns(ctx context.Context) (cols ActionQueueColumns, err error) {
+	const query = `SELECT COLUMN_NAME FROM information_schema.COLUMNS
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'action_queue'`
+	rows, err := oDb.DB.QueryContext(ctx, query)
+	if err != nil {
+		return cols, fmt.Errorf("actionQColumns: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return cols, fmt.Errorf("actionQColumns: %w", err)
+		}
+		switch name {
+		case "action":
+			cols.Action = true
+		case "action_params":
+			cols.ActionParams = true
+		case "transport":
+			cols.Transport = true
+		}
+	}
+	return cols, rows.Err()
+}
+
+// optionalCol returns expr if the optional column exists, else NULL.
+func optionalCol(exists bool, expr string) string {
+	if exists {
+		return expr
+	}
+	return "NULL"
+}
+
+// nullMissing returns the query with the references to the optional
+// action_queue columns missing from cols replaced by NULL.
+func (cols ActionQueueColumns) nullMissing(query string) string {
+	for _, c := range []struct {
+		exists bool
+		re     *regexp.Regexp
+	}{
+		{cols.Action, reActionQAction},
+		{cols.ActionParams, reActionQActionParams},
+		{cols.Transport, reActionQTransport},
+	} {
+		if !c.exists {
+			query = c.re.ReplaceAllString(query, "NULL")
+		}
+	}
+	return query
+}
+
+// ActionQPushPullGetQueued returns the push and pull entries dequeued at
+// @now. The optional columns missing from cols are read as NULL.
+func (oDb *DB) ActionQPushPullGetQueued(ctx context.Context, cols ActionQueueColumns) (lines []ActionQueueEntry, err error) {
+	query := `SELECT
+    	a.id, a.command, a.action_type, a.connect_to, n.fqdn, n.listener_port, a.form_id, COALESCE(s.svc_app, n.app),
+    	COALESCE(a.svc_id, ""), ` + optionalCol(cols.Action, "a.action") + `, ` + optionalCol(cols.ActionParams, "a.action_params") + `, s.svcname, n.version,
+    	a.node_id, COALESCE(n.cluster_id, ""), a.user_id, ` + optionalCol(cols.Transport, "a.transport") + `
+		FROM action_queue a JOIN nodes n ON a.node_id=n.node_id
+		LEFT JOIN services s ON a.svc_id=s.svc_id
+		WHERE a.status='W' AND a.action_type IN (?, ?) AND a.date_dequeued=@now`
 
 	var rows *sql.Rows
 
@@ -259,7 +370,9 @@ func (oDb *DB) ActionQPushPullGetQueued(ctx context.Context) (lines []ActionQueu
 	defer func() { _ = rows.Close() }()
 	for rows.Next() {
 		var line ActionQueueEntry
-		if err = rows.Scan(&line.ID, &line.Command, &line.ActionType, &line.ConnectTo, &line.Fqdn, &line.ListenerPort, &line.FormId); err != nil {
+		if err = rows.Scan(&line.ID, &line.Command, &line.ActionType, &line.ConnectTo, &line.Fqdn, &line.ListenerPort, &line.FormId, &line.App,
+			&line.SvcId, &line.Action, &line.ActionParams, &line.Svcname, &line.AgentVersion,
+			&line.NodeId, &line.ClusterID, &line.UserId, &line.Transport); err != nil {
 			return
 		}
 		lines = append(lines, line)
@@ -268,6 +381,15 @@ func (oDb *DB) ActionQPushPullGetQueued(ctx context.Context) (lines []ActionQueu
 	return
 }
 
+// ActionQResetDequeued resets the dequeued date of the waiting entries ids,
+// so they are fetched again by the next ActionQPushPullSetDequeuedToNow.
+func (oDb *DB) ActionQResetDequeued(ctx context.Context, ids []int) error {
+	placeholders, args := getPlaceholdersAndArgs(ids)
+	request := fmt.Sprintf(`update action_queue set date_dequeued=0 where id in (%s) and status='W'`, strings.Join(placeholders, ","))
+	_, err := oDb.ExecContext(ctx, request, args...)
+	return err
+}
+
 func getPlaceholdersAndArgs(ids []int) (placeholders []string, args []any) {
 	placeholders = make([]string, len(ids))
 	args = make([]any, len(ids))
@@ -383,3 +505,176 @@ func (oDb *DB) ActionQSetFormNextId(ctx context.Context, id int) error {
 	_, err := oDb.ExecContext(ctx, request, id)
 	return err
 }
+
+// actionResponsibleCond returns the condition on the joined nodes and
+// services rows of the action targets the groups are responsible for: the
+// action object app, or the action node app for node actions and objects
+// without app.
+func actionResponsibleCond(groups []string) (string, []any) {
+	cleanGroups := cleanGroups(groups)
+	if len(cleanGroups) == 0 {
+		return "1=0", nil
+	}
+	args := make([]any, len(cleanGroups))
+	for i, g := range cleanGroups {
+		args[i] = g
+	}
+	return "COALESCE(NULLIF(services.svc_app, ''), nodes.app) IN (" +
+		"SELECT a.app FROM apps a" +
+		" JOIN apps_responsibles ar ON ar.app_id = a.id" +
+		" JOIN auth_group ag ON ag.id = ar.group_id" +
+		" WHERE ag.role IN (" + Placeholders(len(cleanGroups)) + ")" +
+		")", args
+}
+
+func buildActionQueueQuery(groups []string, isManager bool, selectExprs []string) (string, []any) {
+	q := From(schema.TActionQueue).
+		LeftJoin(schema.TNodes, schema.TServices).
+		RawSelect(selectExprs...)
+
+	if !isManager {
+		q = q.WhereRaw(actionResponsibleCond(groups))
+	} else {
+		q = q.Where(schema.ActionQueueID, ">", 0)
+	}
+
+	query, args, err := q.Build()
+	if err != nil {
+		panic(fmt.Sprintf("buildActionQueueQuery: %v", err))
+	}
+	return query, args
+}
+
+// ActionTargetResponsible returns true if the groups are responsible for the
+// action target node and object, with the same rule as the action queue
+// entries visibility. The svcID is empty for node actions.
+func (oDb *DB) ActionTargetResponsible(ctx context.Context, nodeID, svcID string, groups []string) (bool, error) {
+	cond, condArgs := actionResponsibleCond(groups)
+	query := "SELECT COUNT(*) FROM nodes LEFT JOIN services ON services.svc_id = ?" +
+		" WHERE nodes.node_id = ? AND " + cond
+	args := append([]any{svcID, nodeID}, condArgs...)
+	var n int
+	if err := oDb.DB.QueryRowContext(ctx, query, args...).Scan(&n); err != nil {
+		return false, fmt.Errorf("actionTargetResponsible: %w", err)
+	}
+	return n > 0, nil
+}
+
+// GetActions returns the action queue entries visible by the groups.
+func (oDb *DB) GetActions(ctx context.Context, p ListParams) ([]map[string]any, error) {
+	cols, err := oDb.ActionQColumns(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getActions: %w", err)
+	}
+	query, args := buildActionQueueQuery(p.Groups, p.IsManager, p.SelectExprs)
+	if gb := p.GroupByClause(""); gb != "" {
+		query += " " + gb
+	}
+	query += " " + p.OrderByClause("action_queue.id DESC")
+	query, args = appendLimitOffset(query, args, p.Limit, p.Offset)
+
+	rows, err := oDb.DB.QueryContext(ctx, cols.nullMissing(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("getActions: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	return scanRowsToMaps(rows, p.Props, p.TypeHints)
+}
+
+// GetAction returns the action queue entry id if visible by the groups.
+func (oDb *DB) GetAction(ctx context.Context, id int, p ListParams) ([]map[string]any, error) {
+	cols, err := oDb.ActionQColumns(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getAction: %w", err)
+	}
+	query, args := buildActionQueueQuery(p.Groups, p.IsManager, p.SelectExprs)
+	query += " AND action_queue.id = ?"
+	args = append(args, id)
+	query, args = appendLimitOffset(query, args, p.Limit, p.Offset)
+
+	rows, err := oDb.DB.QueryContext(ctx, cols.nullMissing(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("getAction: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	return scanRowsToMaps(rows, p.Props, p.TypeHints)
+}
+
+// ActionQByID returns the action queue entry id, or nil if not found. The
+// optional columns missing from the database are read as NULL.
+func (oDb *DB) ActionQByID(ctx context.Context, id int) (*ActionQueue, error) {
+	cols, err := oDb.ActionQColumns(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("actionQByID: %w", err)
+	}
+	query := `SELECT
+		id, COALESCE(status, ''), command, action_type, date_queued, date_dequeued,
+		COALESCE(ret, 0), COALESCE(stdout, ''), COALESCE(stderr, ''),
+		user_id, connect_to, COALESCE(node_id, ''), COALESCE(svc_id, ''),
+		` + optionalCol(cols.Action, "action") + `, ` + optionalCol(cols.ActionParams, "action_params") + `
+		FROM action_queue WHERE id = ?`
+	var (
+		a          ActionQueue
+		actionType sql.NullString
+		userID     sql.NullInt64
+	)
+	err = oDb.DB.QueryRowContext(ctx, query, id).Scan(&a.ID, &a.Status, &a.Command, &actionType,
+		&a.DateQueued, &a.DateDequeued, &a.Ret, &a.Stdout, &a.Stderr,
+		&userID, &a.ConnectTo, &a.NodeId, &a.SvcId, &a.Action, &a.ActionParams)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("actionQByID: %w", err)
+	}
+	a.ActionType = actionType.String
+	if userID.Valid {
+		i := int(userID.Int64)
+		a.UserId = &i
+	}
+	return &a, nil
+}
+
+// ActionQInsert queues a new action in the 'W' status and returns its id.
+// The connect_to value is copied from the action node. A structured action
+// is refused with ErrActionQColumns if the database has no action and
+// action_params columns.
+func (oDb *DB) ActionQInsert(ctx context.Context, a ActionQueue) (int64, error) {
+	cols, err := oDb.ActionQColumns(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("actionQInsert: %w", err)
+	}
+	var result sql.Result
+	switch {
+	case cols.Action && cols.ActionParams:
+		const query = `INSERT INTO action_queue
+			(status, command, action_type, user_id, connect_to, node_id, svc_id, action, action_params, date_queued)
+			VALUES ('W', ?, ?, ?, (SELECT connect_to FROM nodes WHERE node_id = ?), ?, ?, ?, ?, NOW())`
+		result, err = oDb.ExecContext(ctx, query, a.Command, a.ActionType, a.UserId, a.NodeId, a.NodeId, a.SvcId, a.Action, a.ActionParams)
+	case a.Action != nil:
+		return 0, fmt.Errorf("actionQInsert: %w", ErrActionQColumns)
+	default:
+		const query = `INSERT INTO action_queue
+			(status, command, action_type, user_id, connect_to, node_id, svc_id, date_queued)
+			VALUES ('W', ?, ?, ?, (SELECT connect_to FROM nodes WHERE node_id = ?), ?, ?, NOW())`
+		result, err = oDb.ExecContext(ctx, query, a.Command, a.ActionType, a.UserId, a.NodeId, a.NodeId, a.SvcId)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("actionQInsert: %w", err)
+	}
+	return result.LastInsertId()
+}
+
+// ActionQCancel sets the 'C' status on the action queue entry id if not yet
+// dequeued by the runner or the node, and returns true if the entry
+// was cancelled.
+func (oDb *DB) ActionQCancel(ctx context.Context, id int) (bool, error) {
+	const query = `UPDATE action_queue SET status='C', date_dequeued=NOW() WHERE id = ? AND status IN ('W', 'Q')`
+	count, err := oDb.execCountContext(ctx, query, id)
+	if err != nil {
+		return false, fmt.Errorf("actionQCancel: %w", err)
+	}
+	return count > 0, nil
+}
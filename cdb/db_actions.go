@@ -445,6 +445,13 @@ func (oDb *DB) UpdateDashActionErrors(ctx context.Context, svcID string, nodeID
 		if svcEnv.Valid && svcEnv.String == "PRD" {
 			sev = 4
 		}
+		decision, err := oDb.resolveAlert(ctx, "action errors", nodeID, svcID, svcEnv.String, sev)
+		if err != nil {
+			return fmt.Errorf("UpdateDashActionErrors: %w", err)
+		} else if decision.Suppress {
+			return nil
+		}
+		sev = decision.Severity
 		const queryInsert = `INSERT INTO dashboard
                  SET
                    dash_type="action errors",
@@ -0,0 +1,288 @@
+package cdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/opensvc/oc3/schema"
+)
+
+type (
+	// AlertsFilter selects the dashboard alerts matching the non-zero
+	// fields. The Type field may contain the % wildcard.
+	AlertsFilter struct {
+		Type        string
+		Env         string
+		MinSeverity int
+
+		// Acked selects the alerts with (true) or without (false) an
+		// active acknowledgement
+		Acked *bool
+	}
+
+	// DashboardAck is an acknowledgement of a dashboard alert, valid from
+	// Begin to End, like the svcmon_log_ack acknowledgements of the service
+	// unavailability periods.
+	//
+	//	CREATE TABLE `dashboard_ack` (
+	//	  `id` int(11) NOT NULL AUTO_INCREMENT,
+	//	  `alert_key` char(32) NOT NULL,
+	//	  `dash_type` varchar(100) NOT NULL,
+	//	  `node_id` char(36) NOT NULL DEFAULT '',
+	//	  `svc_id` char(36) NOT NULL DEFAULT '',
+	//	  `ack_begin` datetime NOT NULL,
+	//	  `ack_end` datetime NOT NULL,
+	//	  `ack_comment` text NOT NULL,
+	//	  `acked_by` varchar(100) NOT NULL,
+	//	  `acked_on` datetime NOT NULL,
+	//	  PRIMARY KEY (`id`),
+	//	  KEY `k_alert_key_ack_end` (`alert_key`, `ack_end`),
+	//	  KEY `k_ack_end` (`ack_end`)
+	//	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
+	DashboardAck struct {
+		ID       int64
+		AlertKey string
+		Type     string
+		NodeID   string
+		SvcID    string
+		Begin    time.Time
+		End      time.Time
+		Comment  string
+		AckedBy  string
+		AckedOn  time.Time
+	}
+)
+
+var (
+	// AckUnavailabilityAlertTypes are the dashboard alert types of the
+	// service availability, whose acknowledgement is also recorded as a
+	// svcmon_log_ack unavailability period. The workers do not raise these
+	// alerts during such a period.
+	AckUnavailabilityAlertTypes = []string{
+		"service unavailable",
+		"service placement",
+		"service available but degraded",
+	}
+)
+
+// DashboardAlertKeyExpr returns the sql expression of the key identifying
+// an alert of the dashboard table aliased as t, across the dashboard row
+// deletions and re-insertions.
+func DashboardAlertKeyExpr(t string) string {
+	return fmt.Sprintf(`MD5(CONCAT_WS("|", %[1]s.dash_type, COALESCE(%[1]s.node_id, ""), COALESCE(%[1]s.svc_id, ""),`+
+		` COALESCE(%[1]s.dash_instance, ""), COALESCE(%[1]s.dash_dict_md5, "")))`, t)
+}
+
+// DashboardAckExpr returns the sql expression of the col column of the
+// active acknowledgement of the dashboard alert, or NULL if the alert is
+// not acknowledged.
+func DashboardAckExpr(col string) string {
+	return "(SELECT dashboard_ack." + col + " FROM dashboard_ack" +
+		" WHERE dashboard_ack.alert_key = " + DashboardAlertKeyExpr("dashboard") +
+		" AND dashboard_ack.ack_begin <= NOW() AND dashboard_ack.ack_end >= NOW()" +
+		" ORDER BY dashboard_ack.ack_end DESC LIMIT 1)"
+}
+
+// alertResponsibleCond returns the sql condition matching the dashboard
+// alerts whose node app or service app has one of the groups as responsible.
+// The service alerts have no node_id.
+func alertResponsibleCond(groups []string) (string, []any) {
+	cleanGroups := cleanGroups(groups)
+	if len(cleanGroups) == 0 {
+		return "1=0", nil
+	}
+	args := make([]any, 0, 2*len(cleanGroups))
+	for _, g := range cleanGroups {
+		args = append(args, g)
+	}
+	for _, g := range cleanGroups {
+		args = append(args, g)
+	}
+	return "(dashboard.node_id IN (" +
+		"SELECT n.node_id FROM nodes n" +
+		" JOIN apps a ON n.app = a.app" +
+		" JOIN apps_responsibles ar ON ar.app_id = a.id" +
+		" JOIN auth_group ag ON ag.id = ar.group_id" +
+		" WHERE ag.role IN (" + Placeholders(len(cleanGroups)) + ")" +
+		") OR dashboard.svc_id IN (" +
+		"SELECT s.svc_id FROM services s" +
+		" JOIN apps a ON s.svc_app = a.app" +
+		" JOIN apps_responsibles ar ON ar.app_id = a.id" +
+		" JOIN auth_group ag ON ag.id = ar.group_id" +
+		" WHERE ag.role IN (" + Placeholders(len(cleanGroups)) + ")" +
+		"))", args
+}
+
+func buildAlertsQuery(f AlertsFilter, p ListParams) (string, []any) {
+	q := From(schema.TDashboard).
+		RawSelect(p.SelectExprs...)
+
+	if !p.IsManager {
+		cond, args := alertResponsibleCond(p.Groups)
+		q = q.WhereRaw(cond, args...)
+	} else {
+		q = q.Where(schema.DashboardID, ">", 0)
+	}
+
+	switch {
+	case f.Type == "":
+	case strings.Contains(f.Type, "%"):
+		q = q.WhereRaw("dashboard.dash_type LIKE ?", f.Type)
+	default:
+		q = q.Where(schema.DashboardDashType, "=", f.Type)
+	}
+	if f.Env != "" {
+		q = q.Where(schema.DashboardDashEnv, "=", f.Env)
+	}
+	if f.MinSeverity > 0 {
+		q = q.Where(schema.DashboardDashSeverity, ">=", f.MinSeverity)
+	}
+	if f.Acked != nil {
+		if *f.Acked {
+			q = q.WhereRaw(DashboardAckExpr("id") + " IS NOT NULL")
+		} else {
+			q = q.WhereRaw(DashboardAckExpr("id") + " IS NULL")
+		}
+	}
+
+	query, args, err := q.Build()
+	if err != nil {
+		panic(fmt.Sprintf("buildAlertsQuery: %v", err))
+	}
+	return query, args
+}
+
+func (oDb *DB) GetAlerts(ctx context.Context, f AlertsFilter, p ListParams) ([]map[string]any, error) {
+	query, args := buildAlertsQuery(f, p)
+	return oDb.queryAlerts(ctx, "getAlerts", query, args, p)
+}
+
+func (oDb *DB) GetNodeAlerts(ctx context.Context, nodeID string, f AlertsFilter, p ListParams) ([]map[string]any, error) {
+	query, args := buildAlertsQuery(f, p)
+	query += " AND dashboard.node_id = ?"
+	args = append(args, nodeID)
+	return oDb.queryAlerts(ctx, "getNodeAlerts", query, args, p)
+}
+
+func (oDb *DB) GetServiceAlerts(ctx context.Context, svcID string, f AlertsFilter, p ListParams) ([]map[string]any, error) {
+	query, args := buildAlertsQuery(f, p)
+	query += " AND dashboard.svc_id = ?"
+	args = append(args, svcID)
+	return oDb.queryAlerts(ctx, "getServiceAlerts", query, args, p)
+}
+
+func (oDb *DB) queryAlerts(ctx context.Context, name, query string, args []any, p ListParams) ([]map[string]any, error) {
+	if gb := p.GroupByClause(""); gb != "" {
+		query += " " + gb
+	}
+	query += " " + p.OrderByClause("dashboard.dash_severity DESC, dashboard.dash_created DESC, dashboard.id")
+	query, args = appendLimitOffset(query, args, p.Limit, p.Offset)
+
+	rows, err := oDb.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	return scanRowsToMaps(rows, p.Props, p.TypeHints)
+}
+
+// GetAlertEvents returns the dashboard_events begin and end history of the
+// dashboard alert.
+func (oDb *DB) GetAlertEvents(ctx context.Context, alertID int64, p ListParams) ([]map[string]any, error) {
+	q := From(schema.TDashboardEvents).
+		RawSelect(p.SelectExprs...).
+		WhereRaw("(dashboard_events.dash_md5, COALESCE(dashboard_events.node_id, ''), COALESCE(dashboard_events.svc_id, '')) IN ("+
+			"SELECT d.dash_md5, COALESCE(d.node_id, ''), COALESCE(d.svc_id, '') FROM dashboard d WHERE d.id = ?)", alertID)
+	query, args, err := q.Build()
+	if err != nil {
+		return nil, fmt.Errorf("getAlertEvents: %w", err)
+	}
+	if gb := p.GroupByClause(""); gb != "" {
+		query += " " + gb
+	}
+	query += " " + p.OrderByClause("dashboard_events.dash_begin DESC, dashboard_events.id DESC")
+	query, args = appendLimitOffset(query, args, p.Limit, p.Offset)
+
+	rows, err := oDb.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("getAlertEvents: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	return scanRowsToMaps(rows, p.Props, p.TypeHints)
+}
+
+// AlertByID returns the dashboard alert with the id, or nil if it does not
+// exist.
+func (oDb *DB) AlertByID(ctx context.Context, id int64) (*DashboardAlert, error) {
+	query := `SELECT d.id, ` + DashboardAlertKeyExpr("d") + `,
+		  d.dash_type, d.dash_severity,
+		  COALESCE(d.node_id, ""), COALESCE(n.nodename, ""),
+		  COALESCE(d.svc_id, ""), COALESCE(s.svcname, ""),
+		  COALESCE(d.dash_env, ""), COALESCE(s.svc_app, n.app, ""),
+		  COALESCE(d.dash_fmt, ""), COALESCE(d.dash_dict, ""), d.dash_created
+		FROM dashboard d
+		LEFT JOIN nodes n ON n.node_id = d.node_id
+		LEFT JOIN services s ON s.svc_id = d.svc_id
+		WHERE d.id = ?`
+	var a DashboardAlert
+	err := oDb.DB.QueryRowContext(ctx, query, id).Scan(&a.ID, &a.Key, &a.Type, &a.Severity, &a.NodeID, &a.Nodename,
+		&a.SvcID, &a.Svcname, &a.Env, &a.App, &a.Fmt, &a.Dict, &a.Created)
+	switch err {
+	case nil:
+		return &a, nil
+	case sql.ErrNoRows:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("get alert %d: %w", id, err)
+	}
+}
+
+// AlertResponsible returns true if one of the groups is responsible for the
+// node app or the service app of the dashboard alert, like in the alerts
+// listings.
+func (oDb *DB) AlertResponsible(ctx context.Context, alertID int64, groups []string) (bool, error) {
+	cond, args := alertResponsibleCond(groups)
+	query := "SELECT COUNT(*) FROM dashboard WHERE dashboard.id = ? AND " + cond
+	var n int
+	if err := oDb.DB.QueryRowContext(ctx, query, append([]any{alertID}, args...)...).Scan(&n); err != nil {
+		return false, fmt.Errorf("check alert %d responsible: %w", alertID, err)
+	}
+	return n > 0, nil
+}
+
+// InsertDashboardAck acknowledges a dashboard alert and returns the
+// acknowledgement id.
+func (oDb *DB) InsertDashboardAck(ctx context.Context, ack DashboardAck) (int64, error) {
+	query := `INSERT INTO dashboard_ack (alert_key, dash_type, node_id, svc_id, ack_begin, ack_end, ack_comment, acked_by, acked_on)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	result, err := oDb.DB.ExecContext(ctx, query, ack.AlertKey, ack.Type, ack.NodeID, ack.SvcID, ack.Begin, ack.End,
+		ack.Comment, ack.AckedBy, ack.AckedOn)
+	if err != nil {
+		return 0, fmt.Errorf("insert dashboard ack: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("insert dashboard ack: %w", err)
+	}
+	oDb.SetChange("dashboard_ack")
+	return id, nil
+}
+
+// InsertSvcmonLogAck records an acknowledged unavailability period of the
+// service, consulted by ObjectInAckUnavailabilityPeriod. The period is
+// accounted as unavailability in the availability reports if account is
+// set.
+func (oDb *DB) InsertSvcmonLogAck(ctx context.Context, svcID string, begin, end time.Time, comment, ackedBy string, account bool) error {
+	query := `INSERT INTO svcmon_log_ack (svc_id, mon_begin, mon_end, mon_comment, mon_acked_by, mon_acked_on, mon_account)
+		VALUES (?, ?, ?, ?, ?, NOW(), ?)`
+	if _, err := oDb.DB.ExecContext(ctx, query, svcID, begin, end, comment, ackedBy, account); err != nil {
+		return fmt.Errorf("insert svcmon_log_ack %s: %w", svcID, err)
+	}
+	oDb.SetChange("svcmon_log_ack")
+	return nil
+}
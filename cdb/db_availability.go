@@ -0,0 +1,287 @@
+package cdb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+type (
+	// StatusInterval is a services_log or svcmon_log status period. The
+	// NodeID and OverallStatus are empty for the services_log intervals.
+	StatusInterval struct {
+		SvcID         string
+		NodeID        string
+		AvailStatus   string
+		OverallStatus string
+		Begin         time.Time
+		End           time.Time
+	}
+
+	// AckWindow is an acknowledged unavailability period of a service not
+	// accounted in the availability reports.
+	AckWindow struct {
+		SvcID string
+		Begin time.Time
+		End   time.Time
+	}
+
+	// AvailabilityDaily is the daily rollup of the service (NodeID empty)
+	// or instance status durations, in seconds.
+	//
+	//	CREATE TABLE `svc_availability_daily` (
+	//	  `day` date NOT NULL,
+	//	  `svc_id` char(36) NOT NULL,
+	//	  `node_id` char(36) NOT NULL DEFAULT '',
+	//	  `up` int(11) NOT NULL DEFAULT 0,
+	//	  `down` int(11) NOT NULL DEFAULT 0,
+	//	  `degraded` int(11) NOT NULL DEFAULT 0,
+	//	  `excluded` int(11) NOT NULL DEFAULT 0,
+	//	  `unknown` int(11) NOT NULL DEFAULT 0,
+	//	  `updated` datetime NOT NULL,
+	//	  PRIMARY KEY (`svc_id`, `node_id`, `day`),
+	//	  KEY `k_day` (`day`)
+	//	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
+	AvailabilityDaily struct {
+		Day    time.Time
+		SvcID  string
+		NodeID string
+
+		// Nodename is only set by AvailabilityDailySums
+		Nodename string
+
+		Up       int64
+		Down     int64
+		Degraded int64
+		Excluded int64
+		Unknown  int64
+	}
+
+	// ServiceName is a service id and name.
+	ServiceName struct {
+		SvcID   string
+		Svcname string
+	}
+)
+
+const (
+	availabilityDailyBatchSize = 500
+)
+
+// ServicesLogIntervals returns the services_log and services_log_last
+// intervals overlapping the [from, until) period, of all services if
+// svcIDs is empty. The services_log_last interval ends at the last
+// received status.
+func (oDb *DB) ServicesLogIntervals(ctx context.Context, from, until time.Time, svcIDs ...string) ([]StatusInterval, error) {
+	defer logDuration("ServicesLogIntervals", time.Now())
+	filter, filterArgs := svcIDsFilter(svcIDs)
+	query := `SELECT svc_id, svc_availstatus, svc_begin, svc_end FROM services_log
+		WHERE svc_begin < ? AND svc_end > ?` + filter + `
+		UNION ALL
+		SELECT svc_id, svc_availstatus, svc_begin, svc_end FROM services_log_last
+		WHERE svc_begin < ? AND svc_end > ?` + filter
+	args := append([]any{until, from}, filterArgs...)
+	args = append(args, until, from)
+	args = append(args, filterArgs...)
+	rows, err := oDb.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("get services log intervals: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+	var l []StatusInterval
+	for rows.Next() {
+		var i StatusInterval
+		if err := rows.Scan(&i.SvcID, &i.AvailStatus, &i.Begin, &i.End); err != nil {
+			return nil, fmt.Errorf("get services log intervals: %w", err)
+		}
+		l = append(l, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get services log intervals: %w", err)
+	}
+	return l, nil
+}
+
+// SvcmonLogIntervals returns the svcmon_log and svcmon_log_last instance
+// intervals overlapping the [from, until) period, of all services if
+// svcIDs is empty. The svcmon_log_last interval ends at the last received
+// status.
+func (oDb *DB) SvcmonLogIntervals(ctx context.Context, from, until time.Time, svcIDs ...string) ([]StatusInterval, error) {
+	defer logDuration("SvcmonLogIntervals", time.Now())
+	filter, filterArgs := svcIDsFilter(svcIDs)
+	query := `SELECT svc_id, node_id, COALESCE(mon_availstatus, ""), COALESCE(mon_overallstatus, ""), mon_begin, mon_end
+		FROM svcmon_log
+		WHERE mon_begin < ? AND mon_end > ? AND svc_id IS NOT NULL AND node_id IS NOT NULL` + filter + `
+		UNION ALL
+		SELECT svc_id, node_id, COALESCE(mon_availstatus, ""), COALESCE(mon_overallstatus, ""), mon_begin, mon_end
+		FROM svcmon_log_last
+		WHERE mon_begin < ? AND mon_end > ? AND svc_id IS NOT NULL AND node_id IS NOT NULL` + filter
+	args := append([]any{until, from}, filterArgs...)
+	args = append(args, until, from)
+	args = append(args, filterArgs...)
+	rows, err := oDb.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("get svcmon log intervals: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+	var l []StatusInterval
+	for rows.Next() {
+		var i StatusInterval
+		if err := rows.Scan(&i.SvcID, &i.NodeID, &i.AvailStatus, &i.OverallStatus, &i.Begin, &i.End); err != nil {
+			return nil, fmt.Errorf("get svcmon log intervals: %w", err)
+		}
+		l = append(l, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get svcmon log intervals: %w", err)
+	}
+	return l, nil
+}
+
+// AvailabilityAckWindows returns the svcmon_log_ack periods overlapping
+// the [from, until) period and not accounted in the availability reports
+// (mon_account = 0), of all services if svcIDs is empty.
+func (oDb *DB) AvailabilityAckWindows(ctx context.Context, from, until time.Time, svcIDs ...string) ([]AckWindow, error) {
+	filter, filterArgs := svcIDsFilter(svcIDs)
+	query := `SELECT svc_id, mon_begin, mon_end FROM svcmon_log_ack
+		WHERE mon_begin < ? AND mon_end > ? AND mon_account = 0 AND svc_id IS NOT NULL` + filter
+	args := append([]any{until, from}, filterArgs...)
+	rows, err := oDb.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("get availability ack windows: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+	var l []AckWindow
+	for rows.Next() {
+		var w AckWindow
+		if err := rows.Scan(&w.SvcID, &w.Begin, &w.End); err != nil {
+			return nil, fmt.Errorf("get availability ack windows: %w", err)
+		}
+		l = append(l, w)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get availability ack windows: %w", err)
+	}
+	return l, nil
+}
+
+// UpsertAvailabilityDaily inserts or replaces the daily rollups.
+func (oDb *DB) UpsertAvailabilityDaily(ctx context.Context, l []AvailabilityDaily) error {
+	for chunk := range slices.Chunk(l, availabilityDailyBatchSize) {
+		placeholders := make([]string, len(chunk))
+		args := make([]any, 0, 8*len(chunk))
+		for i, a := range chunk {
+			placeholders[i] = "(?, ?, ?, ?, ?, ?, ?, ?, NOW())"
+			args = append(args, a.Day.Format(time.DateOnly), a.SvcID, a.NodeID, a.Up, a.Down, a.Degraded, a.Excluded, a.Unknown)
+		}
+		query := `INSERT INTO svc_availability_daily (day, svc_id, node_id, up, down, degraded, excluded, unknown, updated)
+			VALUES ` + strings.Join(placeholders, ", ") + `
+			ON DUPLICATE KEY UPDATE
+			  up = VALUES(up),
+			  down = VALUES(down),
+			  degraded = VALUES(degraded),
+			  excluded = VALUES(excluded),
+			  unknown = VALUES(unknown),
+			  updated = VALUES(updated)`
+		if _, err := oDb.DB.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("upsert svc_availability_daily: %w", err)
+		}
+	}
+	if len(l) > 0 {
+		oDb.SetChange("svc_availability_daily")
+	}
+	return nil
+}
+
+// PurgeAvailabilityDailyOrphans deletes the rollups of the deleted services
+// and of the instances on deleted nodes.
+func (oDb *DB) PurgeAvailabilityDailyOrphans(ctx context.Context) error {
+	const query = `DELETE a FROM svc_availability_daily a
+		LEFT JOIN services s ON s.svc_id = a.svc_id
+		LEFT JOIN nodes n ON n.node_id = a.node_id
+		WHERE
+		  s.svc_id IS NULL OR
+		  (a.node_id != "" AND n.node_id IS NULL)`
+	if count, err := oDb.execCountContext(ctx, query); err != nil {
+		return fmt.Errorf("purge svc_availability_daily orphans: %w", err)
+	} else if count > 0 {
+		oDb.SetChange("svc_availability_daily")
+	}
+	return nil
+}
+
+// AvailabilityDailySums returns the rollups of the services and their
+// instances summed over the days from the first to the last included, by
+// svc_id and node_id, with the instance nodenames. The returned Day is
+// zero.
+func (oDb *DB) AvailabilityDailySums(ctx context.Context, first, last time.Time, svcIDs ...string) ([]AvailabilityDaily, error) {
+	if len(svcIDs) == 0 {
+		return nil, nil
+	}
+	filter, filterArgs := svcIDsFilter(svcIDs)
+	query := `SELECT a.svc_id, a.node_id, COALESCE(n.nodename, ""), a.up, a.down, a.degraded, a.excluded, a.unknown
+		FROM (
+		  SELECT svc_id, node_id, SUM(up) AS up, SUM(down) AS down, SUM(degraded) AS degraded,
+		    SUM(excluded) AS excluded, SUM(unknown) AS unknown
+		  FROM svc_availability_daily
+		  WHERE day >= ? AND day <= ?` + filter + `
+		  GROUP BY svc_id, node_id
+		) a
+		LEFT JOIN nodes n ON n.node_id = a.node_id AND a.node_id != ""
+		ORDER BY a.svc_id, a.node_id`
+	args := append([]any{first.Format(time.DateOnly), last.Format(time.DateOnly)}, filterArgs...)
+	rows, err := oDb.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("get availability daily sums: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+	var l []AvailabilityDaily
+	for rows.Next() {
+		var a AvailabilityDaily
+		if err := rows.Scan(&a.SvcID, &a.NodeID, &a.Nodename, &a.Up, &a.Down, &a.Degraded, &a.Excluded, &a.Unknown); err != nil {
+			return nil, fmt.Errorf("get availability daily sums: %w", err)
+		}
+		l = append(l, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get availability daily sums: %w", err)
+	}
+	return l, nil
+}
+
+// AppServiceNames returns the services of the app, ordered by name.
+func (oDb *DB) AppServiceNames(ctx context.Context, app string) ([]ServiceName, error) {
+	query := `SELECT svc_id, COALESCE(svcname, "") FROM services WHERE svc_app = ? ORDER BY svcname`
+	rows, err := oDb.DB.QueryContext(ctx, query, app)
+	if err != nil {
+		return nil, fmt.Errorf("get app %s services: %w", app, err)
+	}
+	defer func() { _ = rows.Close() }()
+	var l []ServiceName
+	for rows.Next() {
+		var s ServiceName
+		if err := rows.Scan(&s.SvcID, &s.Svcname); err != nil {
+			return nil, fmt.Errorf("get app %s services: %w", app, err)
+		}
+		l = append(l, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get app %s services: %w", app, err)
+	}
+	return l, nil
+}
+
+// svcIDsFilter returns the sql condition, starting with AND, selecting the
+// svc_id column in svcIDs, or an empty condition if svcIDs is empty.
+func svcIDsFilter(svcIDs []string) (string, []any) {
+	if len(svcIDs) == 0 {
+		return "", nil
+	}
+	args := make([]any, len(svcIDs))
+	for i, s := range svcIDs {
+		args[i] = s
+	}
+	return " AND svc_id IN (" + Placeholders(len(svcIDs)) + ")", args
+}
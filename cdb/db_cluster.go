@@ -2,6 +2,9 @@ package cdb
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/opensvc/oc3/schema"
 )
 
 func (oDb *DB) UpdateClustersData(ctx context.Context, clusterName, clusterID, data string) error {
@@ -22,3 +25,143 @@ func (oDb *DB) UpdateClustersData(ctx context.Context, clusterName, clusterID, d
 	_, err := oDb.ExecContext(ctx, query, clusterName, clusterID, data, clusterName, data)
 	return err
 }
+
+// ClusterNodeIDs returns the node ids of the cluster.
+func (oDb *DB) ClusterNodeIDs(ctx context.Context, clusterID string) ([]string, error) {
+	rows, err := oDb.DB.QueryContext(ctx, "SELECT node_id FROM nodes WHERE cluster_id = ? ORDER BY nodename", clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("clusterNodeIDs: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+	var l []string
+	for rows.Next() {
+		var nodeID string
+		if err := rows.Scan(&nodeID); err != nil {
+			return nil, fmt.Errorf("clusterNodeIDs: %w", err)
+		}
+		l = append(l, nodeID)
+	}
+	return l, rows.Err()
+}
+
+// buildClustersQuery returns the clusters query. The non-manager users only
+// see the clusters with at least one node of an app they are responsible
+// for.
+//
+// The query defines the cluster_nodes and cluster_services common table
+// expressions, the nodes and services the user is responsible for, so the
+// select expressions summarizing the cluster nodes and services apply the
+// same responsibility filter as GetClusterNodes and GetClusterServices.
+func buildClustersQuery(groups []string, isManager bool, selectExprs []string) (string, []any) {
+	nodesQuery, nodesArgs := buildNodesQuery(groups, isManager, []string{"nodes.*"})
+	servicesQuery, servicesArgs := buildServicesQuery(groups, isManager, []string{"services.*"})
+	with := "WITH cluster_nodes AS (" + nodesQuery + "), cluster_services AS (" + servicesQuery + ")\n"
+	withArgs := append(nodesArgs, servicesArgs...)
+
+	q := From(schema.TClusters).
+		RawSelect(selectExprs...)
+
+	if !isManager {
+		cleanGroups := cleanGroups(groups)
+		if len(cleanGroups) == 0 {
+			q = q.WhereRaw("1=0")
+		} else {
+			args := make([]any, len(cleanGroups))
+			for i, g := range cleanGroups {
+				args[i] = g
+			}
+			q = q.WhereRaw(
+				"clusters.cluster_id IN ("+
+					"SELECT n.cluster_id FROM nodes n"+
+					" JOIN apps a ON n.app = a.app"+
+					" JOIN apps_responsibles ar ON ar.app_id = a.id"+
+					" JOIN auth_group ag ON ag.id = ar.group_id"+
+					" WHERE ag.role IN ("+Placeholders(len(cleanGroups))+")"+
+					")",
+				args...,
+			)
+		}
+	} else {
+		q = q.Where(schema.ClustersID, ">", 0)
+	}
+
+	query, args, err := q.Build()
+	if err != nil {
+		panic(fmt.Sprintf("buildClustersQuery: %v", err))
+	}
+	return with + query, append(withArgs, args...)
+}
+
+func (oDb *DB) GetClusters(ctx context.Context, p ListParams) ([]map[string]any, error) {
+	query, args := buildClustersQuery(p.Groups, p.IsManager, p.SelectExprs)
+	if gb := p.GroupByClause(""); gb != "" {
+		query += " " + gb
+	}
+	query += " " + p.OrderByClause("clusters.cluster_name")
+	query, args = appendLimitOffset(query, args, p.Limit, p.Offset)
+
+	rows, err := oDb.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("getClusters: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	return scanRowsToMaps(rows, p.Props, p.TypeHints)
+}
+
+// GetCluster fetches a single cluster by cluster_id.
+func (oDb *DB) GetCluster(ctx context.Context, clusterID string, p ListParams) ([]map[string]any, error) {
+	query, args := buildClustersQuery(p.Groups, p.IsManager, p.SelectExprs)
+	query += " AND clusters.cluster_id = ?"
+	args = append(args, clusterID)
+	query, args = appendLimitOffset(query, args, p.Limit, p.Offset)
+
+	rows, err := oDb.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("getCluster: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	return scanRowsToMaps(rows, p.Props, p.TypeHints)
+}
+
+// GetClusterNodes returns the cluster nodes the user is responsible for.
+func (oDb *DB) GetClusterNodes(ctx context.Context, clusterID string, p ListParams) ([]map[string]any, error) {
+	query, args := buildNodesQuery(p.Groups, p.IsManager, p.SelectExprs)
+	query += " AND nodes.cluster_id = ?"
+	args = append(args, clusterID)
+	if gb := p.GroupByClause(""); gb != "" {
+		query += " " + gb
+	}
+	query += " " + p.OrderByClause("nodes.nodename")
+	query, args = appendLimitOffset(query, args, p.Limit, p.Offset)
+
+	rows, err := oDb.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("getClusterNodes: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	return scanRowsToMaps(rows, p.Props, p.TypeHints)
+}
+
+// GetClusterServices returns the cluster services the user is responsible
+// for.
+func (oDb *DB) GetClusterServices(ctx context.Context, clusterID string, p ListParams) ([]map[string]any, error) {
+	query, args := buildServicesQuery(p.Groups, p.IsManager, p.SelectExprs)
+	query += " AND services.cluster_id = ?"
+	args = append(args, clusterID)
+	if gb := p.GroupByClause(""); gb != "" {
+		query += " " + gb
+	}
+	query += " " + p.OrderByClause("services.svcname")
+	query, args = appendLimitOffset(query, args, p.Limit, p.Offset)
+
+	rows, err := oDb.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("getClusterServices: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	return scanRowsToMaps(rows, p.Props, p.TypeHints)
+}
@@ -269,12 +269,13 @@ func (oDb *DB) PurgeAlertsOnDeletedServices(ctx context.Context) error {
 }
 
 func (oDb *DB) DashboardUpdateNodesNotUpdated(ctx context.Context) error {
+	sev, keep := alertSeverity(`"node information not updated"`, "nodes.node_env", "nodes.node_id", `""`, "0")
 	request := `INSERT INTO dashboard
                SELECT
                  NULL,
                  "node information not updated",
                  "",
-                 0,
+                 ` + sev + `,
                  "",
                  "",
                  updated,
@@ -285,8 +286,9 @@ func (oDb *DB) DashboardUpdateNodesNotUpdated(ctx context.Context) error {
                  NULL,
                  NULL
                FROM nodes
-               WHERE updated < date_sub(NOW(), interval 25 hour)
+               WHERE updated < date_sub(NOW(), interval 25 hour) AND ` + keep + `
                ON DUPLICATE KEY UPDATE
+                 dash_severity=VALUES(dash_severity),
                  dash_updated=NOW()`
 	if count, err := oDb.execCountContext(ctx, request); err != nil {
 		return err
@@ -344,13 +346,14 @@ func (oDb *DB) DashboardUpdateChecksNotUpdated(ctx context.Context) error {
 	} else if count > 0 {
 		oDb.SetChange("dashboard")
 	}
+	sev, keep := alertSeverity(`"check value not updated"`, "n.node_env", "c.node_id", `""`, `IF(n.node_env = "PRD", 1, 0)`)
 	request = `
 	INSERT INTO dashboard
 	SELECT
 	    NULL,
 	    "check value not updated",
 	    "",
-	    IF(n.node_env = "PRD", 1, 0),
+	    ` + sev + `,
 	    "%(t)s:%(i)s",
 	    CONCAT('{"i":"', chk_instance, '", "t":"', chk_type, '"}'),
 	    chk_updated,
@@ -362,8 +365,8 @@ func (oDb *DB) DashboardUpdateChecksNotUpdated(ctx context.Context) error {
 	    CONCAT(chk_type, ":", chk_instance)
 	FROM checks_live c
 	JOIN nodes n ON c.node_id = n.node_id
-	WHERE chk_updated < DATE_SUB(NOW(), INTERVAL 1 DAY)
-	ON DUPLICATE KEY UPDATE dash_updated = NOW();
+	WHERE chk_updated < DATE_SUB(NOW(), INTERVAL 1 DAY) AND ` + keep + `
+	ON DUPLICATE KEY UPDATE dash_severity = VALUES(dash_severity), dash_updated = NOW();
 	`
 	if count, err := oDb.execCountContext(ctx, request); err != nil {
 		return err
@@ -385,6 +388,13 @@ func (oDb *DB) AlertActionErrors(ctx context.Context, line BActionErrorCount) er
 		env = "TST"
 		severity = 3
 	}
+	decision, err := oDb.resolveAlert(ctx, "action errors", line.NodeID, line.SvcID, env, severity)
+	if err != nil {
+		return err
+	} else if decision.Suppress {
+		return nil
+	}
+	severity = decision.Severity
 	request := `
                  INSERT INTO dashboard
                  SET
@@ -426,13 +436,14 @@ func (oDb *DB) DashboardDeleteActionErrorsWithNoError(ctx context.Context) error
 }
 
 func (oDb *DB) DashboardUpdateServiceConfigNotUpdated(ctx context.Context) error {
+	sev, keep := alertSeverity(`"service configuration not updated"`, "services.svc_env", `""`, "services.svc_id", `IF(services.svc_env="PRD", 1, 0)`)
 	request := `
 	     INSERT INTO dashboard
              SELECT
                NULL,
                "service configuration not updated",
                svc_id,
-               IF(svc_env="PRD", 1, 0),
+               ` + sev + `,
                "",
                "",
                updated,
@@ -443,8 +454,9 @@ func (oDb *DB) DashboardUpdateServiceConfigNotUpdated(ctx context.Context) error
                NULL,
                NULL
              FROM services
-             WHERE updated < DATE_SUB(NOW(), INTERVAL 25 HOUR)
+             WHERE updated < DATE_SUB(NOW(), INTERVAL 25 HOUR) AND ` + keep + `
              ON DUPLICATE KEY UPDATE
+               dash_severity=VALUES(dash_severity),
                dash_updated=NOW()
 	`
 	if count, err := oDb.execCountContext(ctx, request); err != nil {
@@ -456,13 +468,14 @@ func (oDb *DB) DashboardUpdateServiceConfigNotUpdated(ctx context.Context) error
 }
 
 func (oDb *DB) DashboardUpdateInstancesNotUpdated(ctx context.Context) error {
+	sev, keep := alertSeverity(`"service status not updated"`, "svcmon.mon_svctype", "svcmon.node_id", "svcmon.svc_id", `IF(svcmon.mon_svctype="PRD", 1, 0)`)
 	request := `
 		INSERT INTO dashboard
 		SELECT
 		  NULL,
 		  "service status not updated",
 		  svc_id,
-		  IF(mon_svctype="PRD", 1, 0),
+		  ` + sev + `,
 		  "",
 		  "",
 		  mon_updated,
@@ -473,8 +486,9 @@ func (oDb *DB) DashboardUpdateInstancesNotUpdated(ctx context.Context) error {
 		  NULL,
 		  NULL
 		FROM svcmon
-		WHERE mon_updated < DATE_SUB(NOW(), INTERVAL 16 MINUTE)
+		WHERE mon_updated < DATE_SUB(NOW(), INTERVAL 16 MINUTE) AND ` + keep + `
 		ON DUPLICATE KEY UPDATE
+		  dash_severity=VALUES(dash_severity),
 		  dash_updated=NOW()
 	`
 	if count, err := oDb.execCountContext(ctx, request); err != nil {
@@ -512,13 +526,14 @@ func (oDb *DB) DashboardUpdateNodeMaintenanceExpired(ctx context.Context) error
 		return err
 	}
 
+	sev, keep := alertSeverity(`"node maintenance expired"`, "nodes.node_env", "nodes.node_id", `""`, "1")
 	request = `
 		INSERT INTO dashboard
 		SELECT
 		  NULL,
                   "node maintenance expired",
                   "",
-                  1,
+                  ` + sev + `,
                   "",
                   "",
                   @now,
@@ -532,8 +547,10 @@ func (oDb *DB) DashboardUpdateNodeMaintenanceExpired(ctx context.Context) error
                  WHERE
                    maintenance_end IS NOT NULL AND
                    maintenance_end != "0000-00-00 00:00:00" AND
-                   maintenance_end < @now
+                   maintenance_end < @now AND
+                   ` + keep + `
 		ON DUPLICATE KEY UPDATE
+		  dash_severity=VALUES(dash_severity),
 		  dash_updated=@now
 	`
 	if count, err := oDb.execCountContext(ctx, request); err != nil {
@@ -565,13 +582,14 @@ func (oDb *DB) DashboardUpdateNodeCloseToMaintenanceEnd(ctx context.Context) err
 		return err
 	}
 
+	sev, keep := alertSeverity(`"node close to maintenance end"`, "nodes.node_env", "nodes.node_id", `""`, "0")
 	request = `
 		INSERT INTO dashboard
 		SELECT
 		  NULL,
 		  "node close to maintenance end",
 		  "",
-		  0,
+		  ` + sev + `,
 		  "",
 		  "",
 		  @now,
@@ -586,8 +604,10 @@ func (oDb *DB) DashboardUpdateNodeCloseToMaintenanceEnd(ctx context.Context) err
 		  maintenance_end IS NOT NULL AND
 		  maintenance_end != "0000-00-00 00:00:00" AND
 		  maintenance_end > DATE_SUB(@now, INTERVAL 30 DAY) AND
-		  maintenance_end > @now
+		  maintenance_end > @now AND
+		  ` + keep + `
 		ON DUPLICATE KEY UPDATE
+		  dash_severity=VALUES(dash_severity),
 		  dash_updated=@now
 	`
 	if count, err := oDb.execCountContext(ctx, request); err != nil {
@@ -619,13 +639,14 @@ func (oDb *DB) DashboardUpdateNodeWithoutMaintenanceEnd(ctx context.Context) err
 		return err
 	}
 
+	sev, keep := alertSeverity(`"node without maintenance end date"`, "nodes.node_env", "nodes.node_id", `""`, "0")
 	request = `
 		INSERT INTO dashboard
 		SELECT
 		  NULL,
 		  "node without maintenance end date",
 		  "",
-		  0,
+		  ` + sev + `,
 		  "",
 		  "",
 		  @now,
@@ -644,8 +665,10 @@ func (oDb *DB) DashboardUpdateNodeWithoutMaintenanceEnd(ctx context.Context) err
                   maintenance_end = "0000-00-00 00:00:00") AND
                  model not like "%virt%" AND
                  model not like "%Not Specified%" AND
-                 model not like "%KVM%"
+                 model not like "%KVM%" AND
+                 ` + keep + `
 		ON DUPLICATE KEY UPDATE
+		  dash_severity=VALUES(dash_severity),
 		  dash_updated=@now
 	`
 	if count, err := oDb.execCountContext(ctx, request); err != nil {
@@ -706,13 +729,14 @@ func (oDb *DB) DashboardUpdateAppWithoutResponsible(ctx context.Context) error {
 		oDb.SetChange("dashboard")
 	}
 
+	sev, keep := alertSeverity(`"application code without responsible"`, `""`, `""`, `""`, "2")
 	request = `
 		INSERT INTO dashboard
 		SELECT
 		  NULL,
 		  "application code without responsible",
 		  "",
-		  2,
+		  ` + sev + `,
 		  "%(a)s",
 		  JSON_OBJECT("a", a.app),
 		  NOW(),
@@ -724,8 +748,10 @@ func (oDb *DB) DashboardUpdateAppWithoutResponsible(ctx context.Context) error {
 		  a.app
 		FROM apps a LEFT JOIN apps_responsibles ar ON a.id=ar.app_id
 		WHERE
-		  ar.group_id IS NULL
+		  ar.group_id IS NULL AND
+		  ` + keep + `
 		ON DUPLICATE KEY UPDATE
+		  dash_severity=VALUES(dash_severity),
 		  dash_updated=NOW()
 	`
 	if count, err := oDb.execCountContext(ctx, request); err != nil {
@@ -835,6 +861,13 @@ func (oDb *DB) DashboardUpdatePkgDiffForNode(ctx context.Context, nodeID string)
 		if monSvctype == "PRD" {
 			sev = 1
 		}
+		decision, err := oDb.resolveAlert(ctx, "package differences in cluster", "", svcID, monSvctype, sev)
+		if err != nil {
+			return fmt.Errorf("failed to resolve the alert severity: %v", err)
+		} else if decision.Suppress {
+			return nil
+		}
+		sev = decision.Severity
 
 		// truncate too long node names list
 		skip := 0
@@ -1011,6 +1044,13 @@ func (oDb *DB) DashboardUpdateCompModDiffForSvc(ctx context.Context, svcID strin
 	if monSvctype.String == "PRD" {
 		sev = 1
 	}
+	decision, err := oDb.resolveAlert(ctx, "compliance moduleset attachment differences in cluster", "", svcID, monSvctype.String, sev)
+	if err != nil {
+		return fmt.Errorf("failed to resolve the alert severity: %v", err)
+	} else if decision.Suppress {
+		return nil
+	}
+	sev = decision.Severity
 
 	// Tronquer la liste des nodes si trop longue
 	skip := 0
@@ -1083,6 +1123,7 @@ func (oDb *DB) DashboardUpdateCompModDiffForSvc(ctx context.Context, svcID strin
 			dash_updated = @now,
 			dash_env = ?
 		ON DUPLICATE KEY UPDATE
+			dash_severity = VALUES(dash_severity),
 			dash_updated = @now
 	`
 	_, err = oDb.ExecContext(ctx, query, svcID, sev, dashDictJSON, dashDictMD5, monSvctype.String)
@@ -1179,6 +1220,13 @@ func (oDb *DB) DashboardUpdateCompRsetDiffForSvc(ctx context.Context, svcID stri
 	if monSvctype.String == "PRD" {
 		sev = 1
 	}
+	decision, err := oDb.resolveAlert(ctx, "compliance ruleset attachment differences in cluster", "", svcID, monSvctype.String, sev)
+	if err != nil {
+		return fmt.Errorf("failed to resolve the alert severity: %v", err)
+	} else if decision.Suppress {
+		return nil
+	}
+	sev = decision.Severity
 
 	// Tronquer la liste des nodes si trop longue
 	skip := 0
@@ -1253,6 +1301,7 @@ func (oDb *DB) DashboardUpdateCompRsetDiffForSvc(ctx context.Context, svcID stri
 			dash_updated = @now,
 			dash_env = ?
 		ON DUPLICATE KEY UPDATE
+			dash_severity = VALUES(dash_severity),
 			dash_updated = @now
 	`
 	_, err = oDb.ExecContext(ctx, query, svcID, sev, dashDictJSON, dashDictMD5, monSvctype.String)
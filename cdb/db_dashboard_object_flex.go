@@ -119,6 +119,7 @@ func (oDb *DB) DashboardUpdateObjectFlexStartedBatch(ctx context.Context, l ...*
            OR (t.svc_flex_max_nodes > 0 AND t.up > t.svc_flex_max_nodes)
         ON DUPLICATE KEY UPDATE 
             dash_updated = NOW(),
+            dash_severity = VALUES(dash_severity),
             dash_dict = VALUES(dash_dict),
             dash_dict_md5 = VALUES(dash_dict_md5)
     `, unionClause)
@@ -3,8 +3,11 @@ package cdb
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
+
+	"github.com/opensvc/oc3/schema"
 )
 
 type (
@@ -224,8 +227,8 @@ func (oDb *DB) HBLogLastExtend(ctx context.Context, l ...*DBHeartbeatLog) error
 	}
 	placeholders := strings.Repeat("(?,?,?),", len(l)-1) + "(?,?,?)"
 
-	query := fmt.Sprintf("UPDATE `hbmon_log_last` SET `end` = NOW() WHERE (`node_id`,`peer_node_id`,`name`) IN %s)", placeholders)
-	args := make([]any, 3*len(l))
+	query := fmt.Sprintf("UPDATE `hbmon_log_last` SET `end` = NOW() WHERE (`node_id`,`peer_node_id`,`name`) IN (%s)", placeholders)
+	args := make([]any, 0, 3*len(l))
 	for _, v := range l {
 		args = append(args, v.NodeID, v.PeerNodeID, v.Name)
 	}
@@ -263,6 +266,148 @@ func (oDb *DB) HBLogUpdate(ctx context.Context, l ...*DBHeartbeatLog) error {
 	return nil
 }
 
+// GetClusterHeartbeats returns the hbmon node, peer and heartbeat name
+// matrix of the cluster.
+func (oDb *DB) GetClusterHeartbeats(ctx context.Context, clusterID string, p ListParams) ([]map[string]any, error) {
+	q := From(schema.THbmon).
+		RawSelect(p.SelectExprs...).
+		Where(schema.HbmonClusterID, "=", clusterID)
+	query, args, err := q.Build()
+	if err != nil {
+		return nil, fmt.Errorf("getClusterHeartbeats: %w", err)
+	}
+	if gb := p.GroupByClause(""); gb != "" {
+		query += " " + gb
+	}
+	query += " " + p.OrderByClause("hbmon.node_id, hbmon.peer_node_id, hbmon.name")
+	query, args = appendLimitOffset(query, args, p.Limit, p.Offset)
+
+	rows, err := oDb.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("getClusterHeartbeats: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	return scanRowsToMaps(rows, p.Props, p.TypeHints)
+}
+
+// GetClusterHeartbeatsLog returns the hbmon_log state transitions of the
+// cluster heartbeats overlapping the [begin, end) period, and the current
+// states from hbmon_log_last, whose end is the last received status. A zero
+// begin or end leaves the period open on that side.
+func (oDb *DB) GetClusterHeartbeatsLog(ctx context.Context, clusterID string, begin, end time.Time, p ListParams) ([]map[string]any, error) {
+	if len(p.SelectExprs) == 0 {
+		return nil, fmt.Errorf("getClusterHeartbeatsLog: no columns selected")
+	}
+	filter := "WHERE `cluster_id` = ?"
+	filterArgs := []any{clusterID}
+	if !begin.IsZero() {
+		filter += " AND `end` > ?"
+		filterArgs = append(filterArgs, begin)
+	}
+	if !end.IsZero() {
+		filter += " AND `begin` < ?"
+		filterArgs = append(filterArgs, end)
+	}
+	const cols = "`id`, `cluster_id`, `node_id`, `peer_node_id`, `name`, `state`, `beating`, `begin`, `end`"
+
+	// the union is aliased hbmon_log so the select expressions address
+	// both tables rows
+	query := "SELECT " + strings.Join(p.SelectExprs, ", ") + "\nFROM (" +
+		"SELECT " + cols + " FROM `hbmon_log` " + filter +
+		" UNION ALL " +
+		"SELECT " + cols + " FROM `hbmon_log_last` " + filter +
+		") hbmon_log"
+	args := append(slices.Clone(filterArgs), filterArgs...)
+	if gb := p.GroupByClause(""); gb != "" {
+		query += " " + gb
+	}
+	query += " " + p.OrderByClause("hbmon_log.begin DESC, hbmon_log.id DESC")
+	query, args = appendLimitOffset(query, args, p.Limit, p.Offset)
+
+	rows, err := oDb.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("getClusterHeartbeatsLog: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	return scanRowsToMaps(rows, p.Props, p.TypeHints)
+}
+
+// AlertHeartbeatPathDown raises a "heartbeat path down" dashboard alert on
+// the nodes seeing a peer through a running heartbeat that stopped beating,
+// while other heartbeats to the same peer still beat. Losing the remaining
+// paths would split the cluster, so these alerts are split-brain
+// precursors. The alerts no longer matching are deleted.
+func (oDb *DB) AlertHeartbeatPathDown(ctx context.Context) error {
+	var now time.Time
+	if err := oDb.DB.QueryRowContext(ctx, "SELECT NOW()").Scan(&now); err != nil {
+		return fmt.Errorf("alertHeartbeatPathDown: %w", err)
+	}
+	sev, keep := alertSeverity(`"heartbeat path down"`, "nodes.node_env", "hb.node_id", `""`, `IF(nodes.node_env = "PRD", 4, 3)`)
+	request := `
+		INSERT INTO dashboard (
+		  dash_type, dash_severity, node_id, svc_id,
+		  dash_fmt, dash_dict, dash_dict_md5, dash_instance,
+		  dash_created, dash_env, dash_updated
+		)
+		SELECT
+		  "heartbeat path down" AS dash_type,
+		  ` + sev + ` AS dash_severity,
+		  hb.node_id,
+		  "" AS svc_id,
+		  "heartbeat %(name)s to %(peer)s is not beating, %(beating)s still beating" AS dash_fmt,
+		  JSON_OBJECT("name", hb.name, "peer", COALESCE(peers.nodename, hb.peer_node_id), "beating", beating.names) AS dash_dict,
+		  MD5(JSON_OBJECT("name", hb.name, "peer", hb.peer_node_id)) AS dash_dict_md5,
+		  CONCAT(hb.name, "->", COALESCE(peers.nodename, hb.peer_node_id)) AS dash_instance,
+		  ? AS dash_created,
+		  nodes.node_env AS dash_env,
+		  ? AS dash_updated
+		FROM hbmon hb
+		JOIN (
+		  -- Subquery to find the node and peer pairs with beating heartbeats
+		  SELECT
+		    node_id,
+		    peer_node_id,
+		    GROUP_CONCAT(name ORDER BY name) AS names
+		  FROM hbmon
+		  WHERE
+		    peer_node_id != "" AND
+		    beating = 1
+		  GROUP BY node_id, peer_node_id
+		) AS beating ON beating.node_id = hb.node_id AND beating.peer_node_id = hb.peer_node_id
+		JOIN nodes ON nodes.node_id = hb.node_id
+		LEFT JOIN nodes peers ON peers.node_id = hb.peer_node_id
+		WHERE
+		  hb.peer_node_id != "" AND
+		  hb.state = "running" AND
+		  hb.beating = 2 AND
+		  ` + keep + `
+		ON DUPLICATE KEY UPDATE
+		  dash_severity = VALUES(dash_severity),
+		  dash_fmt = VALUES(dash_fmt),
+		  dash_dict = VALUES(dash_dict),
+		  dash_env = VALUES(dash_env),
+		  dash_updated = VALUES(dash_updated)
+		`
+	if count, err := oDb.execCountContext(ctx, request, now, now); err != nil {
+		return fmt.Errorf("alertHeartbeatPathDown: %w", err)
+	} else if count > 0 {
+		oDb.SetChange("dashboard")
+	}
+
+	request = `DELETE FROM dashboard
+		   WHERE
+		     dash_type = "heartbeat path down" AND
+		     (dash_updated < ? OR dash_updated IS NULL)`
+	if count, err := oDb.execCountContext(ctx, request, now); err != nil {
+		return fmt.Errorf("alertHeartbeatPathDown: %w", err)
+	} else if count > 0 {
+		oDb.SetChange("dashboard")
+	}
+	return nil
+}
+
 func (o *DBHeartbeat) SameAsLog(logStatus *DBHeartbeatLog) bool {
 	if logStatus == nil {
 		return false
@@ -273,7 +418,7 @@ func (o *DBHeartbeat) SameAsLog(logStatus *DBHeartbeatLog) bool {
 func (o *DBHeartbeat) AsLog() *DBHeartbeatLog {
 	return &DBHeartbeatLog{
 		ClusterID:  o.ClusterID,
-		NodeID:     o.ClusterID,
+		NodeID:     o.NodeID,
 		Name:       o.Name,
 		PeerNodeID: o.PeerNodeID,
 		State:      o.State,
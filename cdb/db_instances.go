@@ -193,6 +193,17 @@ func (oDb *DB) InstancesFromObjectIDs(ctx context.Context, objectIDs ...string)
 	return instances, nil
 }
 
+// InstanceExists returns true if the object svcID has an instance on the
+// node nodeID.
+func (oDb *DB) InstanceExists(ctx context.Context, svcID, nodeID string) (bool, error) {
+	const query = `SELECT COUNT(*) FROM svcmon WHERE svc_id = ? AND node_id = ?`
+	var n int
+	if err := oDb.DB.QueryRowContext(ctx, query, svcID, nodeID).Scan(&n); err != nil {
+		return false, fmt.Errorf("instanceExists: %w", err)
+	}
+	return n > 0, nil
+}
+
 // SvcmonRefreshTimestamp updates svcmon.mon_updated, svcmon_log_last.mon_end for object ids with node id.
 func (oDb *DB) SvcmonRefreshTimestamp(ctx context.Context, nodeID string, objectIDs ...string) (updates bool, err error) {
 	defer logDuration("SvcmonRefreshTimestamp", time.Now())
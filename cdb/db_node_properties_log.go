@@ -0,0 +1,154 @@
+package cdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/opensvc/oc3/schema"
+)
+
+type (
+	// NodePropertyChange is a change of a nodes table column value, logged
+	// in the node_properties_log table.
+	//
+	//	CREATE TABLE `node_properties_log` (
+	//	  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+	//	  `node_id` char(36) NOT NULL,
+	//	  `prop` varchar(64) NOT NULL,
+	//	  `old_value` text DEFAULT NULL,
+	//	  `new_value` text DEFAULT NULL,
+	//	  `changed_at` datetime NOT NULL,
+	//	  PRIMARY KEY (`id`),
+	//	  KEY `k_node_id_changed_at` (`node_id`, `changed_at`),
+	//	  KEY `k_changed_at` (`changed_at`)
+	//	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
+	NodePropertyChange struct {
+		Prop     string  `json:"prop"`
+		OldValue *string `json:"old_value"`
+		NewValue *string `json:"new_value"`
+	}
+)
+
+// NodeProperties returns the values of the props columns of the node, as
+// strings formatted by the database, or nil if the node does not exist.
+func (oDb *DB) NodeProperties(ctx context.Context, nodeID string, props []string) (map[string]*string, error) {
+	exprs := make([]string, len(props))
+	for i, prop := range props {
+		exprs[i] = fmt.Sprintf("CAST(`%s` AS CHAR)", prop)
+	}
+	query := "SELECT " + strings.Join(exprs, ", ") + " FROM nodes WHERE node_id = ?"
+	values := make([]sql.NullString, len(props))
+	ptrs := make([]any, len(props))
+	for i := range values {
+		ptrs[i] = &values[i]
+	}
+	switch err := oDb.DB.QueryRowContext(ctx, query, nodeID).Scan(ptrs...); err {
+	case nil:
+	case sql.ErrNoRows:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("get node %s properties: %w", nodeID, err)
+	}
+	m := make(map[string]*string, len(props))
+	for i, prop := range props {
+		if values[i].Valid {
+			m[prop] = &values[i].String
+		} else {
+			m[prop] = nil
+		}
+	}
+	return m, nil
+}
+
+// DiffNodeProperties returns the changes from the before to the after node
+// properties, ordered like props.
+func DiffNodeProperties(props []string, before, after map[string]*string) []NodePropertyChange {
+	var l []NodePropertyChange
+	for _, prop := range props {
+		o, n := before[prop], after[prop]
+		switch {
+		case o == nil && n == nil:
+			continue
+		case o != nil && n != nil && *o == *n:
+			continue
+		}
+		l = append(l, NodePropertyChange{Prop: prop, OldValue: o, NewValue: n})
+	}
+	return l
+}
+
+// InsertNodePropertyChanges logs the node property changes.
+func (oDb *DB) InsertNodePropertyChanges(ctx context.Context, nodeID string, changedAt time.Time, changes []NodePropertyChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	placeholders := make([]string, len(changes))
+	args := make([]any, 0, 5*len(changes))
+	for i, c := range changes {
+		placeholders[i] = "(?, ?, ?, ?, ?)"
+		args = append(args, nodeID, c.Prop, c.OldValue, c.NewValue, changedAt)
+	}
+	query := "INSERT INTO node_properties_log (node_id, prop, old_value, new_value, changed_at) VALUES " + strings.Join(placeholders, ", ")
+	if _, err := oDb.DB.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("insert node %s property changes: %w", nodeID, err)
+	}
+	oDb.SetChange("node_properties_log")
+	return nil
+}
+
+func buildNodePropertiesLogQuery(groups []string, isManager bool, selectExprs []string) (string, []any) {
+	q := From(schema.TNodePropertiesLog).
+		RawSelect(selectExprs...)
+
+	if !isManager {
+		cleanGroups := cleanGroups(groups)
+		if len(cleanGroups) == 0 {
+			q = q.WhereRaw("1=0")
+		} else {
+			args := make([]any, len(cleanGroups))
+			for i, g := range cleanGroups {
+				args[i] = g
+			}
+			q = q.WhereRaw(
+				"node_properties_log.node_id IN ("+
+					"SELECT n.node_id FROM nodes n"+
+					" JOIN apps a ON n.app = a.app"+
+					" JOIN apps_responsibles ar ON ar.app_id = a.id"+
+					" JOIN auth_group ag ON ag.id = ar.group_id"+
+					" WHERE ag.role IN ("+Placeholders(len(cleanGroups))+")"+
+					")",
+				args...,
+			)
+		}
+	} else {
+		q = q.Where(schema.NodePropertiesLogID, ">", 0)
+	}
+
+	query, args, err := q.Build()
+	if err != nil {
+		panic(fmt.Sprintf("buildNodePropertiesLogQuery: %v", err))
+	}
+	return query, args
+}
+
+func (oDb *DB) GetNodePropertiesLog(ctx context.Context, nodeID string, p ListParams) ([]map[string]any, error) {
+	query, args := buildNodePropertiesLogQuery(p.Groups, p.IsManager, p.SelectExprs)
+	query += " AND node_properties_log.node_id = ?"
+	args = append(args, nodeID)
+	if gb := p.GroupByClause(""); gb != "" {
+		query += " " + gb
+	}
+	query += " " + p.OrderByClause("node_properties_log.changed_at DESC, node_properties_log.id DESC")
+	query, args = appendLimitOffset(query, args, p.Limit, p.Offset)
+
+	rows, err := oDb.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("getNodePropertiesLog: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	return scanRowsToMaps(rows, p.Props, p.TypeHints)
+}
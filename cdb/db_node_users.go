@@ -0,0 +1,191 @@
+package cdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opensvc/oc3/schema"
+)
+
+type (
+	// NodeAccountTable describes a node accounts table, node_users or
+	// node_groups.
+	NodeAccountTable struct {
+		table    *schema.Table
+		rowID    *schema.Col
+		nameCol  string
+		idCol    string
+		dashType string
+
+		// kind is the account kind used in the dashboard alert message
+		kind string
+	}
+)
+
+var (
+	NodeUsersAccounts = NodeAccountTable{
+		table:    schema.TNodeUsers,
+		rowID:    schema.NodeUsersID,
+		nameCol:  "user_name",
+		idCol:    "user_id",
+		dashType: "uid conflict",
+		kind:     "user",
+	}
+	NodeGroupsAccounts = NodeAccountTable{
+		table:    schema.TNodeGroups,
+		rowID:    schema.NodeGroupsID,
+		nameCol:  "group_name",
+		idCol:    "group_id",
+		dashType: "gid conflict",
+		kind:     "group",
+	}
+)
+
+func buildNodeAccountsQuery(t *schema.Table, idCol *schema.Col, groups []string, isManager bool, selectExprs []string) (string, []any) {
+	q := From(t).
+		RawSelect(selectExprs...)
+
+	if !isManager {
+		cleanGroups := cleanGroups(groups)
+		if len(cleanGroups) == 0 {
+			q = q.WhereRaw("1=0")
+		} else {
+			args := make([]any, len(cleanGroups))
+			for i, g := range cleanGroups {
+				args[i] = g
+			}
+			q = q.WhereRaw(
+				t.Name+".node_id IN ("+
+					"SELECT n.node_id FROM nodes n"+
+					" JOIN apps a ON n.app = a.app"+
+					" JOIN apps_responsibles ar ON ar.app_id = a.id"+
+					" JOIN auth_group ag ON ag.id = ar.group_id"+
+					" WHERE ag.role IN ("+Placeholders(len(cleanGroups))+")"+
+					")",
+				args...,
+			)
+		}
+	} else {
+		q = q.Where(idCol, ">", 0)
+	}
+
+	query, args, err := q.Build()
+	if err != nil {
+		panic(fmt.Sprintf("buildNodeAccountsQuery %s: %v", t.Name, err))
+	}
+	return query, args
+}
+
+// GetNodeAccounts returns the accounts of the t table reported by the node
+// nodeID, or by all the nodes if nodeID is empty.
+func (oDb *DB) GetNodeAccounts(ctx context.Context, t NodeAccountTable, nodeID string, p ListParams) ([]map[string]any, error) {
+	query, args := buildNodeAccountsQuery(t.table, t.rowID, p.Groups, p.IsManager, p.SelectExprs)
+	defaultOrderBy := t.table.Name + ".node_id, " + t.table.Name + "." + t.nameCol
+	if nodeID != "" {
+		query += " AND " + t.table.Name + ".node_id = ?"
+		args = append(args, nodeID)
+		defaultOrderBy = t.table.Name + "." + t.nameCol
+	}
+	if gb := p.GroupByClause(""); gb != "" {
+		query += " " + gb
+	}
+	query += " " + p.OrderByClause(defaultOrderBy)
+	query, args = appendLimitOffset(query, args, p.Limit, p.Offset)
+
+	rows, err := oDb.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("getNodeAccounts %s: %w", t.table.Name, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	return scanRowsToMaps(rows, p.Props, p.TypeHints)
+}
+
+// AlertUIDConflict raises a "uid conflict" dashboard alert on the nodes of a
+// cluster reporting the same user name with different uids. Such drifts
+// break the file ownership on the filesystems shared by the cluster nodes
+// on failover.
+func (oDb *DB) AlertUIDConflict(ctx context.Context) error {
+	return oDb.alertNodeAccountIDConflict(ctx, NodeUsersAccounts)
+}
+
+// AlertGIDConflict raises a "gid conflict" dashboard alert on the nodes of a
+// cluster reporting the same group name with different gids.
+func (oDb *DB) AlertGIDConflict(ctx context.Context) error {
+	return oDb.alertNodeAccountIDConflict(ctx, NodeGroupsAccounts)
+}
+
+func (oDb *DB) alertNodeAccountIDConflict(ctx context.Context, t NodeAccountTable) error {
+	sev, keep := alertSeverity(quoteString(t.dashType), "nodes.node_env", "nodes.node_id", `""`, `IF(nodes.node_env = "PRD", 4, 3)`)
+	request := fmt.Sprintf(`
+		INSERT INTO dashboard (
+		  dash_type, dash_severity, node_id, svc_id,
+		  dash_fmt, dash_dict, dash_dict_md5, dash_instance,
+		  dash_created, dash_env, dash_updated
+		)
+		SELECT
+		  "%[4]s" AS dash_type,
+		  %[6]s AS dash_severity,
+		  nodes.node_id,
+		  "" AS svc_id,
+		  "%[5]s %%(name)s has different ids on the cluster nodes: %%(nodes)s" AS dash_fmt,
+		  JSON_OBJECT("name", conflicts.name, "nodes", (
+		    -- the ids by node listed in the alert message
+		    SELECT GROUP_CONCAT(DISTINCT CONCAT(n.nodename, ":", a.%[3]s) ORDER BY n.nodename)
+		    FROM %[1]s a
+		    JOIN nodes n ON n.node_id = a.node_id
+		    WHERE
+		      n.cluster_id = conflicts.cluster_id AND
+		      a.%[2]s = conflicts.name AND
+		      a.%[3]s IS NOT NULL AND
+		      a.updated > DATE_SUB(NOW(), INTERVAL 1 DAY)
+		  )) AS dash_dict,
+		  MD5(JSON_OBJECT("name", conflicts.name)) AS dash_dict_md5,
+		  conflicts.name AS dash_instance,
+		  NOW() AS dash_created,
+		  nodes.node_env AS dash_env,
+		  NOW() AS dash_updated
+		FROM (
+		  -- Self-join to find the nodes reporting a name with an id
+		  -- different from the one reported by another node of the cluster
+		  SELECT DISTINCT
+		    n.cluster_id,
+		    a.node_id,
+		    a.%[2]s AS name
+		  FROM %[1]s a
+		  JOIN nodes n ON n.node_id = a.node_id
+		  JOIN %[1]s b ON b.%[2]s = a.%[2]s AND b.%[3]s != a.%[3]s
+		  JOIN nodes m ON m.node_id = b.node_id AND m.cluster_id = n.cluster_id
+		  WHERE
+		    n.cluster_id IS NOT NULL AND
+		    n.cluster_id != "" AND
+		    a.updated > DATE_SUB(NOW(), INTERVAL 1 DAY) AND
+		    b.updated > DATE_SUB(NOW(), INTERVAL 1 DAY)
+		) AS conflicts
+		JOIN nodes ON nodes.node_id = conflicts.node_id
+		WHERE %[7]s
+		ON DUPLICATE KEY UPDATE
+		  dash_severity = VALUES(dash_severity),
+		  dash_fmt = VALUES(dash_fmt),
+		  dash_dict = VALUES(dash_dict),
+		  dash_env = VALUES(dash_env),
+		  dash_updated = VALUES(dash_updated)
+		`, t.table.Name, t.nameCol, t.idCol, t.dashType, t.kind, sev, keep)
+	if count, err := oDb.execCountContext(ctx, request); err != nil {
+		return err
+	} else if count > 0 {
+		oDb.SetChange("dashboard")
+	}
+
+	request = `DELETE FROM dashboard
+		   WHERE
+		     dash_type = ? AND
+		     dash_updated < DATE_SUB(NOW(), INTERVAL 1 DAY)`
+	if count, err := oDb.execCountContext(ctx, request, t.dashType); err != nil {
+		return err
+	} else if count > 0 {
+		oDb.SetChange("dashboard")
+	}
+
+	return nil
+}
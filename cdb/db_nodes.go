@@ -410,6 +410,7 @@ func (oDb *DB) PurgeNodeHBAsOutdated(ctx context.Context) error {
 }
 
 func (oDb *DB) AlertMACDup(ctx context.Context) error {
+	sev, keep := alertSeverity(`"mac duplicate"`, "nodes.node_env", "nodes.node_id", `""`, `IF(nodes.node_env = "PRD", 4, 3)`)
 	request := `
 		-- Step 1: Find duplicates and prepare data for insert/update
 		INSERT INTO dashboard (
@@ -418,7 +419,7 @@ func (oDb *DB) AlertMACDup(ctx context.Context) error {
 		)
 		SELECT
 		  "mac duplicate" AS dash_type,
-		  IF(nodes.node_env = "PRD", 4, 3) AS dash_severity,
+		  ` + sev + ` AS dash_severity,
 		  nodes.node_id,
 		  "" AS svc_id,
 		  CONCAT("mac ", duplicates.mac, " reported by nodes ", duplicates.node_names) AS dash_fmt,
@@ -456,7 +457,9 @@ func (oDb *DB) AlertMACDup(ctx context.Context) error {
 		  HAVING COUNT(t.mac) > 1
 		) AS duplicates
 		JOIN nodes ON FIND_IN_SET(nodes.node_id, duplicates.node_ids)
+		WHERE ` + keep + `
 		ON DUPLICATE KEY UPDATE
+		  dash_severity = VALUES(dash_severity),
 		  dash_fmt = VALUES(dash_fmt),
 		  dash_dict = VALUES(dash_dict),
 		  dash_env = VALUES(dash_env),
@@ -621,6 +624,16 @@ func (oDb *DB) NodeByNodeIDOrNodename(ctx context.Context, nodeIdOrName string)
 	}
 }
 
+// NodeAgentVersion returns the opensvc agent version reported by the node.
+func (oDb *DB) NodeAgentVersion(ctx context.Context, nodeID string) (string, error) {
+	const query = `SELECT version FROM nodes WHERE node_id = ? LIMIT 1`
+	var version sql.NullString
+	if err := oDb.DB.QueryRowContext(ctx, query, nodeID).Scan(&version); err != nil {
+		return "", fmt.Errorf("nodeAgentVersion: %w", err)
+	}
+	return version.String, nil
+}
+
 // check if a user is responsible for a given node
 func (oDb *DB) NodeResponsible(ctx context.Context, nodeID string, groups []string, isManager bool) (bool, error) {
 	if nodeID == "" {
@@ -0,0 +1,313 @@
+package cdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type (
+	// DashboardAlert is a dashboard row, with the object names and app
+	// used by the alert notification routing.
+	DashboardAlert struct {
+		ID int64
+
+		// Key identifies the alert across the dashboard row deletions and
+		// re-insertions.
+		Key      string
+		Type     string
+		Severity int
+		NodeID   string
+		Nodename string
+		SvcID    string
+		Svcname  string
+		Env      string
+		App      string
+		Fmt      string
+		Dict     string
+		Created  time.Time
+	}
+
+	// DashboardNotifyState is the last notified state of a dashboard alert.
+	// The cleared alerts are kept during the notification dedup window.
+	//
+	//	CREATE TABLE `dashboard_notify` (
+	//	  `alert_key` char(32) NOT NULL,
+	//	  `dash_type` varchar(100) NOT NULL,
+	//	  `dash_severity` int(11) NOT NULL,
+	//	  `node_id` char(36) NOT NULL DEFAULT '',
+	//	  `nodename` varchar(255) NOT NULL DEFAULT '',
+	//	  `svc_id` char(36) NOT NULL DEFAULT '',
+	//	  `svcname` varchar(255) NOT NULL DEFAULT '',
+	//	  `dash_env` varchar(20) NOT NULL DEFAULT '',
+	//	  `app` varchar(64) NOT NULL DEFAULT '',
+	//	  `text` text DEFAULT NULL,
+	//	  `raised_at` datetime NOT NULL,
+	//	  `notified_at` datetime DEFAULT NULL,
+	//	  `cleared_at` datetime DEFAULT NULL,
+	//	  PRIMARY KEY (`alert_key`),
+	//	  KEY `k_cleared_at` (`cleared_at`)
+	//	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
+	DashboardNotifyState struct {
+		Key        string
+		Type       string
+		Severity   int
+		NodeID     string
+		Nodename   string
+		SvcID      string
+		Svcname    string
+		Env        string
+		App        string
+		Text       string
+		RaisedAt   time.Time
+		NotifiedAt *time.Time
+		ClearedAt  *time.Time
+	}
+
+	// NotifyQueueEntry is an alert transition waiting for the end of the
+	// grouping window of its channel.
+	//
+	//	CREATE TABLE `notify_queue` (
+	//	  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+	//	  `channel` varchar(64) NOT NULL,
+	//	  `event` text NOT NULL,
+	//	  `created_at` datetime NOT NULL,
+	//	  PRIMARY KEY (`id`),
+	//	  KEY `k_channel_created_at` (`channel`, `created_at`)
+	//	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
+	NotifyQueueEntry struct {
+		ID      int64
+		Channel string
+
+		// Event is the json encoded transition
+		Event     []byte
+		CreatedAt time.Time
+	}
+)
+
+const (
+	notifyStatesBatchSize = 500
+)
+
+// DashboardAlerts returns the dashboard alerts updated since the given
+// time, or all the alerts if since is zero. The dashboard writers set
+// dash_updated on insert and update, and the filter is served by:
+//
+//	ALTER TABLE `dashboard` ADD KEY `k_dash_updated` (`dash_updated`);
+func (oDb *DB) DashboardAlerts(ctx context.Context, since time.Time) ([]DashboardAlert, error) {
+	query := `SELECT d.id, ` + DashboardAlertKeyExpr("d") + `,
+		  d.dash_type, d.dash_severity,
+		  COALESCE(d.node_id, ""), COALESCE(n.nodename, ""),
+		  COALESCE(d.svc_id, ""), COALESCE(s.svcname, ""),
+		  COALESCE(d.dash_env, ""), COALESCE(s.svc_app, n.app, ""),
+		  COALESCE(d.dash_fmt, ""), COALESCE(d.dash_dict, ""), d.dash_created
+		FROM dashboard d
+		LEFT JOIN nodes n ON n.node_id = d.node_id
+		LEFT JOIN services s ON s.svc_id = d.svc_id`
+	var args []any
+	if !since.IsZero() {
+		query += ` WHERE d.dash_updated >= ?`
+		args = append(args, since)
+	}
+	rows, err := oDb.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("get dashboard alerts: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+	var l []DashboardAlert
+	for rows.Next() {
+		var a DashboardAlert
+		if err := rows.Scan(&a.ID, &a.Key, &a.Type, &a.Severity, &a.NodeID, &a.Nodename, &a.SvcID, &a.Svcname,
+			&a.Env, &a.App, &a.Fmt, &a.Dict, &a.Created); err != nil {
+			return nil, fmt.Errorf("get dashboard alerts: %w", err)
+		}
+		l = append(l, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get dashboard alerts: %w", err)
+	}
+	return l, nil
+}
+
+// DashboardNotifyStates returns the notification states of the dashboard
+// alerts, indexed by alert key.
+func (oDb *DB) DashboardNotifyStates(ctx context.Context) (map[string]DashboardNotifyState, error) {
+	query := `SELECT alert_key, dash_type, dash_severity, node_id, nodename, svc_id, svcname,
+		  dash_env, app, COALESCE(text, ""), raised_at, notified_at, cleared_at
+		FROM dashboard_notify`
+	rows, err := oDb.DB.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("get dashboard notify states: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+	m := make(map[string]DashboardNotifyState)
+	for rows.Next() {
+		var (
+			s                     DashboardNotifyState
+			notifiedAt, clearedAt sql.NullTime
+		)
+		if err := rows.Scan(&s.Key, &s.Type, &s.Severity, &s.NodeID, &s.Nodename, &s.SvcID, &s.Svcname,
+			&s.Env, &s.App, &s.Text, &s.RaisedAt, &notifiedAt, &clearedAt); err != nil {
+			return nil, fmt.Errorf("get dashboard notify states: %w", err)
+		}
+		if notifiedAt.Valid {
+			s.NotifiedAt = &notifiedAt.Time
+		}
+		if clearedAt.Valid {
+			s.ClearedAt = &clearedAt.Time
+		}
+		m[s.Key] = s
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get dashboard notify states: %w", err)
+	}
+	return m, nil
+}
+
+// DashboardClearedAlertKeys returns the keys of the alerts whose
+// notification state is not cleared, and no longer in the dashboard.
+func (oDb *DB) DashboardClearedAlertKeys(ctx context.Context) ([]string, error) {
+	query := `SELECT s.alert_key
+		FROM dashboard_notify s
+		WHERE
+		  s.cleared_at IS NULL AND
+		  NOT EXISTS (
+		    SELECT 1 FROM dashboard d
+		    WHERE
+		      d.dash_type = s.dash_type AND
+		      (d.node_id = s.node_id OR d.node_id IS NULL AND s.node_id = "") AND
+		      ` + DashboardAlertKeyExpr("d") + ` = s.alert_key
+		  )`
+	rows, err := oDb.DB.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("get dashboard cleared alert keys: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+	var l []string
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, fmt.Errorf("get dashboard cleared alert keys: %w", err)
+		}
+		l = append(l, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get dashboard cleared alert keys: %w", err)
+	}
+	return l, nil
+}
+
+// UpsertDashboardNotifyStates inserts or updates the notification states.
+func (oDb *DB) UpsertDashboardNotifyStates(ctx context.Context, l []DashboardNotifyState) error {
+	for len(l) > 0 {
+		n := min(len(l), notifyStatesBatchSize)
+		placeholders := make([]string, n)
+		args := make([]any, 0, 13*n)
+		for i, s := range l[:n] {
+			placeholders[i] = "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+			args = append(args, s.Key, s.Type, s.Severity, s.NodeID, s.Nodename, s.SvcID, s.Svcname,
+				s.Env, s.App, s.Text, s.RaisedAt, s.NotifiedAt, s.ClearedAt)
+		}
+		query := `INSERT INTO dashboard_notify (alert_key, dash_type, dash_severity, node_id, nodename,
+		      svc_id, svcname, dash_env, app, text, raised_at, notified_at, cleared_at)
+		    VALUES ` + strings.Join(placeholders, ", ") + `
+		    ON DUPLICATE KEY UPDATE
+		      dash_severity = VALUES(dash_severity),
+		      nodename = VALUES(nodename),
+		      svcname = VALUES(svcname),
+		      dash_env = VALUES(dash_env),
+		      app = VALUES(app),
+		      text = VALUES(text),
+		      raised_at = VALUES(raised_at),
+		      notified_at = VALUES(notified_at),
+		      cleared_at = VALUES(cleared_at)`
+		if _, err := oDb.DB.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("upsert dashboard notify states: %w", err)
+		}
+		l = l[n:]
+	}
+	return nil
+}
+
+// PurgeDashboardNotifyStates deletes the states of the alerts cleared
+// before the given time.
+func (oDb *DB) PurgeDashboardNotifyStates(ctx context.Context, clearedBefore time.Time) (int64, error) {
+	query := `DELETE FROM dashboard_notify WHERE cleared_at < ?`
+	count, err := oDb.execCountContext(ctx, query, clearedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("purge dashboard notify states: %w", err)
+	}
+	return count, nil
+}
+
+// InsertNotifyQueue queues an alert transition for the channel.
+func (oDb *DB) InsertNotifyQueue(ctx context.Context, channel string, event []byte, createdAt time.Time) error {
+	query := `INSERT INTO notify_queue (channel, event, created_at) VALUES (?, ?, ?)`
+	if _, err := oDb.DB.ExecContext(ctx, query, channel, event, createdAt); err != nil {
+		return fmt.Errorf("insert notify queue %s: %w", channel, err)
+	}
+	return nil
+}
+
+// NotifyQueue returns the queued alert transitions, ordered by channel and
+// creation time.
+func (oDb *DB) NotifyQueue(ctx context.Context) ([]NotifyQueueEntry, error) {
+	query := `SELECT id, channel, event, created_at FROM notify_queue ORDER BY channel, created_at, id`
+	rows, err := oDb.DB.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("get notify queue: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+	var l []NotifyQueueEntry
+	for rows.Next() {
+		var e NotifyQueueEntry
+		if err := rows.Scan(&e.ID, &e.Channel, &e.Event, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("get notify queue: %w", err)
+		}
+		l = append(l, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get notify queue: %w", err)
+	}
+	return l, nil
+}
+
+// DeleteNotifyQueue deletes the queued alert transitions.
+func (oDb *DB) DeleteNotifyQueue(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	query := "DELETE FROM notify_queue WHERE id IN (" + Placeholders(len(ids)) + ")"
+	if _, err := oDb.DB.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("delete notify queue: %w", err)
+	}
+	return nil
+}
+
+// InsertAlertSent records a message sent to a notification channel in the
+// alerts and alerts_sent tables. The msgType is the channel type, and
+// sentTo the channel recipients.
+func (oDb *DB) InsertAlertSent(ctx context.Context, msgType, sentTo, subject, body string, sentAt time.Time) error {
+	query := `INSERT INTO alerts (sent_at, sent_to, subject, body, send_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)`
+	result, err := oDb.DB.ExecContext(ctx, query, sentAt, sentTo, subject, body, sentAt, sentAt)
+	if err != nil {
+		return fmt.Errorf("insert alert: %w", err)
+	}
+	alertID, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("insert alert: %w", err)
+	}
+	query = `INSERT INTO alerts_sent (alert_id, msg_type, user_id, sent) VALUES (?, ?, 0, ?)`
+	if _, err := oDb.DB.ExecContext(ctx, query, alertID, msgType, sentAt); err != nil {
+		return fmt.Errorf("insert alert %d sent: %w", alertID, err)
+	}
+	oDb.SetChange("alerts", "alerts_sent")
+	return nil
+}
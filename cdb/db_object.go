@@ -676,7 +676,7 @@ func (oDb *DB) PurgeTablesFromObjectID(ctx context.Context, id string) error {
 			"svcmon_log_ack", "checks_settings", "comp_log", "comp_log_daily",
 			"comp_rulesets_services", "comp_modulesets_services", "log",
 			"action_queue", "svc_tags", "form_output_results", "svcmon_log_last",
-			"resmon_log_last",
+			"resmon_log_last", "svc_availability_daily",
 		}
 
 		err error
@@ -6,6 +6,7 @@ import (
 )
 
 func (oDb *DB) StatObsolescenceHW(ctx context.Context) error {
+	var sev, keep string
 	query := `INSERT IGNORE INTO obsolescence (
 			obs_type,
 			obs_name,
@@ -42,6 +43,7 @@ func (oDb *DB) StatObsolescenceHW(ctx context.Context) error {
 	if _, err := oDb.ExecContext(ctx, query); err != nil {
 		return fmt.Errorf("update obsolescence failed: %w", err)
 	}
+	sev, keep = alertSeverity(`"hardware obsolescence warning"`, "n.node_env", "n.node_id", `""`, "0")
 	query = `
 		INSERT INTO dashboard (
 		    dash_type,
@@ -58,7 +60,7 @@ func (oDb *DB) StatObsolescenceHW(ctx context.Context) error {
 		SELECT
 		    "hardware obsolescence warning",
 		    "",
-		    0,
+		    ` + sev + `,
 		    "%(o)s warning since %(a)s",
 		    JSON_OBJECT("a", o.obs_warn_date, "o", o.obs_name),
 		    NOW(),
@@ -77,7 +79,9 @@ func (oDb *DB) StatObsolescenceHW(ctx context.Context) error {
 		    AND o.obs_name NOT LIKE "%cluster%"
 		    AND o.obs_warn_date < NOW()
 		    AND o.obs_alert_date > NOW()
+		    AND ` + keep + `
 		ON DUPLICATE KEY UPDATE
+		  dash_severity=VALUES(dash_severity),
 		  dash_updated=NOW()
             `
 	if _, err := oDb.ExecContext(ctx, query); err != nil {
@@ -102,6 +106,7 @@ func (oDb *DB) StatObsolescenceHW(ctx context.Context) error {
 	if _, err := oDb.ExecContext(ctx, query); err != nil {
 		return fmt.Errorf("update obsolescence failed: %w", err)
 	}
+	sev, keep = alertSeverity(`"hardware obsolescence alert"`, "n.node_env", "n.node_id", `""`, "0")
 	query = `
 		INSERT INTO dashboard (
 		    dash_type,
@@ -118,7 +123,7 @@ func (oDb *DB) StatObsolescenceHW(ctx context.Context) error {
 		SELECT
 		    "hardware obsolescence alert",
 		    "",
-		    0,
+		    ` + sev + `,
 		    "%(o)s alert since %(a)s",
 		    JSON_OBJECT("a", o.obs_alert_date, "o", o.obs_name),
 		    NOW(),
@@ -136,7 +141,9 @@ func (oDb *DB) StatObsolescenceHW(ctx context.Context) error {
 		    AND o.obs_name NOT LIKE "%virtuel%"
 		    AND o.obs_name NOT LIKE "%cluster%"
 		    AND o.obs_alert_date < NOW()
+		    AND ` + keep + `
 		ON DUPLICATE KEY UPDATE
+		  dash_severity=VALUES(dash_severity),
 		  dash_updated=NOW()
             `
 	if _, err := oDb.ExecContext(ctx, query); err != nil {
@@ -146,6 +153,7 @@ func (oDb *DB) StatObsolescenceHW(ctx context.Context) error {
 }
 
 func (oDb *DB) StatObsolescenceOS(ctx context.Context) error {
+	var sev, keep string
 	query := `INSERT IGNORE INTO obsolescence (
 			obs_type,
 			obs_name,
@@ -179,6 +187,7 @@ func (oDb *DB) StatObsolescenceOS(ctx context.Context) error {
 	if _, err := oDb.ExecContext(ctx, query); err != nil {
 		return fmt.Errorf("update obsolescence failed: %w", err)
 	}
+	sev, keep = alertSeverity(`"os obsolescence warning"`, "n.node_env", "n.node_id", `""`, "0")
 	query = `
 		INSERT INTO dashboard (
 		    dash_type,
@@ -195,7 +204,7 @@ func (oDb *DB) StatObsolescenceOS(ctx context.Context) error {
 		SELECT
 		    "os obsolescence warning",
 		    "",
-		    0,
+		    ` + sev + `,
 		    "%(o)s warning since %(a)s",
 		    JSON_OBJECT("a", o.obs_warn_date, "o", o.obs_name),
 		    NOW(),
@@ -211,7 +220,9 @@ func (oDb *DB) StatObsolescenceOS(ctx context.Context) error {
 		    AND o.obs_alert_date != "0000-00-00 00:00:00"
 		    AND o.obs_warn_date < NOW()
 		    AND o.obs_alert_date > NOW()
+		    AND ` + keep + `
 		ON DUPLICATE KEY UPDATE
+		  dash_severity=VALUES(dash_severity),
 		  dash_updated=NOW()
             `
 	if _, err := oDb.ExecContext(ctx, query); err != nil {
@@ -233,6 +244,7 @@ func (oDb *DB) StatObsolescenceOS(ctx context.Context) error {
 	if _, err := oDb.ExecContext(ctx, query); err != nil {
 		return fmt.Errorf("update obsolescence failed: %w", err)
 	}
+	sev, keep = alertSeverity(`"os obsolescence alert"`, "n.node_env", "n.node_id", `""`, "0")
 	query = `
 		INSERT INTO dashboard (
 		    dash_type,
@@ -249,7 +261,7 @@ func (oDb *DB) StatObsolescenceOS(ctx context.Context) error {
 		SELECT
 		    "os obsolescence alert",
 		    "",
-		    0,
+		    ` + sev + `,
 		    "%(o)s alert since %(a)s",
 		    JSON_OBJECT("a", o.obs_alert_date, "o", o.obs_name),
 		    NOW(),
@@ -264,7 +276,9 @@ func (oDb *DB) StatObsolescenceOS(ctx context.Context) error {
 		    AND o.obs_alert_date IS NOT NULL
 		    AND o.obs_alert_date != "0000-00-00 00:00:00"
 		    AND o.obs_alert_date < NOW()
+		    AND ` + keep + `
 		ON DUPLICATE KEY UPDATE
+		  dash_severity=VALUES(dash_severity),
 		  dash_updated=NOW()
             `
 	if _, err := oDb.ExecContext(ctx, query); err != nil {
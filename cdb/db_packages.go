@@ -1,6 +1,13 @@
 package cdb
 
-import "context"
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/opensvc/oc3/schema"
+)
 
 func (oDb *DB) PurgePackagesOutdated(ctx context.Context) error {
 	var query = `DELETE
@@ -27,3 +34,182 @@ func (oDb *DB) PurgePatchesOutdated(ctx context.Context) error {
 	}
 	return nil
 }
+
+type (
+	// PackagesFilter selects the packages matching the non-empty fields.
+	// The Name and Version fields may contain the % wildcard.
+	PackagesFilter struct {
+		Name    string
+		Version string
+		Arch    string
+		Type    string
+	}
+
+	// PackageInstall is a package version installed on a node.
+	PackageInstall struct {
+		NodeID   string
+		Nodename string
+		Name     string
+		Version  string
+		Arch     string
+		Type     string
+	}
+)
+
+func buildPackagesQuery(f PackagesFilter, p ListParams) (string, []any) {
+	q := From(schema.TPackages).
+		RawSelect(p.SelectExprs...)
+
+	// LEFT JOIN nodes if needed.
+	for _, prop := range p.Props {
+		if strings.HasPrefix(prop, "nodes.") {
+			q = q.LeftJoin(schema.TNodes)
+			break
+		}
+	}
+
+	if !p.IsManager {
+		cleanGroups := cleanGroups(p.Groups)
+		if len(cleanGroups) == 0 {
+			q = q.WhereRaw("1=0")
+		} else {
+			args := make([]any, len(cleanGroups))
+			for i, g := range cleanGroups {
+				args[i] = g
+			}
+			q = q.WhereRaw(
+				"packages.node_id IN ("+
+					"SELECT n.node_id FROM nodes n"+
+					" JOIN apps a ON n.app = a.app"+
+					" JOIN apps_responsibles ar ON ar.app_id = a.id"+
+					" JOIN auth_group ag ON ag.id = ar.group_id"+
+					" WHERE ag.role IN ("+Placeholders(len(cleanGroups))+")"+
+					")",
+				args...,
+			)
+		}
+	} else {
+		q = q.Where(schema.PackagesID, ">", 0)
+	}
+
+	likeOrEqual := func(s string) string {
+		if strings.Contains(s, "%") {
+			return "LIKE"
+		}
+		return "="
+	}
+	if f.Name != "" {
+		q = q.Where(schema.PackagesPkgName, likeOrEqual(f.Name), f.Name)
+	}
+	if f.Version != "" {
+		q = q.Where(schema.PackagesPkgVersion, likeOrEqual(f.Version), f.Version)
+	}
+	if f.Arch != "" {
+		q = q.Where(schema.PackagesPkgArch, "=", f.Arch)
+	}
+	if f.Type != "" {
+		q = q.Where(schema.PackagesPkgType, "=", f.Type)
+	}
+
+	query, args, err := q.Build()
+	if err != nil {
+		panic(fmt.Sprintf("buildPackagesQuery: %v", err))
+	}
+	return query, args
+}
+
+func (oDb *DB) GetPackages(ctx context.Context, f PackagesFilter, p ListParams) ([]map[string]any, error) {
+	query, args := buildPackagesQuery(f, p)
+	if gb := p.GroupByClause(""); gb != "" {
+		query += " " + gb
+	}
+	query += " " + p.OrderByClause("packages.pkg_name, packages.pkg_version, packages.node_id")
+	query, args = appendLimitOffset(query, args, p.Limit, p.Offset)
+	return oDb.queryPackages(ctx, "getPackages", query, args, p)
+}
+
+func (oDb *DB) GetNodePackages(ctx context.Context, nodeID string, p ListParams) ([]map[string]any, error) {
+	query, args := buildPackagesQuery(PackagesFilter{}, p)
+	query += " AND packages.node_id = ?"
+	args = append(args, nodeID)
+	if gb := p.GroupByClause(""); gb != "" {
+		query += " " + gb
+	}
+	query += " " + p.OrderByClause("packages.pkg_name, packages.pkg_version")
+	query, args = appendLimitOffset(query, args, p.Limit, p.Offset)
+	return oDb.queryPackages(ctx, "getNodePackages", query, args, p)
+}
+
+func (oDb *DB) queryPackages(ctx context.Context, name, query string, args []any, p ListParams) ([]map[string]any, error) {
+	rows, err := oDb.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	// Use nested output when cross-table props (containing a dot) are requested.
+	for _, prop := range p.Props {
+		if strings.Contains(prop, ".") {
+			return scanRowsToNestedMaps(rows, p.Props, "packages")
+		}
+	}
+	return scanRowsToMaps(rows, p.Props, p.TypeHints)
+}
+
+// PackagesDiff returns the installs of the packages not installed with the
+// same versions and architectures on all the nodes, ordered by package name,
+// type and nodename. The gpg-pubkey pseudo packages are ignored.
+func (oDb *DB) PackagesDiff(ctx context.Context, nodeIDs []string) ([]PackageInstall, error) {
+	if len(nodeIDs) == 0 {
+		return nil, nil
+	}
+	in := Placeholders(len(nodeIDs))
+	query := fmt.Sprintf(`
+		SELECT p.node_id, n.nodename, p.pkg_name, p.pkg_version, p.pkg_arch, COALESCE(p.pkg_type, '')
+		FROM packages p
+		JOIN nodes n ON n.node_id = p.node_id
+		WHERE
+		  p.node_id IN (%[1]s)
+		  AND p.pkg_name NOT LIKE "gpg-pubkey%%"
+		  AND (p.pkg_name, COALESCE(p.pkg_type, '')) IN (
+		    SELECT t.pkg_name, t.pkg_type
+		    FROM (
+		      SELECT pkg_name, COALESCE(pkg_type, '') AS pkg_type, COUNT(DISTINCT node_id) AS c
+		      FROM packages
+		      WHERE
+		        node_id IN (%[1]s)
+		        AND pkg_name NOT LIKE "gpg-pubkey%%"
+		      GROUP BY pkg_name, COALESCE(pkg_type, ''), pkg_version, pkg_arch
+		    ) AS t
+		    WHERE t.c != ?
+		  )
+		ORDER BY p.pkg_name, COALESCE(p.pkg_type, ''), n.nodename, p.pkg_version, p.pkg_arch`, in)
+	args := make([]any, 0, 2*len(nodeIDs)+1)
+	for _, id := range nodeIDs {
+		args = append(args, id)
+	}
+	for _, id := range nodeIDs {
+		args = append(args, id)
+	}
+	args = append(args, len(nodeIDs))
+
+	rows, err := oDb.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("packagesDiff: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+	var l []PackageInstall
+	for rows.Next() {
+		var i PackageInstall
+		var nodename sql.NullString
+		if err := rows.Scan(&i.NodeID, &nodename, &i.Name, &i.Version, &i.Arch, &i.Type); err != nil {
+			return nil, fmt.Errorf("packagesDiff: %w", err)
+		}
+		i.Nodename = nodename.String
+		l = append(l, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("packagesDiff: %w", err)
+	}
+	return l, nil
+}
@@ -0,0 +1,118 @@
+package cdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+type (
+	// TablePartition is a partition of a table, as described in the
+	// information_schema.PARTITIONS table.
+	TablePartition struct {
+		Name string
+
+		// Method is the partitioning method, like "RANGE" or "RANGE COLUMNS"
+		Method string
+
+		// Expression is the partitioning expression, like "to_days(`log_date`)"
+		Expression string
+
+		// Description is the upper bound of a RANGE partition, like "739282",
+		// "'2024-02-01'" or "MAXVALUE"
+		Description string
+
+		// Rows is the approximate number of rows
+		Rows int64
+	}
+
+	// PartitionDefinition is a RANGE partition to create.
+	PartitionDefinition struct {
+		Name string
+
+		// LessThan is the partition upper bound expression
+		LessThan string
+	}
+)
+
+// TablePartitions returns the partitions of the table, ordered by position.
+// The returned list is empty if the table is not partitioned.
+func (oDb *DB) TablePartitions(ctx context.Context, table string) ([]TablePartition, error) {
+	query := `SELECT PARTITION_NAME, PARTITION_METHOD, COALESCE(PARTITION_EXPRESSION, ''),
+	        COALESCE(PARTITION_DESCRIPTION, ''), COALESCE(TABLE_ROWS, 0)
+	    FROM information_schema.PARTITIONS
+	    WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND PARTITION_NAME IS NOT NULL
+	    ORDER BY PARTITION_ORDINAL_POSITION`
+	rows, err := oDb.DB.QueryContext(ctx, query, table)
+	if err != nil {
+		return nil, fmt.Errorf("get %s partitions: %w", table, err)
+	}
+	defer func() { _ = rows.Close() }()
+	var l []TablePartition
+	for rows.Next() {
+		var p TablePartition
+		var method sql.NullString
+		if err := rows.Scan(&p.Name, &method, &p.Expression, &p.Description, &p.Rows); err != nil {
+			return nil, fmt.Errorf("get %s partitions: %w", table, err)
+		}
+		p.Method = method.String
+		l = append(l, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get %s partitions: %w", table, err)
+	}
+	return l, nil
+}
+
+// AddPartitions adds RANGE partitions to the table. If catchAll is not
+// empty, the new partitions are split from this MAXVALUE partition.
+func (oDb *DB) AddPartitions(ctx context.Context, table, catchAll string, l []PartitionDefinition) error {
+	defs := make([]string, 0, len(l)+1)
+	for _, p := range l {
+		defs = append(defs, fmt.Sprintf("PARTITION `%s` VALUES LESS THAN (%s)", p.Name, p.LessThan))
+	}
+	var query string
+	if catchAll != "" {
+		defs = append(defs, fmt.Sprintf("PARTITION `%s` VALUES LESS THAN MAXVALUE", catchAll))
+		query = fmt.Sprintf("ALTER TABLE `%s` REORGANIZE PARTITION `%s` INTO (%s)", table, catchAll, strings.Join(defs, ", "))
+	} else {
+		query = fmt.Sprintf("ALTER TABLE `%s` ADD PARTITION (%s)", table, strings.Join(defs, ", "))
+	}
+	if _, err := oDb.DB.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("add %s partitions: %w", table, err)
+	}
+	return nil
+}
+
+// DropPartitions drops the partitions of the table, with their rows.
+func (oDb *DB) DropPartitions(ctx context.Context, table string, names []string) error {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = "`" + name + "`"
+	}
+	query := fmt.Sprintf("ALTER TABLE `%s` DROP PARTITION %s", table, strings.Join(quoted, ","))
+	if _, err := oDb.DB.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("drop %s partitions: %w", table, err)
+	}
+	return nil
+}
+
+// PartitionHasRows returns true if the partition of the table has rows
+// matching where, like "`dash_end` IS NULL", or any row if where is empty.
+func (oDb *DB) PartitionHasRows(ctx context.Context, table, partition, where string) (bool, error) {
+	query := fmt.Sprintf("SELECT 1 FROM `%s` PARTITION (`%s`)", table, partition)
+	if where != "" {
+		query += " WHERE " + where
+	}
+	query += " LIMIT 1"
+	var i int
+	switch err := oDb.DB.QueryRowContext(ctx, query).Scan(&i); err {
+	case nil:
+		return true, nil
+	case sql.ErrNoRows:
+		return false, nil
+	default:
+		return false, fmt.Errorf("check %s partition %s rows: %w", table, partition, err)
+	}
+}
@@ -0,0 +1,79 @@
+package cdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opensvc/oc3/schema"
+)
+
+func buildPatchesQuery(groups []string, isManager bool, selectExprs []string) (string, []any) {
+	q := From(schema.TPatches).
+		RawSelect(selectExprs...)
+
+	if !isManager {
+		cleanGroups := cleanGroups(groups)
+		if len(cleanGroups) == 0 {
+			q = q.WhereRaw("1=0")
+		} else {
+			args := make([]any, len(cleanGroups))
+			for i, g := range cleanGroups {
+				args[i] = g
+			}
+			q = q.WhereRaw(
+				"patches.node_id IN ("+
+					"SELECT n.node_id FROM nodes n"+
+					" JOIN apps a ON n.app = a.app"+
+					" JOIN apps_responsibles ar ON ar.app_id = a.id"+
+					" JOIN auth_group ag ON ag.id = ar.group_id"+
+					" WHERE ag.role IN ("+Placeholders(len(cleanGroups))+")"+
+					")",
+				args...,
+			)
+		}
+	} else {
+		q = q.Where(schema.PatchesID, ">", 0)
+	}
+
+	query, args, err := q.Build()
+	if err != nil {
+		panic(fmt.Sprintf("buildPatchesQuery: %v", err))
+	}
+	return query, args
+}
+
+func (oDb *DB) GetPatches(ctx context.Context, p ListParams) ([]map[string]any, error) {
+	query, args := buildPatchesQuery(p.Groups, p.IsManager, p.SelectExprs)
+	if gb := p.GroupByClause(""); gb != "" {
+		query += " " + gb
+	}
+	query += " " + p.OrderByClause("patches.node_id, patches.patch_num, patches.patch_rev")
+	query, args = appendLimitOffset(query, args, p.Limit, p.Offset)
+
+	rows, err := oDb.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("getPatches: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	return scanRowsToMaps(rows, p.Props, p.TypeHints)
+}
+
+func (oDb *DB) GetNodePatches(ctx context.Context, nodeID string, p ListParams) ([]map[string]any, error) {
+	query, args := buildPatchesQuery(p.Groups, p.IsManager, p.SelectExprs)
+	query += " AND patches.node_id = ?"
+	args = append(args, nodeID)
+	if gb := p.GroupByClause(""); gb != "" {
+		query += " " + gb
+	}
+	query += " " + p.OrderByClause("patches.patch_num, patches.patch_rev")
+	query, args = appendLimitOffset(query, args, p.Limit, p.Offset)
+
+	rows, err := oDb.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("getNodePatches: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	return scanRowsToMaps(rows, p.Props, p.TypeHints)
+}
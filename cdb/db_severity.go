@@ -0,0 +1,253 @@
+package cdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/opensvc/oc3/severity"
+)
+
+type (
+	// AlertSubject is the node or service properties evaluated by the
+	// alert severity rules.
+	AlertSubject struct {
+		Env      string
+		App      string
+		NodeType string
+		Tags     []string
+	}
+)
+
+// ServiceTagNames returns the tag names of the services, indexed by svc_id.
+func (oDb *DB) ServiceTagNames(ctx context.Context, svcIDs ...string) (map[string][]string, error) {
+	if len(svcIDs) == 0 {
+		return nil, nil
+	}
+	args := make([]any, len(svcIDs))
+	for i, s := range svcIDs {
+		args[i] = s
+	}
+	m, err := oDb.tagNames(ctx, `SELECT st.svc_id, t.tag_name FROM svc_tags st
+		JOIN tags t ON t.tag_id = st.tag_id
+		WHERE st.svc_id IN (`+Placeholders(len(svcIDs))+`)`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("get service tag names: %w", err)
+	}
+	return m, nil
+}
+
+// tagNames returns the tag names indexed by the first column of the query
+// rows, the second column being the tag name.
+func (oDb *DB) tagNames(ctx context.Context, query string, args ...any) (map[string][]string, error) {
+	rows, err := oDb.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	m := make(map[string][]string)
+	for rows.Next() {
+		var id, name sql.NullString
+		if err := rows.Scan(&id, &name); err != nil {
+			return nil, err
+		}
+		if id.Valid && name.Valid {
+			m[id.String] = append(m[id.String], name.String)
+		}
+	}
+	return m, rows.Err()
+}
+
+// AlertSubjectOf returns the properties evaluated by the severity rules of
+// the alerts of the node and service. The service properties take
+// precedence over the node properties.
+func (oDb *DB) AlertSubjectOf(ctx context.Context, nodeID, svcID string) (AlertSubject, error) {
+	var s AlertSubject
+	if nodeID != "" {
+		query := `SELECT COALESCE(node_env, ""), COALESCE(app, ""), COALESCE(type, "") FROM nodes WHERE node_id = ?`
+		err := oDb.DB.QueryRowContext(ctx, query, nodeID).Scan(&s.Env, &s.App, &s.NodeType)
+		if err != nil && err != sql.ErrNoRows {
+			return s, fmt.Errorf("get node %s alert subject: %w", nodeID, err)
+		}
+		m, err := oDb.tagNames(ctx, `SELECT nt.node_id, t.tag_name FROM node_tags nt
+			JOIN tags t ON t.tag_id = nt.tag_id
+			WHERE nt.node_id = ?`, nodeID)
+		if err != nil {
+			return s, fmt.Errorf("get node %s tag names: %w", nodeID, err)
+		}
+		s.Tags = append(s.Tags, m[nodeID]...)
+	}
+	if svcID != "" {
+		var env, app string
+		query := `SELECT COALESCE(svc_env, ""), COALESCE(svc_app, "") FROM services WHERE svc_id = ?`
+		err := oDb.DB.QueryRowContext(ctx, query, svcID).Scan(&env, &app)
+		if err != nil && err != sql.ErrNoRows {
+			return s, fmt.Errorf("get service %s alert subject: %w", svcID, err)
+		}
+		if env != "" {
+			s.Env = env
+		}
+		if app != "" {
+			s.App = app
+		}
+		m, err := oDb.ServiceTagNames(ctx, svcID)
+		if err != nil {
+			return s, err
+		}
+		s.Tags = append(s.Tags, m[svcID]...)
+	}
+	return s, nil
+}
+
+// resolveAlert returns the severity rules decision for the alert of the
+// node and service, for the alerts raised one at a time. The alerts raised
+// in bulk by INSERT ... SELECT use alertSeverity instead.
+func (oDb *DB) resolveAlert(ctx context.Context, alertType, nodeID, svcID, env string, builtin int) (severity.Decision, error) {
+	r := severity.Current()
+	if !r.Enabled() {
+		return r.Resolve(severity.Subject{Type: alertType, Env: env, Severity: builtin}), nil
+	}
+	s, err := oDb.AlertSubjectOf(ctx, nodeID, svcID)
+	if err != nil {
+		return severity.Decision{}, err
+	}
+	return r.Resolve(severity.Subject{
+		Type:     alertType,
+		Env:      env,
+		App:      s.App,
+		Tags:     s.Tags,
+		NodeType: s.NodeType,
+		Severity: builtin,
+	}), nil
+}
+
+// alertSeverity returns the sql expression of the severity given by the
+// alert severity rules, and the sql condition of the alerts not suppressed
+// by the rules. The alert is described by the sql expressions of its type,
+// env, node id and service id, and builtin is the sql expression of its
+// built-in severity, kept if no rule matches.
+//
+// The rule values are quoted in the returned sql, so the alert queries
+// keep their placeholders. The node and service expressions must be
+// qualified by the table name or alias of the alert query, as they are
+// evaluated in subqueries.
+func alertSeverity(alertType, env, nodeID, svcID, builtin string) (string, string) {
+	r := severity.Current()
+	if !r.Enabled() {
+		return builtin, "TRUE"
+	}
+	var sev, keep strings.Builder
+	sev.WriteString("CASE")
+	keep.WriteString("CASE")
+	for _, rule := range r.Rules() {
+		cond := ruleCond(rule, alertType, env, nodeID, svcID)
+		if rule.Suppress {
+			fmt.Fprintf(&sev, " WHEN %s THEN %s", cond, builtin)
+			fmt.Fprintf(&keep, " WHEN %s THEN 0", cond)
+		} else {
+			fmt.Fprintf(&sev, " WHEN %s THEN %d", cond, *rule.Severity)
+			fmt.Fprintf(&keep, " WHEN %s THEN 1", cond)
+		}
+	}
+	fmt.Fprintf(&sev, " ELSE %s END", builtin)
+	keep.WriteString(" ELSE 1 END = 1")
+	return "(" + sev.String() + ")", "(" + keep.String() + ")"
+}
+
+// ruleCond returns the sql condition of the alerts matching the rule, like
+// severity.Rule.Match.
+func ruleCond(rule severity.Rule, alertType, env, nodeID, svcID string) string {
+	conds := []string{"TRUE"}
+	if len(rule.Envs) > 0 {
+		conds = append(conds, fmt.Sprintf("COALESCE(%s, '') IN (%s)", env, quoteList(rule.Envs)))
+	}
+	if len(rule.Apps) > 0 {
+		// the service app takes precedence over the node app
+		app := fmt.Sprintf("COALESCE(NULLIF((SELECT sev_s.svc_app FROM services sev_s WHERE sev_s.svc_id = %s), ''),"+
+			" (SELECT sev_n.app FROM nodes sev_n WHERE sev_n.node_id = %s), '')", svcID, nodeID)
+		conds = append(conds, fmt.Sprintf("%s IN (%s)", app, quoteList(rule.Apps)))
+	}
+	if len(rule.NodeTypes) > 0 {
+		nodeType := fmt.Sprintf("COALESCE((SELECT sev_n.type FROM nodes sev_n WHERE sev_n.node_id = %s), '')", nodeID)
+		conds = append(conds, fmt.Sprintf("%s IN (%s)", nodeType, quoteList(rule.NodeTypes)))
+	}
+	if len(rule.Tags) > 0 {
+		tags := quoteList(rule.Tags)
+		conds = append(conds, fmt.Sprintf("(EXISTS (SELECT 1 FROM node_tags sev_nt JOIN tags sev_t ON sev_t.tag_id = sev_nt.tag_id"+
+			" WHERE sev_nt.node_id = %s AND sev_t.tag_name IN (%s))"+
+			" OR EXISTS (SELECT 1 FROM svc_tags sev_st JOIN tags sev_t ON sev_t.tag_id = sev_st.tag_id"+
+			" WHERE sev_st.svc_id = %s AND sev_t.tag_name IN (%s)))", nodeID, tags, svcID, tags))
+	}
+	if len(rule.Types) > 0 {
+		l := make([]string, len(rule.Types))
+		for i, pattern := range rule.Types {
+			l[i] = fmt.Sprintf("%s REGEXP %s", alertType, quoteString(globToRegexp(pattern)))
+		}
+		conds = append(conds, "("+strings.Join(l, " OR ")+")")
+	}
+	return "(" + strings.Join(conds, " AND ") + ")"
+}
+
+// globToRegexp returns the anchored regular expression matching like the
+// path.Match pattern, the syntax of the rule type patterns.
+func globToRegexp(pattern string) string {
+	var b strings.Builder
+	b.WriteString("^")
+	inClass := false
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case c == '\\' && i+1 < len(pattern) && inClass:
+			// an escaped class character is a literal, like a range "-"
+			i++
+			if strings.IndexByte(`\-[]^`, pattern[i]) >= 0 {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(pattern[i])
+		case c == '\\' && i+1 < len(pattern):
+			i++
+			b.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		case inClass && c == ']':
+			inClass = false
+			b.WriteByte(c)
+		case inClass:
+			if c == '^' && pattern[i-1] == '[' {
+				// path.Match negates the class with ^ too
+				b.WriteByte(c)
+			} else if c == '\\' || c == '[' {
+				b.WriteByte('\\')
+				b.WriteByte(c)
+			} else {
+				b.WriteByte(c)
+			}
+		case c == '[':
+			inClass = true
+			b.WriteByte(c)
+		case c == '*':
+			b.WriteString("[^/]*")
+		case c == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+	return b.String()
+}
+
+// quoteString returns the sql string literal of s.
+func quoteString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `'`, `''`)
+	return "'" + s + "'"
+}
+
+func quoteList(l []string) string {
+	quoted := make([]string, len(l))
+	for i, s := range l {
+		quoted[i] = quoteString(s)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,77 @@
+package cdb
+
+import (
+	"path"
+	"regexp"
+	"testing"
+)
+
+// TestGlobToRegexp verifies the sql regular expressions of the rule type
+// patterns match like path.Match, the matcher of the rule types in go.
+func TestGlobToRegexp(t *testing.T) {
+	names := []string{
+		"",
+		"check value",
+		"check",
+		"checks",
+		"service unavailable",
+		"service available but degraded",
+		"node/alert",
+		"mac duplicate",
+		"a", "b", "c", "x", "-", "]", "[", "^", "*", "?", "\\", ".", "a.b", "axb",
+		"action errors",
+		"é",
+	}
+	patterns := []string{
+		"check value",
+		"check *",
+		"check*",
+		"*",
+		"*e*",
+		"service ?navailable",
+		"node?alert",
+		"node*",
+		"?",
+		"[abc]",
+		"[a-c]",
+		"[^a-c]",
+		"[^a]",
+		"[a-]",
+		"[\\-]",
+		"[a\\-c]",
+		"[\\]]",
+		"[\\[]",
+		"[\\^]",
+		"[\\\\]",
+		"[]a]",
+		"\\*",
+		"\\?",
+		"\\[",
+		"\\\\",
+		"\\a",
+		"a.b",
+		"a?b",
+		"[.]",
+		"mac dup*",
+		"action error?",
+		"?*",
+		"[é]",
+	}
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			// the invalid patterns are refused by the rules validation
+			continue
+		}
+		re, err := regexp.Compile(globToRegexp(pattern))
+		if err != nil {
+			t.Errorf("pattern %q: compile %q: %s", pattern, globToRegexp(pattern), err)
+			continue
+		}
+		for _, name := range names {
+			want, _ := path.Match(pattern, name)
+			if got := re.MatchString(name); got != want {
+				t.Errorf("pattern %q (%s) on %q: match %v, want %v", pattern, re, name, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/opensvc/oc3/archive"
+)
+
+func archiveRestore(table, into, from, to, directory string, batchSize int) error {
+	fromDay, err := time.ParseInLocation(time.DateOnly, from, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid --from: %w", err)
+	}
+	toDay := fromDay
+	if to != "" {
+		if toDay, err = time.ParseInLocation(time.DateOnly, to, time.Local); err != nil {
+			return fmt.Errorf("invalid --to: %w", err)
+		}
+	}
+	if into == "" {
+		into = table + "_restore"
+	}
+	if err := setup(sectionScheduler); err != nil {
+		return err
+	}
+	if directory == "" {
+		directory = viper.GetString(sectionScheduler + ".task.trim.archive.directory")
+	}
+	db, err := newDatabase()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = db.Close() }()
+
+	r := &archive.Restorer{
+		DB:        db,
+		Dir:       directory,
+		Table:     table,
+		Into:      into,
+		BatchSize: batchSize,
+	}
+	n, err := r.Restore(context.Background(), fromDay, toDay)
+	if err != nil {
+		return err
+	}
+	slog.Info(fmt.Sprintf("restore %s: %d rows inserted into %s", table, n, into))
+	return nil
+}
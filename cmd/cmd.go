@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/opensvc/oc3/archive"
 	"github.com/opensvc/oc3/util/logkey"
 	"github.com/opensvc/oc3/util/version"
 )
@@ -158,6 +159,57 @@ func cmdSchedulerExec() *cobra.Command {
 	return cmd
 }
 
+func cmdArchive() *cobra.Command {
+	return &cobra.Command{
+		Use:   "archive",
+		Short: "manage the rows archived by the trim task",
+	}
+}
+
+func cmdArchiveRestore() *cobra.Command {
+	var (
+		table, into, from, to, directory string
+		batchSize                        int
+	)
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "load the rows archived in a date range into a side table",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return archiveRestore(table, into, from, to, directory, batchSize)
+		},
+	}
+	cmd.Flags().StringVar(&table, "table", "", "the archived table name")
+	cmd.Flags().StringVar(&into, "into", "", "the side table name, default <table>_restore")
+	cmd.Flags().StringVar(&from, "from", "", "the first day to restore, like 2024-01-31")
+	cmd.Flags().StringVar(&to, "to", "", "the last day to restore, default the first day")
+	cmd.Flags().StringVar(&directory, "directory", "", "the archive directory, default scheduler.task.trim.archive.directory")
+	cmd.Flags().IntVar(&batchSize, "batch-size", archive.DefaultRestoreBatchSize, "the maximum number of rows per insert")
+	_ = cmd.MarkFlagRequired("table")
+	_ = cmd.MarkFlagRequired("from")
+	return cmd
+}
+
+func cmdNotify() *cobra.Command {
+	return &cobra.Command{
+		Use:   "notify",
+		Short: "manage the alert notification channels",
+	}
+}
+
+func cmdNotifyTest() *cobra.Command {
+	var channel string
+	cmd := &cobra.Command{
+		Use:   "test",
+		Short: "send a test message to a notification channel",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return notifyTest(channel)
+		},
+	}
+	cmd.Flags().StringVar(&channel, "channel", "", "the channel name, as configured in scheduler.task.notify.channels")
+	_ = cmd.MarkFlagRequired("channel")
+	return cmd
+}
+
 func cmdVersion() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "version",
@@ -183,9 +235,19 @@ func Root(args []string) *cobra.Command {
 		cmdSchedulerExec(),
 		cmdSchedulerList(),
 	)
+	grpArchive := cmdArchive()
+	grpArchive.AddCommand(
+		cmdArchiveRestore(),
+	)
+	grpNotify := cmdNotify()
+	grpNotify.AddCommand(
+		cmdNotifyTest(),
+	)
 	cmd.AddCommand(
+		grpArchive,
 		cmdFeeder(),
 		cmdApiCollector(),
+		grpNotify,
 		grpScheduler,
 		cmdVersion(),
 		cmdWorker(),
@@ -34,6 +34,12 @@ type (
 		apiRegister(e *echo.Echo)
 	}
 
+	// apiEnabler is implemented by the sections serving their api only
+	// when enabled.
+	apiEnabler interface {
+		apiEnabled() bool
+	}
+
 	docMiddlerwarer interface {
 		docMiddleware() echo.MiddlewareFunc
 	}
@@ -65,7 +71,11 @@ func start(i Sectioner) (bool, <-chan error) {
 	// define public paths
 	publicPath := []string{}
 	publicPrefix := []string{}
-	if _, ok := i.(apiRegister); ok {
+	a, hasApi := i.(apiRegister)
+	if e, ok := i.(apiEnabler); ok && !e.apiEnabled() {
+		hasApi = false
+	}
+	if hasApi {
 		needRun = true
 		publicPath = append(publicPath, pathApi+"/version")
 
@@ -120,7 +130,7 @@ func start(i Sectioner) (bool, <-chan error) {
 		e.Use(a.authMiddleware(publicPath, publicPrefix))
 	}
 
-	if a, ok := i.(apiRegister); ok {
+	if hasApi {
 		slog.Info(fmt.Sprintf("add handler for openapi: %s", pathApi))
 		a.apiRegister(e)
 	}
@@ -12,6 +12,12 @@ var (
 	configCandidateDirs = []string{"/etc/oc3/", "$HOME/.config/oc3", "./"}
 )
 
+const (
+	// defaultMessengerKey is the well known messenger sign key, only
+	// accepted by the messenger in dev mode.
+	defaultMessengerKey = "magix123"
+)
+
 func workerSection(name string) string {
 	if name != "" {
 		return sectionWorker + "." + name
@@ -49,6 +55,7 @@ func setDefaultFeederConfig() {
 	viper.SetDefault(s+".ui.enable", false)
 	viper.SetDefault(s+".sync.timeout", "2s")
 	viper.SetDefault(s+".log.request.level", "none")
+	viper.SetDefault(s+".body.max_size", "32mb")
 }
 
 func setDefaultServerConfig() {
@@ -67,13 +74,21 @@ func setDefaultServerConfig() {
 func setDefaultSchedulerConfig() {
 	s := sectionScheduler
 	viper.SetDefault(s+".addr", "127.0.0.1:8082")
+	viper.SetDefault(s+".api.enable", false)
 	viper.SetDefault(s+".directories.uploads", "/oc3/uploads")
 	viper.SetDefault(s+".pprof.net.enable", false)
 	viper.SetDefault(s+".pprof.ux.enable", false)
 	viper.SetDefault(s+".pprof.ux.socket", "/var/run/oc3_scheduler_pprof.sock")
 	viper.SetDefault(s+".metrics.enable", false)
+	viper.SetDefault(s+".ui.enable", false)
+	viper.SetDefault(s+".sync.timeout", "2s")
 	viper.SetDefault(s+".task.trim.retention", 365)
 	viper.SetDefault(s+".task.trim.batch_size", 1000)
+	viper.SetDefault(s+".task.trim.archive.directory", "/oc3/archive")
+	viper.SetDefault(s+".task.partition.premake", 3)
+	viper.SetDefault(s+".election.mode", "none")
+	viper.SetDefault(s+".election.backend", "mysql")
+	viper.SetDefault(s+".election.lease", "15s")
 	viper.SetDefault(s+".log.request.level", "none")
 }
 
@@ -84,7 +99,14 @@ func setDefaultMessengerConfig() {
 	viper.SetDefault(s+".pprof.ux.enable", false)
 	viper.SetDefault(s+".pprof.ux.socket", "/var/run/oc3_messenger_pprof.sock")
 	viper.SetDefault(s+".metrics.enable", false)
-	viper.SetDefault(s+".key", "magix123")
+	viper.SetDefault(s+".key", defaultMessengerKey)
+	viper.SetDefault(s+".dev", false)
+	// signature.algorithm is used by the messenger and the producers. The
+	// md5 algorithm of the legacy producers also requires
+	// signature.legacy_md5.
+	viper.SetDefault(s+".signature.algorithm", "sha256")
+	viper.SetDefault(s+".signature.replay_window", "30s")
+	viper.SetDefault(s+".signature.legacy_md5", false)
 	viper.SetDefault(s+".url", "http://127.0.0.1:8889")
 	viper.SetDefault(s+".require_token", false)
 	viper.SetDefault(s+".key_file", "")
@@ -106,6 +128,24 @@ func setDefaultRunnerConfig() {
 	viper.SetDefault(s+".purge_timeout", 0)
 	viper.SetDefault(s+".notification_timeout", 0)
 	viper.SetDefault(s+".command_timeout", 0)
+	viper.SetDefault(s+".max_output_size", 0)
+	// max_in_flight.<scope> is the maximum number of push and pull actions
+	// dispatched and not yet terminated per node, cluster or user, on top
+	// of the nb_workers limit. 0 or a negative value means unlimited.
+	viper.SetDefault(s+".max_in_flight.node", 0)
+	viper.SetDefault(s+".max_in_flight.cluster", 0)
+	viper.SetDefault(s+".max_in_flight.user", 0)
+	viper.SetDefault(s+".transport.default", "local")
+	// free_form.apps are the apps allowed to queue free-form commands,
+	// "*" for all apps. Only the structured actions are executed by
+	// default.
+	viper.SetDefault(s+".free_form.apps", []string{})
+	viper.SetDefault(s+".ssh.user", "opensvc")
+	viper.SetDefault(s+".ssh.port", "22")
+	viper.SetDefault(s+".ssh.key", "/etc/oc3/ssh/id_ed25519")
+	viper.SetDefault(s+".ssh.known_hosts", "/etc/oc3/ssh/known_hosts")
+	viper.SetDefault(s+".ssh.connect_timeout", 0)
+	viper.SetDefault(s+".ssh.idle_timeout", 0)
 }
 
 func setDefaultDBConfig() {
@@ -165,5 +205,7 @@ func newEv() *oc2websocket.T {
 	return &oc2websocket.T{
 		Url: viper.GetString("messenger.url"),
 		Key: []byte(viper.GetString("messenger.key")),
+
+		Algorithm: viper.GetString("messenger.signature.algorithm"),
 	}
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/opensvc/oc3/cdb"
 	api "github.com/opensvc/oc3/feeder"
 	handlers "github.com/opensvc/oc3/feeder/handlers"
+	"github.com/opensvc/oc3/worker"
 	"github.com/opensvc/oc3/xauth"
 )
 
@@ -37,14 +38,31 @@ func (t *feeder) apiRegister(e *echo.Echo) {
 	odb := cdb.New(t.db)
 	odb.CreateSession(nil)
 
-	api.RegisterHandlersWithBaseURL(e, &handlers.Api{
+	api.RegisterHandlersWithOptions(e, &handlers.Api{
 		DB:  t.db,
 		ODB: odb,
 
 		Redis:       t.redis,
 		UI:          viper.GetBool(t.section + ".ui.enable"),
 		SyncTimeout: viper.GetDuration(t.section + ".sync.timeout"),
-	}, pathApi)
+		Formats:     worker.DaemonStatusFormats(),
+	}, api.RegisterHandlersOptions{
+		BaseURL:              pathApi,
+		OperationMiddlewares: handlers.BodyMiddlewares(t.bodyLimits()),
+	})
+}
+
+// bodyLimits returns the request body size limits from the body.max_size
+// and body.max_sizes.<operation id> settings, like "32mb".
+func (t *feeder) bodyLimits() handlers.BodyLimits {
+	limits := handlers.BodyLimits{
+		MaxSize:  int64(viper.GetSizeInBytes(t.section + ".body.max_size")),
+		MaxSizes: make(map[string]int64),
+	}
+	for operationID := range viper.GetStringMap(t.section + ".body.max_sizes") {
+		limits.MaxSizes[operationID] = int64(viper.GetSizeInBytes(t.section + ".body.max_sizes." + operationID))
+	}
+	return limits
 }
 
 func (t *feeder) docMiddleware() echo.MiddlewareFunc {
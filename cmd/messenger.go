@@ -37,6 +37,10 @@ func startMessenger() error {
 
 func (t *messengerT) run() error {
 	section := t.Section()
+	key := viper.GetString(section + ".key")
+	if key == defaultMessengerKey && !viper.GetBool(section+".dev") {
+		return fmt.Errorf("refuse to start with the default %s.key: set a new key or enable %s.dev", section, section)
+	}
 	u, err := url.Parse(viper.GetString(section + ".url"))
 	if err != nil {
 		slog.Warn(fmt.Sprintf("parsing %s.url: %v", section, err))
@@ -55,10 +59,13 @@ func (t *messengerT) run() error {
 	cometCmd := messenger.CmdComet{
 		Address:      u.Hostname(),
 		Port:         u.Port(),
-		Key:          viper.GetString(sectionMessenger + ".key"),
+		Key:          key,
 		RequireToken: viper.GetBool(sectionMessenger + ".require_token"),
 		CertFile:     viper.GetString(sectionMessenger + ".cert_file"),
 		KeyFile:      viper.GetString(sectionMessenger + ".key_file"),
+		Algorithm:    viper.GetString(sectionMessenger + ".signature.algorithm"),
+		ReplayWindow: viper.GetDuration(sectionMessenger + ".signature.replay_window"),
+		LegacyMD5:    viper.GetBool(sectionMessenger + ".signature.legacy_md5"),
 	}
 
 	return cometCmd.Run()
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/opensvc/oc3/notify"
+)
+
+// notifyTest sends a test message to a notification channel, to validate
+// its configuration.
+func notifyTest(channel string) error {
+	if err := setup(sectionScheduler); err != nil {
+		return err
+	}
+	cfg, err := notify.LoadConfig()
+	if err != nil {
+		return err
+	}
+	n, err := notify.New(cfg)
+	if err != nil {
+		return err
+	}
+	ch := n.Channel(channel)
+	if ch == nil {
+		return fmt.Errorf("channel %s is not configured", channel)
+	}
+	m := notify.NewMessage([]notify.Event{{
+		Kind: notify.EventRaise,
+		Type: "test",
+		Text: "oc3 notification test message",
+		At:   time.Now(),
+	}})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if err := ch.Send(ctx, m); err != nil {
+		return err
+	}
+	slog.Info(fmt.Sprintf("test message sent to channel %s (%s %s)", channel, ch.Type(), ch.Recipients()))
+	return nil
+}
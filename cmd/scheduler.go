@@ -5,12 +5,16 @@ import (
 	"database/sql"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/labstack/echo/v4"
 	"github.com/shaj13/go-guardian/v2/auth/strategies/union"
+	"github.com/spf13/viper"
 
 	"github.com/opensvc/oc3/scheduler"
+	api "github.com/opensvc/oc3/schedulerapi"
+	handlers "github.com/opensvc/oc3/schedulerapi/handlers"
 	"github.com/opensvc/oc3/xauth"
 )
 
@@ -19,15 +23,37 @@ type (
 		db      *sql.DB
 		redis   *redis.Client
 		section string
+		sched   *scheduler.Scheduler
 	}
 )
 
 func (t *schedulerT) authMiddleware(publicPath, publicPrefix []string) echo.MiddlewareFunc {
-	return AuthMiddleware(union.New(
+	return handlers.AuthMiddleware(union.New(
 		xauth.NewPublicStrategy(publicPath, publicPrefix),
+		xauth.NewBasicWeb2py(t.db, viper.GetString("w2p_hmac")),
 	))
 }
 
+// apiEnabled returns true if the task control api is enabled. The api is
+// opt-in, so the scheduler listens only if an api, metrics or profiling
+// feature is enabled.
+func (t *schedulerT) apiEnabled() bool {
+	return viper.GetBool(t.section + ".api.enable")
+}
+
+func (t *schedulerT) apiRegister(e *echo.Echo) {
+	api.RegisterHandlersWithBaseURL(e, &handlers.Api{
+		DB:          t.db,
+		Scheduler:   t.sched,
+		UI:          viper.GetBool(t.section + ".ui.enable"),
+		SyncTimeout: viper.GetDuration(t.section + ".sync.timeout"),
+	}, pathApi)
+}
+
+func (t *schedulerT) docMiddleware() echo.MiddlewareFunc {
+	return handlers.UIMiddleware(context.Background(), pathApi, pathSpec)
+}
+
 func newScheduler() (*schedulerT, error) {
 	if err := setup(sectionScheduler); err != nil {
 		return nil, err
@@ -56,8 +82,27 @@ func scheduleExec(name string) error {
 	return task.Exec(context.Background())
 }
 
+// scheduleList prints the tasks. The schedule overrides, states and last
+// results are read only if the database is reachable, so the compiled tasks
+// are listed offline.
 func scheduleList() error {
-	scheduler.Tasks.Print()
+	var infos []scheduler.TaskInfo
+	if err := initConfig(); err != nil {
+		slog.Debug(fmt.Sprintf("init config: %s", err))
+	}
+	db, err := newDatabase()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = db.Close() }()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := db.PingContext(ctx); err != nil {
+		slog.Debug(fmt.Sprintf("database not reachable, list the compiled tasks: %s", err))
+	} else if infos, err = scheduler.GetTaskInfos(ctx, db); err != nil {
+		slog.Warn(fmt.Sprintf("get task states: %s", err))
+	}
+	scheduler.Tasks.Print(infos)
 	return nil
 }
 
@@ -66,6 +111,11 @@ func startScheduler() error {
 	if err != nil {
 		return err
 	}
+	t.sched = &scheduler.Scheduler{
+		DB:    t.db,
+		Redis: t.redis,
+		Ev:    newEv(),
+	}
 	if ok, errC := start(t); ok {
 		slog.Info(fmt.Sprintf("%s started", t.Section()))
 		go func() {
@@ -75,12 +125,7 @@ func startScheduler() error {
 		}()
 	}
 
-	sched := &scheduler.Scheduler{
-		DB:    t.db,
-		Redis: t.redis,
-		Ev:    newEv(),
-	}
-	return sched.Run()
+	return t.sched.Run()
 }
 
 func (t *schedulerT) Section() string {
@@ -40,6 +40,7 @@ func (t *server) apiRegister(e *echo.Echo) {
 		DB:          t.db,
 		ODB:         odb,
 		Redis:       t.redis,
+		Ev:          newEv(),
 		UI:          viper.GetBool(t.section + ".ui.enable"),
 		SyncTimeout: viper.GetDuration(t.section + ".sync.timeout"),
 		SubSystem:   t.section,
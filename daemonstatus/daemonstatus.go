@@ -0,0 +1,295 @@
+// Package daemonstatus defines the typed opensvc agent daemon status data,
+// by payload version, and validates the decoded json data against them.
+//
+// The worker registry holds the supported Formats with their data provider
+// constructors, and the feeder accepts the versions of these Formats. A new
+// payload version is supported by adding its typed data here, and its Format
+// and data provider to the worker registry.
+//
+// The validation collects all the fields not conforming to the typed data,
+// so the feeder rejects the payloads the worker would not decode, and
+// reports the agent side format changes. The unknown fields are ignored.
+package daemonstatus
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+type (
+	// Format is a daemon status data format, selected by the opensvc client
+	// version prefix.
+	Format struct {
+		// Name is the format name, like v3
+		Name string
+
+		// Prefix is the opensvc client version prefix, like "3."
+		Prefix string
+
+		// Type is the typed data the format data is decoded to
+		Type reflect.Type
+
+		// Instance is the typed data of the instance status posted alone by
+		// the agents of the format, nil if not posted
+		Instance reflect.Type
+
+		// Objects and Instances are the tokens of the objects and instances
+		// locations in the data, with the {object} and {node} placeholders
+		// for the map keys
+		Objects   []string
+		Instances []string
+	}
+
+	// FieldError is a field of the data not conforming to its type.
+	FieldError struct {
+		// Path is the dotted location of the field, like
+		// cluster.node.n1.status.frozen_at
+		Path string `json:"path"`
+
+		// Field is the Path with the map keys and array indexes replaced
+		// by *, like
+		// cluster.node.*.status.frozen_at
+		Field string `json:"field"`
+
+		Expected string `json:"expected"`
+		Got      string `json:"got"`
+	}
+
+	// Formats is a list of supported formats.
+	Formats []Format
+
+	// Report is the result of a daemon status data validation.
+	Report struct {
+		Version   string       `json:"version"`
+		Schema    string       `json:"schema"`
+		CheckedAt time.Time    `json:"checked_at"`
+		Errors    []FieldError `json:"errors"`
+	}
+)
+
+const (
+	// reportErrMax is the maximum number of field errors described by
+	// Report.Err
+	reportErrMax = 5
+)
+
+const (
+	// ObjectKey and NodeKey are the placeholders of the object and node
+	// names in the Format locations
+	ObjectKey = "{object}"
+	NodeKey   = "{node}"
+)
+
+var (
+	timeType = reflect.TypeFor[time.Time]()
+)
+
+// Lookup returns the Format of the opensvc client version, and false if the
+// version is not supported.
+func (l Formats) Lookup(version string) (Format, bool) {
+	for _, f := range l {
+		if strings.HasPrefix(version, f.Prefix) {
+			return f, true
+		}
+	}
+	return Format{}, false
+}
+
+// Versions returns the supported opensvc client version patterns, like 3.x
+func (l Formats) Versions() []string {
+	versions := make([]string, len(l))
+	for i, f := range l {
+		versions[i] = f.Prefix + "x"
+	}
+	return versions
+}
+
+// Validate returns the validation report of the daemon status data, with the
+// field errors sorted by path.
+func (f Format) Validate(version string, data map[string]any) Report {
+	return newReport(version, f.Name, f.Type, data)
+}
+
+// ValidateInstance returns the validation report of the instance status data
+// posted alone, with the field errors sorted by path. The format must have an
+// Instance type.
+func (f Format) ValidateInstance(version string, data map[string]any) Report {
+	return newReport(version, f.Name+"-instance", f.Instance, data)
+}
+
+func newReport(version, schema string, t reflect.Type, data map[string]any) Report {
+	r := Report{Version: version, Schema: schema, CheckedAt: time.Now(), Errors: []FieldError{}}
+	r.validate(t, data, nil, nil)
+	slices.SortFunc(r.Errors, func(a, b FieldError) int { return strings.Compare(a.Path, b.Path) })
+	return r
+}
+
+// Valid returns true if all the fields conform.
+func (r Report) Valid() bool {
+	return len(r.Errors) == 0
+}
+
+// Equal returns true if the reports have the same version, schema and field
+// errors, regardless of their check time.
+func (r Report) Equal(other Report) bool {
+	return r.Version == other.Version && r.Schema == other.Schema && slices.Equal(r.Errors, other.Errors)
+}
+
+// Err returns an error describing the first field errors, or nil if all
+// the fields conform.
+func (r Report) Err() error {
+	if r.Valid() {
+		return nil
+	}
+	l := make([]string, 0, reportErrMax)
+	for i, e := range r.Errors {
+		if i == reportErrMax {
+			l = append(l, fmt.Sprintf("and %d more", len(r.Errors)-reportErrMax))
+			break
+		}
+		l = append(l, e.String())
+	}
+	return fmt.Errorf("invalid daemon status %s data: %s", r.Schema, strings.Join(l, "; "))
+}
+
+func (e FieldError) String() string {
+	return fmt.Sprintf("%s: expected %s, got %s", e.Path, e.Expected, e.Got)
+}
+
+// validate checks v against the type t, and appends the errors to the report.
+// The null values conform to all types.
+func (r *Report) validate(t reflect.Type, v any, path, field []string) {
+	if v == nil {
+		return
+	}
+	fail := func(expected string) {
+		r.Errors = append(r.Errors, FieldError{
+			Path:     strings.Join(path, "."),
+			Field:    strings.Join(field, "."),
+			Expected: expected,
+			Got:      jsonType(v),
+		})
+	}
+	if t == timeType {
+		if s, ok := v.(string); !ok {
+			fail("date-time")
+		} else if _, err := time.Parse(time.RFC3339Nano, s); err != nil {
+			fail("date-time")
+		}
+		return
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		r.validate(t.Elem(), v, path, field)
+	case reflect.Interface:
+	case reflect.String:
+		if _, ok := v.(string); !ok {
+			fail("string")
+		}
+	case reflect.Bool:
+		if _, ok := v.(bool); !ok {
+			fail("boolean")
+		}
+	case reflect.Float32, reflect.Float64:
+		if _, ok := v.(float64); !ok {
+			fail("number")
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if f, ok := v.(float64); !ok || f != float64(int64(f)) {
+			fail("integer")
+		}
+	case reflect.Slice:
+		l, ok := v.([]any)
+		if !ok {
+			fail("array")
+			return
+		}
+		for i, e := range l {
+			r.validate(t.Elem(), e, append(path, fmt.Sprint(i)), append(field, "*"))
+		}
+	case reflect.Map:
+		m, ok := v.(map[string]any)
+		if !ok {
+			fail("object")
+			return
+		}
+		for k, e := range m {
+			r.validate(t.Elem(), e, append(path, k), append(field, "*"))
+		}
+	case reflect.Struct:
+		m, ok := v.(map[string]any)
+		if !ok {
+			fail("object")
+			return
+		}
+		for i := range t.NumField() {
+			f := t.Field(i)
+			name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if name == "" || name == "-" {
+				continue
+			}
+			e, ok := m[name]
+			if !ok {
+				if f.Tag.Get("required") == "true" {
+					r.Errors = append(r.Errors, FieldError{
+						Path:     strings.Join(append(path, name), "."),
+						Field:    strings.Join(append(field, name), "."),
+						Expected: jsonKind(f.Type),
+						Got:      "missing",
+					})
+				}
+				continue
+			}
+			r.validate(f.Type, e, append(path, name), append(field, name))
+		}
+	}
+}
+
+// jsonType returns the json type name of a decoded json value.
+func jsonType(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64, json.Number:
+		return "number"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+// jsonKind returns the json type name of the values decoded to t.
+func jsonKind(t reflect.Type) string {
+	if t == timeType {
+		return "date-time"
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		return jsonKind(t.Elem())
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "integer"
+	case reflect.Slice:
+		return "array"
+	case reflect.Map, reflect.Struct:
+		return "object"
+	default:
+		return "any"
+	}
+}
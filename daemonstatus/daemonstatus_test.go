@@ -0,0 +1,334 @@
+package daemonstatus
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+var testFormats = Formats{
+	{Name: "v2", Prefix: "2.", Type: reflect.TypeFor[DataV2](), Instance: reflect.TypeFor[InstanceV2]()},
+	{Name: "v3", Prefix: "3.", Type: reflect.TypeFor[DataV3]()},
+}
+
+func TestFormatValidate(t *testing.T) {
+	cases := []struct {
+		name    string
+		version string
+		data    string
+		want    []FieldError
+	}{
+		{
+			name:    "v2 conforming",
+			version: "2.1-1234",
+			data: `{
+				"cluster_id": "c1",
+				"cluster_name": "cluster1",
+				"nodes": {
+					"n1": {
+						"frozen": 0,
+						"hb": {
+							"hb#1.rx": {"type": "unicast", "state": "running", "peers": {
+								"n2": {"beating": true, "desc": ":10000 ← n2", "last_at": "2024-09-02T10:00:00.123456Z"}
+							}}
+						},
+						"services": {"status": {
+							"s1": {
+								"app": "app1",
+								"avail": "up",
+								"overall": "up",
+								"frozen": 1725271200.5,
+								"monitor": {"status": "idle", "global_expect": null},
+								"status_group": {"fs": "up"},
+								"resources": {"fs#1": {"status": "up", "label": "/srv", "type": "fs.xfs", "log": ["info: mounted"]}}
+							}
+						}}
+					}
+				},
+				"services": {"s1": {"avail": "up", "overall": "up", "placement": "optimal", "frozen": "thawed", "provisioned": "mixed"}}
+			}`,
+		},
+		{
+			name:    "v2 missing required",
+			version: "2.1",
+			data:    `{"cluster_id": "c1", "nodes": {}}`,
+			want: []FieldError{
+				{Path: "cluster_name", Field: "cluster_name", Expected: "string", Got: "missing"},
+				{Path: "services", Field: "services", Expected: "object", Got: "missing"},
+			},
+		},
+		{
+			name:    "v2 not conforming",
+			version: "2.1",
+			data: `{
+				"cluster_id": "c1",
+				"cluster_name": 1,
+				"nodes": {
+					"n1": {
+						"frozen": "yes",
+						"hb": {"hb#1.rx": {"peers": {"n2": {"beating": "true", "last_at": "yesterday"}}}},
+						"services": {"status": {"s1": {"resources": {"fs#1": {"log": "mounted"}}}}}
+					}
+				},
+				"services": []
+			}`,
+			want: []FieldError{
+				{Path: "cluster_name", Field: "cluster_name", Expected: "string", Got: "number"},
+				{Path: "nodes.n1.frozen", Field: "nodes.*.frozen", Expected: "number", Got: "string"},
+				{Path: "nodes.n1.hb.hb#1.rx.peers.n2.beating", Field: "nodes.*.hb.*.peers.*.beating", Expected: "boolean", Got: "string"},
+				{Path: "nodes.n1.hb.hb#1.rx.peers.n2.last_at", Field: "nodes.*.hb.*.peers.*.last_at", Expected: "date-time", Got: "string"},
+				{Path: "nodes.n1.services.status.s1.resources.fs#1.log", Field: "nodes.*.services.status.*.resources.*.log", Expected: "array", Got: "string"},
+				{Path: "services", Field: "services", Expected: "object", Got: "array"},
+			},
+		},
+		{
+			name:    "v3 conforming",
+			version: "3.0.0-alpha1",
+			data: `{
+				"cluster": {
+					"config": {"id": "c1", "name": "cluster1"},
+					"node": {
+						"n1": {
+							"status": {"frozen_at": "0001-01-01T00:00:00Z"},
+							"daemon": {"heartbeat": {"streams": [
+								{"id": "hb#1.rx", "type": "unicast", "state": "running", "peers": {
+									"n2": {"is_beating": true, "desc": ":1215 ← n2", "last_at": "2024-09-02T10:00:00+02:00"}
+								}}
+							]}},
+							"instance": {
+								"s1": {
+									"config": {"app": "app1", "resources": {"fs#1": {"is_monitored": false}}},
+									"monitor": {"state": "idle", "global_expect": "none"},
+									"status": {
+										"avail": "up",
+										"overall": "up",
+										"frozen_at": "2024-09-02T10:00:00Z",
+										"resources": {"fs#1": {"status": "up", "log": [{"level": "info", "message": "mounted"}]}}
+									}
+								}
+							}
+						}
+					},
+					"object": {"s1": {"avail": "up", "overall": "up", "placement_state": "optimal", "frozen": "thawed", "provisioned": "true"}}
+				}
+			}`,
+		},
+		{
+			name:    "v3 missing required",
+			version: "3.0.0",
+			data:    `{"cluster": {"config": {"id": "c1"}, "node": {}}}`,
+			want: []FieldError{
+				{Path: "cluster.config.name", Field: "cluster.config.name", Expected: "string", Got: "missing"},
+				{Path: "cluster.object", Field: "cluster.object", Expected: "object", Got: "missing"},
+			},
+		},
+		{
+			name:    "v3 missing root",
+			version: "3.0.0",
+			data:    `{}`,
+			want: []FieldError{
+				{Path: "cluster", Field: "cluster", Expected: "object", Got: "missing"},
+			},
+		},
+		{
+			name:    "v3 not conforming",
+			version: "3.0.0",
+			data: `{
+				"cluster": {
+					"config": {"id": "c1", "name": "cluster1"},
+					"node": {
+						"n1": {
+							"status": {"frozen_at": 0},
+							"daemon": {"heartbeat": {"streams": [
+								{"id": "hb#1.rx"},
+								{"id": "hb#2.rx", "peers": {"n2": {"is_beating": "true"}}}
+							]}},
+							"instance": {"s1": {"status": {"frozen_at": "2024-09-02 10:00:00"}}}
+						}
+					},
+					"object": {"s1": "up"}
+				}
+			}`,
+			want: []FieldError{
+				{Path: "cluster.node.n1.daemon.heartbeat.streams.1.peers.n2.is_beating", Field: "cluster.node.*.daemon.heartbeat.streams.*.peers.*.is_beating", Expected: "boolean", Got: "string"},
+				{Path: "cluster.node.n1.instance.s1.status.frozen_at", Field: "cluster.node.*.instance.*.status.frozen_at", Expected: "date-time", Got: "string"},
+				{Path: "cluster.node.n1.status.frozen_at", Field: "cluster.node.*.status.frozen_at", Expected: "date-time", Got: "number"},
+				{Path: "cluster.object.s1", Field: "cluster.object.*", Expected: "object", Got: "string"},
+			},
+		},
+		{
+			name:    "v3 nulls",
+			version: "3.0.0",
+			data: `{
+				"cluster": {
+					"config": {"id": "c1", "name": null},
+					"node": {
+						"n1": {
+							"status": {"frozen_at": null},
+							"daemon": {"heartbeat": {"streams": null}},
+							"instance": {"s1": null, "s2": {"config": null, "monitor": null, "status": {"resources": {"fs#1": null}}}}
+						}
+					},
+					"object": {"s1": null}
+				}
+			}`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var data map[string]any
+			if err := json.Unmarshal([]byte(tc.data), &data); err != nil {
+				t.Fatalf("data Unmarshal: %s", err)
+			}
+			f, ok := testFormats.Lookup(tc.version)
+			if !ok {
+				t.Fatalf("Lookup %s: not found", tc.version)
+			}
+			r := f.Validate(tc.version, data)
+			if r.Version != tc.version {
+				t.Errorf("Version = %s, want %s", r.Version, tc.version)
+			}
+			if got := r.Errors; !slices.Equal(got, tc.want) {
+				t.Errorf("Errors = %v, want %v", got, tc.want)
+			}
+			if got := r.Err() == nil; got != (len(tc.want) == 0) {
+				t.Errorf("Err = %v", r.Err())
+			}
+		})
+	}
+}
+
+func TestFormatsLookup(t *testing.T) {
+	if f, ok := testFormats.Lookup("3.0.0-beta1"); !ok || f.Name != "v3" {
+		t.Errorf("Lookup 3.0.0-beta1 = %s, %v, want v3, true", f.Name, ok)
+	}
+	if _, ok := testFormats.Lookup("1.9"); ok {
+		t.Errorf("Lookup 1.9: want false")
+	}
+	if got, want := testFormats.Versions(), []string{"2.x", "3.x"}; !slices.Equal(got, want) {
+		t.Errorf("Versions = %v, want %v", got, want)
+	}
+}
+
+func TestReportValidate(t *testing.T) {
+	type sample struct {
+		Count int       `json:"count"`
+		Ratio float64   `json:"ratio"`
+		At    time.Time `json:"at"`
+		Items []int     `json:"items"`
+		Sub   *struct {
+			Name string `json:"name" required:"true"`
+		} `json:"sub"`
+		Any      any    `json:"any"`
+		Internal string `json:"-"`
+	}
+	cases := []struct {
+		name string
+		data string
+		want []FieldError
+	}{
+		{
+			name: "conforming",
+			data: `{"count": 3, "ratio": 0.5, "at": "2024-09-02T10:00:00.5+02:00", "items": [1, 2], "sub": {"name": "a"}, "any": [1, "a"], "-": 1}`,
+		},
+		{
+			name: "nulls",
+			data: `{"count": null, "ratio": null, "at": null, "items": [null], "sub": null, "any": null}`,
+		},
+		{
+			name: "unknown fields",
+			data: `{"other": {"count": "a"}}`,
+		},
+		{
+			name: "integer as float",
+			data: `{"count": 3.5, "items": [1, 2e0, 2.5]}`,
+			want: []FieldError{
+				{Path: "count", Field: "count", Expected: "integer", Got: "number"},
+				{Path: "items.2", Field: "items.*", Expected: "integer", Got: "number"},
+			},
+		},
+		{
+			name: "integer as string",
+			data: `{"count": "3", "ratio": "0.5"}`,
+			want: []FieldError{
+				{Path: "count", Field: "count", Expected: "integer", Got: "string"},
+				{Path: "ratio", Field: "ratio", Expected: "number", Got: "string"},
+			},
+		},
+		{
+			name: "times",
+			data: `{"at": "2024-09-02"}`,
+			want: []FieldError{
+				{Path: "at", Field: "at", Expected: "date-time", Got: "string"},
+			},
+		},
+		{
+			name: "time as number",
+			data: `{"at": 1725271200}`,
+			want: []FieldError{
+				{Path: "at", Field: "at", Expected: "date-time", Got: "number"},
+			},
+		},
+		{
+			name: "required in optional struct",
+			data: `{"sub": {}}`,
+			want: []FieldError{
+				{Path: "sub.name", Field: "sub.name", Expected: "string", Got: "missing"},
+			},
+		},
+		{
+			name: "array and object mismatch",
+			data: `{"items": {"a": 1}, "sub": [true]}`,
+			want: []FieldError{
+				{Path: "items", Field: "items", Expected: "array", Got: "object"},
+				{Path: "sub", Field: "sub", Expected: "object", Got: "array"},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var data map[string]any
+			if err := json.Unmarshal([]byte(tc.data), &data); err != nil {
+				t.Fatalf("data Unmarshal: %s", err)
+			}
+			r := newReport("0.0", "sample", reflect.TypeFor[sample](), data)
+			if got := r.Errors; !slices.Equal(got, tc.want) {
+				t.Errorf("Errors = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFormatValidateInstance(t *testing.T) {
+	f, _ := testFormats.Lookup("2.1")
+	var data map[string]any
+	if err := json.Unmarshal([]byte(`{"avail": "up", "frozen": "no", "resources": {"fs#1": {"monitor": 1}}}`), &data); err != nil {
+		t.Fatalf("data Unmarshal: %s", err)
+	}
+	want := []FieldError{
+		{Path: "frozen", Field: "frozen", Expected: "number", Got: "string"},
+		{Path: "resources.fs#1.monitor", Field: "resources.*.monitor", Expected: "boolean", Got: "number"},
+	}
+	r := f.ValidateInstance("2.1", data)
+	if r.Schema != "v2-instance" {
+		t.Errorf("Schema = %s, want v2-instance", r.Schema)
+	}
+	if !slices.Equal(r.Errors, want) {
+		t.Errorf("Errors = %v, want %v", r.Errors, want)
+	}
+}
+
+func TestReportEqual(t *testing.T) {
+	a := Report{Version: "3.0", Schema: "v3", CheckedAt: time.Now(), Errors: []FieldError{{Path: "a", Field: "a", Expected: "string", Got: "number"}}}
+	b := a
+	b.CheckedAt = a.CheckedAt.Add(time.Hour)
+	if !a.Equal(b) {
+		t.Errorf("reports checked at different times differ")
+	}
+	b.Errors = nil
+	if a.Equal(b) {
+		t.Errorf("reports with different errors are equal")
+	}
+}
@@ -0,0 +1,95 @@
+package daemonstatus
+
+import (
+	"time"
+)
+
+type (
+	// DataV2 is the opensvc agent v2 daemon status data.
+	DataV2 struct {
+		ClusterID   string               `json:"cluster_id" required:"true"`
+		ClusterName string               `json:"cluster_name" required:"true"`
+		Nodes       map[string]NodeV2    `json:"nodes" required:"true"`
+		Services    map[string]*ObjectV2 `json:"services" required:"true"`
+	}
+
+	// NodeV2 is a node of the v2 daemon status data.
+	NodeV2 struct {
+		// Frozen is the node freeze unix timestamp, 0 if thawed
+		Frozen float64 `json:"frozen"`
+
+		Hb       map[string]HeartbeatV2 `json:"hb"`
+		Services struct {
+			Status map[string]*InstanceV2 `json:"status"`
+		} `json:"services"`
+	}
+
+	// HeartbeatV2 is a node heartbeat of the v2 daemon status data.
+	HeartbeatV2 struct {
+		Type  string                     `json:"type"`
+		State string                     `json:"state"`
+		Peers map[string]HeartbeatPeerV2 `json:"peers"`
+	}
+
+	// HeartbeatPeerV2 is a heartbeat peer of the v2 daemon status data.
+	HeartbeatPeerV2 struct {
+		// Beating is nil if the peer beating state is unknown
+		Beating *bool     `json:"beating"`
+		Desc    string    `json:"desc"`
+		LastAt  time.Time `json:"last_at"`
+	}
+
+	// ObjectV2 is an object of the v2 daemon status data.
+	ObjectV2 struct {
+		Avail     string `json:"avail"`
+		Overall   string `json:"overall"`
+		Placement string `json:"placement"`
+		Frozen    string `json:"frozen"`
+
+		// Provisioned is a boolean, or a string like "mixed"
+		Provisioned any `json:"provisioned"`
+	}
+
+	// InstanceV2 is an object instance of the v2 daemon status data, also
+	// posted alone by the v2 agents instance status feed.
+	InstanceV2 struct {
+		App     string `json:"app"`
+		Avail   string `json:"avail"`
+		Overall string `json:"overall"`
+
+		// Frozen is the instance freeze unix timestamp, 0 if thawed
+		Frozen float64 `json:"frozen"`
+
+		Monitor struct {
+			Status       string `json:"status"`
+			GlobalExpect string `json:"global_expect"`
+		} `json:"monitor"`
+
+		StatusGroup map[string]string     `json:"status_group"`
+		Encap       map[string]EncapV2    `json:"encap"`
+		Resources   map[string]ResourceV2 `json:"resources"`
+	}
+
+	// EncapV2 is the status of the encapsulated instance of a container
+	// resource in the v2 daemon status data.
+	EncapV2 struct {
+		Hostname string  `json:"hostname"`
+		Avail    string  `json:"avail"`
+		Overall  string  `json:"overall"`
+		Frozen   float64 `json:"frozen"`
+
+		StatusGroup map[string]string     `json:"status_group"`
+		Resources   map[string]ResourceV2 `json:"resources"`
+	}
+
+	// ResourceV2 is an instance resource of the v2 daemon status data.
+	ResourceV2 struct {
+		Status   string   `json:"status"`
+		Label    string   `json:"label"`
+		Type     string   `json:"type"`
+		Disable  bool     `json:"disable"`
+		Optional bool     `json:"optional"`
+		Monitor  bool     `json:"monitor"`
+		Log      []string `json:"log"`
+	}
+)
@@ -0,0 +1,127 @@
+package daemonstatus
+
+import (
+	"time"
+)
+
+type (
+	// DataV3 is the opensvc agent v3 daemon status data.
+	DataV3 struct {
+		Cluster ClusterV3 `json:"cluster" required:"true"`
+	}
+
+	// ClusterV3 is the cluster of the v3 daemon status data.
+	ClusterV3 struct {
+		Config struct {
+			ID   string `json:"id" required:"true"`
+			Name string `json:"name" required:"true"`
+		} `json:"config" required:"true"`
+
+		Node   map[string]NodeV3    `json:"node" required:"true"`
+		Object map[string]*ObjectV3 `json:"object" required:"true"`
+	}
+
+	// NodeV3 is a node of the v3 daemon status data.
+	NodeV3 struct {
+		Status struct {
+			FrozenAt time.Time `json:"frozen_at"`
+		} `json:"status"`
+
+		Daemon struct {
+			Heartbeat struct {
+				Streams []HeartbeatStreamV3 `json:"streams"`
+			} `json:"heartbeat"`
+		} `json:"daemon"`
+
+		Instance map[string]*InstanceV3 `json:"instance"`
+	}
+
+	// HeartbeatStreamV3 is a node heartbeat stream of the v3 daemon status
+	// data.
+	HeartbeatStreamV3 struct {
+		ID    string                     `json:"id"`
+		Type  string                     `json:"type"`
+		State string                     `json:"state"`
+		Peers map[string]HeartbeatPeerV3 `json:"peers"`
+	}
+
+	// HeartbeatPeerV3 is a heartbeat stream peer of the v3 daemon status
+	// data.
+	HeartbeatPeerV3 struct {
+		IsBeating bool      `json:"is_beating"`
+		Desc      string    `json:"desc"`
+		LastAt    time.Time `json:"last_at"`
+	}
+
+	// ObjectV3 is an object of the v3 daemon status data.
+	ObjectV3 struct {
+		Avail          string `json:"avail"`
+		Overall        string `json:"overall"`
+		PlacementState string `json:"placement_state"`
+		Frozen         string `json:"frozen"`
+
+		// Provisioned is "true", "false", "mixed" or "n/a"
+		Provisioned string `json:"provisioned"`
+	}
+
+	// InstanceV3 is an object instance of the v3 daemon status data. The
+	// instance parts are nil if not reported.
+	InstanceV3 struct {
+		Config  *InstanceConfigV3  `json:"config"`
+		Monitor *InstanceMonitorV3 `json:"monitor"`
+		Status  *InstanceStatusV3  `json:"status"`
+	}
+
+	// InstanceConfigV3 is an object instance config of the v3 daemon status
+	// data.
+	InstanceConfigV3 struct {
+		App       string `json:"app"`
+		Resources map[string]struct {
+			IsMonitored bool `json:"is_monitored"`
+		} `json:"resources"`
+	}
+
+	// InstanceMonitorV3 is an object instance monitor of the v3 daemon
+	// status data.
+	InstanceMonitorV3 struct {
+		State        string `json:"state"`
+		GlobalExpect string `json:"global_expect"`
+	}
+
+	// InstanceStatusV3 is an object instance status of the v3 daemon status
+	// data.
+	InstanceStatusV3 struct {
+		Avail    string    `json:"avail"`
+		Overall  string    `json:"overall"`
+		FrozenAt time.Time `json:"frozen_at"`
+
+		StatusGroup map[string]string     `json:"status_group"`
+		Encap       map[string]EncapV3    `json:"encap"`
+		Resources   map[string]ResourceV3 `json:"resources"`
+	}
+
+	// EncapV3 is the status of the encapsulated instance of a container
+	// resource in the v3 daemon status data.
+	EncapV3 struct {
+		Hostname string    `json:"hostname"`
+		Avail    string    `json:"avail"`
+		Overall  string    `json:"overall"`
+		FrozenAt time.Time `json:"frozen_at"`
+
+		StatusGroup map[string]string     `json:"status_group"`
+		Resources   map[string]ResourceV3 `json:"resources"`
+	}
+
+	// ResourceV3 is an instance resource of the v3 daemon status data.
+	ResourceV3 struct {
+		Status   string `json:"status"`
+		Label    string `json:"label"`
+		Type     string `json:"type"`
+		Disable  bool   `json:"disable"`
+		Optional bool   `json:"optional"`
+		Log      []struct {
+			Level   string `json:"level"`
+			Message string `json:"message"`
+		} `json:"log"`
+	}
+)
@@ -0,0 +1,101 @@
+package daemonstatus
+
+import (
+	"time"
+)
+
+type (
+	// DataV4 is the opensvc agent v4 daemon status data. The instances
+	// are nested in their object, indexed by node name.
+	DataV4 struct {
+		Cluster struct {
+			ID   string `json:"id" required:"true"`
+			Name string `json:"name" required:"true"`
+		} `json:"cluster" required:"true"`
+
+		Nodes   map[string]*NodeV4   `json:"nodes" required:"true"`
+		Objects map[string]*ObjectV4 `json:"objects" required:"true"`
+	}
+
+	// NodeV4 is a node of the v4 daemon status data.
+	NodeV4 struct {
+		FrozenAt   time.Time           `json:"frozen_at"`
+		Heartbeats []HeartbeatStreamV4 `json:"heartbeats"`
+	}
+
+	// HeartbeatStreamV4 is a node heartbeat stream of the v4 daemon status
+	// data.
+	HeartbeatStreamV4 struct {
+		ID    string                     `json:"id"`
+		Type  string                     `json:"type"`
+		State string                     `json:"state"`
+		Peers map[string]HeartbeatPeerV4 `json:"peers"`
+	}
+
+	// HeartbeatPeerV4 is a heartbeat stream peer of the v4 daemon status
+	// data.
+	HeartbeatPeerV4 struct {
+		IsBeating bool      `json:"is_beating"`
+		Desc      string    `json:"desc"`
+		LastAt    time.Time `json:"last_at"`
+	}
+
+	// ObjectV4 is an object of the v4 daemon status data, with its
+	// instances indexed by node name.
+	ObjectV4 struct {
+		Avail          string `json:"avail"`
+		Overall        string `json:"overall"`
+		PlacementState string `json:"placement_state"`
+
+		// Frozen is "frozen", "thawed", "mixed" or "n/a"
+		Frozen string `json:"frozen"`
+
+		// Provisioned is "true", "false", "mixed" or "n/a"
+		Provisioned string `json:"provisioned"`
+
+		Instances map[string]*InstanceV4 `json:"instances"`
+	}
+
+	// InstanceV4 is an object instance of the v4 daemon status data.
+	InstanceV4 struct {
+		App      string    `json:"app"`
+		Avail    string    `json:"avail"`
+		Overall  string    `json:"overall"`
+		FrozenAt time.Time `json:"frozen_at"`
+
+		Monitor struct {
+			State        string `json:"state"`
+			GlobalExpect string `json:"global_expect"`
+		} `json:"monitor"`
+
+		StatusGroup map[string]string     `json:"status_group"`
+		Encap       map[string]EncapV4    `json:"encap"`
+		Resources   map[string]ResourceV4 `json:"resources"`
+	}
+
+	// EncapV4 is the status of the encapsulated instance of a container
+	// resource in the v4 daemon status data.
+	EncapV4 struct {
+		Hostname string    `json:"hostname"`
+		Avail    string    `json:"avail"`
+		Overall  string    `json:"overall"`
+		FrozenAt time.Time `json:"frozen_at"`
+
+		StatusGroup map[string]string     `json:"status_group"`
+		Resources   map[string]ResourceV4 `json:"resources"`
+	}
+
+	// ResourceV4 is an instance resource of the v4 daemon status data.
+	ResourceV4 struct {
+		Status      string `json:"status"`
+		Label       string `json:"label"`
+		Type        string `json:"type"`
+		Disable     bool   `json:"disable"`
+		Optional    bool   `json:"optional"`
+		IsMonitored bool   `json:"is_monitored"`
+		Log         []struct {
+			Level   string `json:"level"`
+			Message string `json:"message"`
+		} `json:"log"`
+	}
+)
@@ -1,11 +1,11 @@
 // Package feeder provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.7.1 DO NOT EDIT.
 package feeder
 
 import (
 	"bytes"
-	"compress/gzip"
+	"compress/flate"
 	"encoding/base64"
 	"fmt"
 	"net/http"
@@ -30,6 +30,9 @@ type ServerInterface interface {
 	// (POST /daemon/status)
 	PostDaemonStatus(ctx echo.Context) error
 
+	// (POST /daemon/status/patch)
+	PostDaemonStatusPatch(ctx echo.Context) error
+
 	// (POST /instance/action)
 	PostInstanceAction(ctx echo.Context) error
 
@@ -79,9 +82,9 @@ type ServerInterfaceWrapper struct {
 func (w *ServerInterfaceWrapper) PostChecks(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostChecks(ctx)
@@ -92,9 +95,9 @@ func (w *ServerInterfaceWrapper) PostChecks(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) PostDaemonPing(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostDaemonPing(ctx)
@@ -105,22 +108,35 @@ func (w *ServerInterfaceWrapper) PostDaemonPing(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) PostDaemonStatus(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostDaemonStatus(ctx)
 	return err
 }
 
+// PostDaemonStatusPatch converts echo context to params.
+func (w *ServerInterfaceWrapper) PostDaemonStatusPatch(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostDaemonStatusPatch(ctx)
+	return err
+}
+
 // PostInstanceAction converts echo context to params.
 func (w *ServerInterfaceWrapper) PostInstanceAction(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostInstanceAction(ctx)
@@ -131,9 +147,9 @@ func (w *ServerInterfaceWrapper) PostInstanceAction(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) PutInstanceActionEnd(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PutInstanceActionEnd(ctx)
@@ -144,9 +160,9 @@ func (w *ServerInterfaceWrapper) PutInstanceActionEnd(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) PostInstanceResourceInfo(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostInstanceResourceInfo(ctx)
@@ -157,15 +173,15 @@ func (w *ServerInterfaceWrapper) PostInstanceResourceInfo(ctx echo.Context) erro
 func (w *ServerInterfaceWrapper) PostInstanceStatus(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params PostInstanceStatusParams
 	// ------------- Optional query parameter "sync" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "sync", ctx.QueryParams(), &params.Sync)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "sync", ctx.QueryParams(), &params.Sync, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sync: %s", err))
 	}
@@ -179,9 +195,9 @@ func (w *ServerInterfaceWrapper) PostInstanceStatus(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) GetNodeActionQueued(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetNodeActionQueued(ctx)
@@ -192,9 +208,9 @@ func (w *ServerInterfaceWrapper) GetNodeActionQueued(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) PostNodeActionQueuedDone(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostNodeActionQueuedDone(ctx)
@@ -205,9 +221,9 @@ func (w *ServerInterfaceWrapper) PostNodeActionQueuedDone(ctx echo.Context) erro
 func (w *ServerInterfaceWrapper) PostNodeActionQueuedRunning(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostNodeActionQueuedRunning(ctx)
@@ -218,9 +234,9 @@ func (w *ServerInterfaceWrapper) PostNodeActionQueuedRunning(ctx echo.Context) e
 func (w *ServerInterfaceWrapper) PostNodeDisk(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostNodeDisk(ctx)
@@ -231,9 +247,9 @@ func (w *ServerInterfaceWrapper) PostNodeDisk(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) PostNodeSysReport(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostNodeSysReport(ctx)
@@ -244,9 +260,9 @@ func (w *ServerInterfaceWrapper) PostNodeSysReport(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) PostSystem(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostSystem(ctx)
@@ -257,9 +273,9 @@ func (w *ServerInterfaceWrapper) PostSystem(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) PostObjectConfig(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostObjectConfig(ctx)
@@ -299,101 +315,132 @@ type EchoRouter interface {
 	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 }
 
+// RegisterHandlersOptions configures RegisterHandlersWithOptions.
+type RegisterHandlersOptions struct {
+	// BaseURL is prepended to every registered path so the API can be served
+	// under a prefix.
+	BaseURL string
+	// OperationMiddlewares lets the caller attach per-operation middleware at
+	// registration time. The map key is the OpenAPI `operationId` value as it
+	// appears in the spec (the raw, un-normalized form). Operations that have
+	// no entry are registered with no extra middleware. A nil map disables
+	// per-operation middleware entirely.
+	OperationMiddlewares map[string][]echo.MiddlewareFunc
+}
+
 // RegisterHandlers adds each server route to the EchoRouter.
 func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
+	RegisterHandlersWithOptions(router, si, RegisterHandlersOptions{})
 }
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
+// RegisterHandlersWithBaseURL registers handlers and prepends BaseURL to the
+// paths so the API can be served under a prefix.
 func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+	RegisterHandlersWithOptions(router, si, RegisterHandlersOptions{BaseURL: baseURL})
+}
+
+// RegisterHandlersWithOptions registers handlers using the supplied options,
+// including any per-operation middleware.
+func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options RegisterHandlersOptions) {
 
 	wrapper := ServerInterfaceWrapper{
 		Handler: si,
 	}
 
-	router.POST(baseURL+"/checks", wrapper.PostChecks)
-	router.POST(baseURL+"/daemon/ping", wrapper.PostDaemonPing)
-	router.POST(baseURL+"/daemon/status", wrapper.PostDaemonStatus)
-	router.POST(baseURL+"/instance/action", wrapper.PostInstanceAction)
-	router.PUT(baseURL+"/instance/action", wrapper.PutInstanceActionEnd)
-	router.POST(baseURL+"/instance/resource_info", wrapper.PostInstanceResourceInfo)
-	router.POST(baseURL+"/instance/status", wrapper.PostInstanceStatus)
-	router.GET(baseURL+"/node/actionq", wrapper.GetNodeActionQueued)
-	router.POST(baseURL+"/node/actionq/done", wrapper.PostNodeActionQueuedDone)
-	router.POST(baseURL+"/node/actionq/running", wrapper.PostNodeActionQueuedRunning)
-	router.POST(baseURL+"/node/disk", wrapper.PostNodeDisk)
-	router.POST(baseURL+"/node/sysreport", wrapper.PostNodeSysReport)
-	router.POST(baseURL+"/node/system", wrapper.PostSystem)
-	router.POST(baseURL+"/object/config", wrapper.PostObjectConfig)
-	router.GET(baseURL+"/openapi.json", wrapper.GetSwagger)
-	router.GET(baseURL+"/version", wrapper.GetVersion)
+	router.POST(options.BaseURL+"/checks", wrapper.PostChecks, options.OperationMiddlewares["PostChecks"]...)
+	router.POST(options.BaseURL+"/daemon/ping", wrapper.PostDaemonPing, options.OperationMiddlewares["PostDaemonPing"]...)
+	router.POST(options.BaseURL+"/daemon/status", wrapper.PostDaemonStatus, options.OperationMiddlewares["PostDaemonStatus"]...)
+	router.POST(options.BaseURL+"/daemon/status/patch", wrapper.PostDaemonStatusPatch, options.OperationMiddlewares["PostDaemonStatusPatch"]...)
+	router.POST(options.BaseURL+"/instance/action", wrapper.PostInstanceAction, options.OperationMiddlewares["PostInstanceAction"]...)
+	router.PUT(options.BaseURL+"/instance/action", wrapper.PutInstanceActionEnd, options.OperationMiddlewares["PutInstanceActionEnd"]...)
+	router.POST(options.BaseURL+"/instance/resource_info", wrapper.PostInstanceResourceInfo, options.OperationMiddlewares["PostInstanceResourceInfo"]...)
+	router.POST(options.BaseURL+"/instance/status", wrapper.PostInstanceStatus, options.OperationMiddlewares["PostInstanceStatus"]...)
+	router.GET(options.BaseURL+"/node/actionq", wrapper.GetNodeActionQueued, options.OperationMiddlewares["GetNodeActionQueued"]...)
+	router.POST(options.BaseURL+"/node/actionq/done", wrapper.PostNodeActionQueuedDone, options.OperationMiddlewares["PostNodeActionQueuedDone"]...)
+	router.POST(options.BaseURL+"/node/actionq/running", wrapper.PostNodeActionQueuedRunning, options.OperationMiddlewares["PostNodeActionQueuedRunning"]...)
+	router.POST(options.BaseURL+"/node/disk", wrapper.PostNodeDisk, options.OperationMiddlewares["PostNodeDisk"]...)
+	router.POST(options.BaseURL+"/node/sysreport", wrapper.PostNodeSysReport, options.OperationMiddlewares["PostNodeSysReport"]...)
+	router.POST(options.BaseURL+"/node/system", wrapper.PostSystem, options.OperationMiddlewares["PostSystem"]...)
+	router.POST(options.BaseURL+"/object/config", wrapper.PostObjectConfig, options.OperationMiddlewares["PostObjectConfig"]...)
+	router.GET(options.BaseURL+"/openapi.json", wrapper.GetSwagger, options.OperationMiddlewares["GetSwagger"]...)
+	router.GET(options.BaseURL+"/version", wrapper.GetVersion, options.OperationMiddlewares["GetVersion"]...)
 
 }
 
-// Base64 encoded, gzipped, json marshaled Swagger object
+// Base64 encoded, compressed with deflate, json marshaled OpenAPI spec.
+// Stored as a slice of fixed-width chunks rather than one concatenated
+// const string: with thousands of chunks the chained `+` fold is several
+// times slower for the Go compiler than parsing a slice literal.
 var swaggerSpec = []string{
-
-	"H4sIAAAAAAAC/+xbWXMbuRH+K6hJHjZVtChLzkP05jPlJGV7RW/yYKtYINAcYj0DjHHQ5rr431O45sRw",
-	"KFn0bmI/WRxg+vi60ehj/CUjoqwEB65VdvUlq7DEJWiQ7hfjPxuQu8WOE/8zu8o+2ifZLOO4hOwqU3Zt",
-	"limygRLbTXpX2ecrIQrAPNvv97NMgqoEV+CIPjo/t/8QwTVwbf/EVVUwgjUTfP6rEtw+awj+WcI6u8r+",
-	"NG8knftVNX8jxaqA0nOhoIhklSWTXWVPMEXX8NGA0tl+lj06f/gtuP7CsdEbIdlvQD3by2/B9oWQK0Yp",
-	"cMvzr98G4Jdcg+S4QAuQW5DouZRCWv7PMJSCv2E8f0wIVBrorcSppKhAaua9hQsKy09Mb5aY2BeWHw0Y",
-	"T7ErTsGURmKNSGGUBonsiwrZN1EFnDKeI/8q8oRUNsuYhlINSbVJODefRa9WWjKeWyXDAywl3tnfYvUr",
-	"EO0kFUYvieBrlo9L6bcjS10hvcEaSfhomASF3rxevEVzXLG53zQPtEbFbdGalrR54F9LWZY6AyKlsTYK",
-	"aVaC0risLJpFgVaAJKwlqA1QtBYepsbuC/fWD8v/H1j+kLn3MeY7sR4TT6Av4NsNoBXkjCMhEfBoAacy",
-	"KP2eZ7Oe1XFNaaAOlvl2yEJvABFRlphTVDAOCMvclO4+a+E2aUUn5ZC6Fz5IXZ8ExDi6fvH08vLyb68w",
-	"FxaWEuuUCYjsKFPfirMMeMKVWxDdgZlV39/bUe1Dod3b7F+MQ9KrJQuADNhUWG/SCyyhUlDHriVEloyq",
-	"tE1rT1Gi2AJFbmeCggKlbHQwxnMfbnDOPCpYWJ6NvbgsROI0+18rQH33SxGKoiX520UfBzZ4a08LcISV",
-	"YjkHiqixNFALD++nCSZbkCp5Bu3LogKutgSRggHXiGKNUXxhQMslbC4k0ezqnTf3LB7McAy9sYP9am9p",
-	"pAieH3TvWSmeNn8Iagt1EI/efDOIWrOs5bmDW+NW5ziFY9KJWwZwMaZiFLE1KgFzxvO1KUZ8e8IhJ1xu",
-	"uGxWCnRiqWeyCK/02Pu3osk6IAdZxlEOKXT7Pu8CPnLuehK5XSkuz5j6MCRK0/qPAFoKCkVyJdzNoxFL",
-	"Qj522yj2m3OvEG6vMsb15UVjZ8Y15OASXqOgLVhrZQucCjkNjzNMW9jAP9CuCUVdZxahFJwvudKYE7gG",
-	"JYwk8JKvxRBeFp7W90R3+QPs1OHlJGJbXBgLWV85uz+upmTuXz3yGIfyru0kPYbmqAdoUYlC5LtpjsEu",
-	"DrtD0C/q493zaazbBXLz4qhspw7pTqKGTUqpV4KCPaIH9Dkq2XDH/IiUdJa9dn89rbPoXnpYVUmo7BUc",
-	"aozBGpXV0iWthxa7Dj+ZLgLfJvetC/i8LPHndDjwq520qr+qscw7Ib61oRScaSGBLmU43ksiDB/ZLSTZ",
-	"gNISa7hdGifxp1YVU8e/1U4nsxtFRAW3Q++Why7lmG+E0k83QFKeucVF15zxj5Qovd9bLG/lCj153esz",
-	"L8GY2E1/JF30quNK3OOqWHS7MvYI3u0C9p5K0xOGOg9oo97hcNeYZyyKkw3meS9aJDUXErFwIxwDwPjt",
-	"IGHLhFFLU1GsgS6x7hxL+/CBzWiTlccd3jmZKcJ1ExGcsERoPA4MoOGzHsr2GG1MifkDCZjiVQEIPlcF",
-	"5tiXeBUQtmYEaYH0hikkCDFSAidgHVtv4D2vPL+z99N6OAlSMv/sukxNL6TnOb5KTN9C4DtUtzIUG0k6",
-	"6+ZVitMd+ByqV7ZkOVblbMmIEKnctxGrC0bNoiHYUnBWg3qwkmnb5ZnwVeNofRdahRKUKTSqpCCgFFC0",
-	"2rky3PJOtK3u1YByLAFQmoKUI5UjFUYfiXYXYemKw0CgZjIF5LXhPHmFJTs68RLpNGKRbx6MxFE82Jws",
-	"vg5ex5bBlCKJII+bhaOy287Bn5IpEk/Jtdipa6iE1Il0GwoIBfjxidaaFd0idsU4duO7iVAdmAUKKVEr",
-	"TD7gPNGBwZKk80p3HRbFLQ/JaCRTLD+AweFyaiJn8LGludecSoGyZ5xCRO2UTt1YLaSO8qa4/6hyqaVU",
-	"LwluFuAzLivrCNn52fnZw0nrj9/Lrt9KjGR6t7DShp4bVow8Nr6ccFq4Xrd92vDaaF35RjuWIONu/+tF",
-	"9IR//OdtHCY7Em61T2O/n9UdDM20UyzmJWsAChLhirUMeJVdnp2fPXJZbgXcLvpH55kvg5wWc9KUE0Il",
-	"cow3Rm2Q3+RuAIu2SzBeUrvaFCQeTVD6iaC7+xvFNgz2XYtpaaA/Zb/wQ+CuBq//2TFhdvWuY7x3N/vZ",
-	"l46B3t3srRPgXFm/CADdWBpzPyqaV/EOSEJ27SdHde0wNlkcwbNVKZ0O0xaTo3C9GCNa75snJuD7WXZx",
-	"/miIUMmUYjzvIRPnbLMwEwxgh1WmUC3k19gT5xbAjjmbXO8OBj1oxUVs8p/WjoHN/VqyN9N233WcT79s",
-	"NzWfnkztfdj6XmRq72XrO4/De+2m+/KRWM/OmyFt2kue+GlLPXCx7oxRzrbAY/vAht0Rf4mN1Mdx4nQK",
-	"j4nJ2tF+co9c+zOVxCC+Pe1D+Ht2vFlWmYSLPef0bg5mev713FWPfwgXS7qA/yTgR+TxkadufMcEMB2A",
-	"fnF9LyQkIhLsX5EAigSQJTARgDpDtNP4SJLVXT0mraRNra0jDb7muX/rTGUPCy1k00O8OHvY2CWkNhRr",
-	"fDZhljqTaH+s+i6Nc7Nl3v6Y1WpySnPeJgs5P2DIgIpyUx+kDCGg1NoUxQ79pHacbKTgwqi/+PTyYppS",
-	"8ylXjCnoJ9yn9F2GGJtuh8Tmo+UcpnBdOHPQrY9xVOxQ2evHPTZ6A1xb3wHatAq7rvx30K8EDbeP7xxl",
-	"aae4F5fstroSqYYtCn9nvOc0dGXTYUOB7iMugQDbJtqyw7DRB9t1gE9z/AeN5rvf/c2F//taRrbavEnj",
-	"RPQRJh+4+FQAzUF5+1QaYU79xSMNd4Zai6IQn2zF2/SAUcGUPjvSfLHvfHoLRk7/u0ak8eOq45IkZ0b7",
-	"jkJ+/m+8LQ5Yxn3XcRpTNJ+e3NUAY/qcMBVysKudkk0XP91LFEp7vOvNB1BuxgKHoC5NoVmFpZ7bpO9B",
-	"nCgfh3bD4q5o14r8KFeiF8RxwLgL+E3WSYnYgtyNOMHC0zrNQQuCfoXdnQpY44n/IPJd+kL3v3IcH4xD",
-	"G+OYQNz5ZO40PtJh8dX16beKxmHacxZ1DTn9IB9ffMJ57gZNX5WGT/73Gp9q/yFcO8JVmVXBSMCrNTNM",
-	"lj8StJEc4Yq1PvoZoPnveulkRU3kfj/lTD3fwhVesYK5EerN3nuh3Mb+gpFFdpWdzbP9zf6/AQAA//9A",
-	"q0GlODsAAA==",
+	"7Fxfc9s2Ev8qGN499GZYS7HdzsRvaZrcpO01rp3ePcQeDQSsJDQkwACgYrWj736Df/wLSpRj+dqcnywR",
+	"4O5i97eLxWLlPxIi8kJw4FolF38kBZY4Bw3SfmP8lxLk5nrDifuaXCQfzZMkTTjOIblIlBlLE0VWkGMz",
+	"SW8K83wuRAaYJ9vtNk0kqEJwBZbo+XRq/hDBNXBtPuKiyBjBmgk++U0Jbp7VBP8uYZFcJH+b1JJO3Kia",
+	"XEoxzyB3XCgoIllhyCQXyXeYoiv4WILSyTZNzqfPHoPrrxyXeiUk+x2oY3v2GGxfCzlnlAJ3PJ8/Bs+X",
+	"gi8yRpx2nz3KMr090Suumd6gd0Kgn7BcghPhm8cxsCqLQkgNFP0LKMPonQH8Nk2+eRxcv+EaJMcZuga5",
+	"BoleSSmk4f89hlzwS8aXLwiBQgM9SJxCigKkZs5JuaAw+8T0aoaJeWH2sYTSUWyLkzGlkVggkpVKg0Tm",
+	"RYXMm6gAThlfIvcqcoRUkiZMQ676pJokbHRJQzBRWjK+TLbVAywl3pjvYv4bEG0lFaWeEcEXbDkspZuO",
+	"DHWF9AprJOFjySQodPn2+h2a4IJN3KSJpzUoboPWfknrB+61mGWpNSBSGutSIc1yUBrnhdFmlqE5IAkL",
+	"CWoFFC2EU1Nt92v71pPlvwDL7zL3Nmy1VqwXxBHoCvhuBWgOS8aRkAh4sIBdMih9w5O0Y3VcUeotB8vl",
+	"us9CrwARkeeYU5QxDgjLZZnbNKKht71WtFL2qTvhvdSVJyDG0dXrl2dnZ89/xlwYteRYx0xApOCxZCRN",
+	"gEeg3FDRPZiZ5bt0KSx7V2h3NvuJcYiiWjKvkB6bAutVfIBFluSXY8YiIktGVdymFVKUyNZAkZ0ZoaBA",
+	"KRMdytJx70+wYB4UzA+nQy/OMhHxZvdtDqgLvxihIFqUvxl0cWCF18ZbgCOsFFtyoIiWhgZq6MPhNMJk",
+	"DVJFfdC8LArgak0QyRhwjSjWGIUXerRsnmxDEk0u3jtzp8ExvRs6Y3v7VWippfDI92vvWCl4m3OCykIt",
+	"jQc03/aiVpo0kNvbNQ7y45geoyBuGMDGmIJRxBYoB8wZXy7KbADbewC5B3L94XKuQEeGOiYL6pVO9+6t",
+	"YLKWkt2XHVr2mW5zP28rfMDvOhLZWTEu3zP1oU+Uxtc/oNBcUMiiI35vHoxYEpZDu41iv1t4+XB7kTCu",
+	"z05rOzOuYQk24S0VNAVrjKyBUyH3q8capims5+9pV4TCWlOjoZg633ClMSdwBUqUksAbvhB99TL/tNon",
+	"2sMfYKN2D0c1tsZZaVTWXZyZH0ZjMne3HjkGUA7aVtIxNAcRoEUhMrHc7Ofo7WJ1t0v115V7dzCNdbMu",
+	"Ub84KNuxQ7qVqGYTW9QP129/vsSarN4WIHFIzdoLW0iRx2U0KT4qhHEIaXJf8ywXa0BmtySi2CCHUpQJ",
+	"dyiIhVFRGOLAy9yIjqnddsCQsR+KDBOwjmEfGKqGCiid3EaoBWWPE1abQ73eKV5AfWzHoDRFXkK7ZCMV",
+	"EkGTyL3aNY4oEi9mzCA/CwomZu4A2Kjsz9AYc0ZIk7f208vqWNNmiosiil2TE/lDX2+MymJmTxG7BtsR",
+	"aG/+DnwdnbfI4G6W47t4fHajjO8YdRCIT8gFZ1pIoDPp4+2MiJIPzBaSrEBpiTUclldL/KlxrKw2pPlG",
+	"R9NNRUQBh2nvwCgYA+alUPrlCkgMmWuctc0ZPsRE6XxfY3kQFDry2tdTJ8GQ2HXBKl6FUONqDuPKCuiw",
+	"usII3s2KwgPVCo649ziF1svbvf/U5hnaVskK82UnWkRXLiRifoseo4Dh7VrCmolSzcqCYg10hnXLLc3D",
+	"r80RI35a/RhXqS+5KJNucwKIl/kcZGp3oTlWMFPwMexKHO70DXfVIlexmbjXJ4XZqm1VpZm2fnseT1vv",
+	"If/RYOFzkWDNw1BhM5TIWdDrbUDjbVUH5XpDmI9Wm8jWLEEhLcaptQHIfThEfq4p8WBKmTudCstccMuz",
+	"JKbuNt/c8Eoia98HxXoR9NfX0tXrl+jb59PTOm0xUlmlbIKoXmXehKPSj0heGZPrOK4WN7xVAtC6IPRX",
+	"c6EK7m7twax7XMlf8PScR8Od7sv4Aq3KHPOvJWCK5xkguCsyzF0+qwogbMGIwwVTSBBSSml17ZR8wwvH",
+	"7+Rm/3qsBDGZf7HV/Lrm3NkQXDUunlyCuwk4yGBs4HBfXRLEON2Dz6660JrMhqpJazIgRKzGUIvVVkbF",
+	"oibYWGBaKXVnxahpl+8Fh511NMcbSVBlplEhBQGlbKhze5ygELkeeFADyqG8XmkKUsbVrako9UhttzUs",
+	"bRHOE6iY7FPkVcl5NDNldEdu2LrwQq5IO7Bl4N7kaKjbmWUbBvsWEsndcD0watdoktsrUyAek+t6o66g",
+	"EFJHTtGQgS90jj8/LVjWLhbOGce2O2VP1uOZeQoxUQtMPuBlpNKNJYkfF+3On2UHOslgJFNsuUMHu8tW",
+	"u1fvY0u9v9klecqOcVwj0Wzv85bdj1Mu+zPjJp1yuUKMgslRDlpv9UJscWqjdGw7bsBglKuE+UPZ3gEX",
+	"hXb+uFpRw/SdCkA9AHc4L4y7JNOT6cmzvT4ynL3YJI+UkunNtZG1yvoZeVG6Wopdg3nHPq15rbQu3LUv",
+	"liDDbPftdQDOD/95FzrKLAk72qWx3aZVPV0zbRcWsrgFAAWJcMEaML9Izk6mJ+eutAncDLpHU1/3s6uY",
+	"kLqWIlQkE7ss1Qq5SXafrPLzNzS5aFZjnDZB6e8E3TxcY1DNYNu2mJYldFvtTqfT/gre/tjo2IrxqkhM",
+	"zKS6tWrf3G9a0Egu3rdA8f52m/7RMvz7260BF14qgzev+FtDIxyvi7ADR01x5fojqoLMUP/MgJ0a5afj",
+	"2arBZJS9TvcrOtLntU2T0+l5X0M5U8pcZLc1E7pJUhSpZSCmUCXknxEneGkM04JJncHfAygnyHTL2BMf",
+	"U+ZugNmzJcJLbLY2d3xyJg1HVn9K9JHFFYsWDDKqEBcamZqxkLlRPJZww0PTQpVkn0+nqHUgGwLndTgT",
+	"Hxeens3DArTTkGb7Uqcj4DGdNtp198191uix3Tf37Hhwrpo/d881k44C/Umdm90jUtqWPVyXnNyNnE3D",
+	"POCNd9xw/yXDSiPs7RrzJfeqjSMmYQDqOJxPnyO2uOF9GvEiLKJssQCpkLnorIqxqXNIqwqUl85BeSyS",
+	"NYQB2nfwG56xD66RKBYGzfwxznnpiz2P4aGO1/+Fm06fj5n7/Ity6VA6ntTNmHF3/s51VVWNVWZDx2jJ",
+	"1sDDrZRJaAfQGxomXoTOsmNA1xMfj9UH5NrtnYo03Da7+qo49LRHPSCg06QoI9B9ZTpt7wPcsoPbV5z+",
+	"WaAbhZZrKX4C1nEjZdX/EUoB8YD5q70vMvUkIsF8CgRQIIAMgT0Bs9XcdxzsRVndF4nxRZoiiwFo71cG",
+	"f/rTXmX1fee9ay1kfad3evKstveI7K7TUJi2fhP5Pr7gesqk+ZvJ7a0z3LFgcsjBbboDICEBt01VSJWE",
+	"gFKLMss26Cu14WQlBRel+ocrNJzup1T/dKVK8r/CXUpPIfEBQyIXNCSO9v7dN8+1zWSaKuu7QBVuoMw2",
+	"bB+X5iylDSaB1leBbRf5J2jTDOl2SXczlMTB9iBQb19lRVI5V878n+p7Qv2tazwcKdBdjUsgwNaRa9d+",
+	"OOoq297wHies9C6S758DNRKfL9HDJrJxPRw1erAqwuQDF58yoKbhyNq90LYr2W7AsuSufCiyTHyyJcPq",
+	"7hiZW+WTkbAI99XHR0bg9ASOLjho+FHNuCTUwsO8o5BrMy6djXdY3LaPH8fEgby6t2GH1vMXTDWtOdVG",
+	"ybpbIX4bKJR2dqwm77Be3f6wy4R5mWlWYKknJln/OjTEjrNizeK+VqwW8nR8PWqwaHQcDEPLTTJORcQa",
+	"5GYAXNeO1nECgxf0M/Bkl2Dq77v/kcETxh4QY+1/ZTB+U3LvjdqQWr9QOg72Wiw+uw7yV9+VfN/KSdCh",
+	"P+P1zmfXn/ByCfJzj2V7/23F2x+P6YoHuUxQV1HOM0a8vhrdT9HjsARdSm76hBrN3j1t/rsaOtohN3B/",
+	"mONt0AXBBZ6zjNlmsNutQ6FchzpWKbPkIjmZJNvb7X8HAA==",
 }
 
-// GetSwagger returns the content of the embedded swagger specification file
-// or error if failed to decode
+// decodeSpec returns the embedded OpenAPI spec as raw JSON bytes,
+// after base64-decoding and flate-decompressing the embedded blob.
 func decodeSpec() ([]byte, error) {
-	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	encoded := strings.Join(swaggerSpec, "")
+	compressed, err := base64.StdEncoding.DecodeString(encoded)
 	if err != nil {
 		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
 	}
-	zr, err := gzip.NewReader(bytes.NewReader(zipped))
-	if err != nil {
-		return nil, fmt.Errorf("error decompressing spec: %w", err)
-	}
+	zr := flate.NewReader(bytes.NewReader(compressed))
 	var buf bytes.Buffer
-	_, err = buf.ReadFrom(zr)
-	if err != nil {
-		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	if _, err := buf.ReadFrom(zr); err != nil {
+		return nil, fmt.Errorf("read flate: %w", err)
+	}
+	if err := zr.Close(); err != nil {
+		return nil, fmt.Errorf("close flate reader: %w", err)
 	}
 
 	return buf.Bytes(), nil
@@ -401,7 +448,7 @@ func decodeSpec() ([]byte, error) {
 
 var rawSpec = decodeSpecCached()
 
-// a naive cached of a decoded swagger spec
+// a naive cache of the decoded OpenAPI spec
 func decodeSpecCached() func() ([]byte, error) {
 	data, err := decodeSpec()
 	return func() ([]byte, error) {
@@ -419,12 +466,12 @@ func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
 	return res
 }
 
-// GetSwagger returns the Swagger specification corresponding to the generated code
-// in this file. The external references of Swagger specification are resolved.
-// The logic of resolving external references is tightly connected to "import-mapping" feature.
-// Externally referenced files must be embedded in the corresponding golang packages.
-// Urls can be supported but this task was out of the scope.
-func GetSwagger() (swagger *openapi3.T, err error) {
+// GetSpec returns the OpenAPI specification corresponding to the generated
+// code in this file. External references in the spec are resolved through
+// PathToRawSpec; externally-referenced files must be embedded in their
+// corresponding Go packages (via the import-mapping feature). URL-based
+// external refs are not supported.
+func GetSpec() (swagger *openapi3.T, err error) {
 	resolvePath := PathToRawSpec("")
 
 	loader := openapi3.NewLoader()
@@ -450,3 +497,22 @@ func GetSwagger() (swagger *openapi3.T, err error) {
 	}
 	return
 }
+
+// GetSpecJSON returns the raw JSON bytes of the embedded OpenAPI
+// specification: decompressed but not unmarshaled. External references
+// are not resolved here; the bytes are the spec exactly as embedded by
+// codegen. The result is cached at package init time, so repeated calls
+// are cheap.
+func GetSpecJSON() ([]byte, error) {
+	return rawSpec()
+}
+
+// GetSwagger returns the OpenAPI specification corresponding to the
+// generated code in this file.
+//
+// Deprecated: GetSwagger predates kin-openapi renaming openapi3.Swagger
+// to openapi3.T. Use [GetSpec] instead. This wrapper is retained for
+// backwards compatibility.
+func GetSwagger() (*openapi3.T, error) {
+	return GetSpec()
+}
@@ -1,6 +1,6 @@
 // Package feeder provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.7.1 DO NOT EDIT.
 package feeder
 
 import (
@@ -10,10 +10,40 @@ import (
 )
 
 const (
-	BasicAuthScopes  = "basicAuth.Scopes"
-	BearerAuthScopes = "bearerAuth.Scopes"
+	BasicAuthScopes  basicAuthContextKey  = "basicAuth.Scopes"
+	BearerAuthScopes bearerAuthContextKey = "bearerAuth.Scopes"
 )
 
+// Defines values for JSONPatchOperationOp.
+const (
+	Add     JSONPatchOperationOp = "add"
+	Copy    JSONPatchOperationOp = "copy"
+	Move    JSONPatchOperationOp = "move"
+	Remove  JSONPatchOperationOp = "remove"
+	Replace JSONPatchOperationOp = "replace"
+	Test    JSONPatchOperationOp = "test"
+)
+
+// Valid indicates whether the value is a known member of the JSONPatchOperationOp enum.
+func (e JSONPatchOperationOp) Valid() bool {
+	switch e {
+	case Add:
+		return true
+	case Copy:
+		return true
+	case Move:
+		return true
+	case Remove:
+		return true
+	case Replace:
+		return true
+	case Test:
+		return true
+	default:
+		return false
+	}
+}
+
 // Action The begin or end action request
 type Action struct {
 	Action string `json:"action"`
@@ -103,6 +133,22 @@ type InstanceStatus struct {
 	Version string `json:"version"`
 }
 
+// JSONPatchOperation defines model for JSONPatchOperation.
+type JSONPatchOperation struct {
+	// From the json pointer of the move and copy source location
+	From *string              `json:"from,omitempty"`
+	Op   JSONPatchOperationOp `json:"op"`
+
+	// Path the json pointer of the target location
+	Path string `json:"path"`
+
+	// Value the add, replace and test operation value
+	Value interface{} `json:"value,omitempty"`
+}
+
+// JSONPatchOperationOp defines model for JSONPatchOperation.Op.
+type JSONPatchOperationOp string
+
 // NodeDisks defines model for NodeDisks.
 type NodeDisks struct {
 	Data *[]Disk `json:"data,omitempty"`
@@ -149,7 +195,32 @@ type PostDaemonStatus struct {
 	Changes           []string               `json:"changes"`
 	Data              map[string]interface{} `json:"data"`
 	PreviousUpdatedAt *time.Time             `json:"previous_updated_at,omitempty"`
-	UpdatedAt         *time.Time             `json:"updated_at,omitempty"`
+
+	// Seq the status sequence number, the base_seq of the next
+	// POST /daemon/status/patch
+	Seq       *int64     `json:"seq,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+
+	// Version the opensvc client data version
+	Version string `json:"version"`
+}
+
+// PostDaemonStatusPatch defines model for PostDaemonStatusPatch.
+type PostDaemonStatusPatch struct {
+	// BaseSeq the sequence number of the status the patch applies to
+	BaseSeq int64 `json:"base_seq"`
+
+	// Changes object or instance changes in addition to the ones touched by
+	// the patch
+	Changes *[]string `json:"changes,omitempty"`
+
+	// Patch the RFC 6902 operations to apply to the status data
+	Patch             []JSONPatchOperation `json:"patch"`
+	PreviousUpdatedAt *time.Time           `json:"previous_updated_at,omitempty"`
+
+	// Seq the sequence number of the patched status
+	Seq       int64      `json:"seq"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 
 	// Version the opensvc client data version
 	Version string `json:"version"`
@@ -210,9 +281,19 @@ type Package struct {
 	Version     string     `json:"version"`
 }
 
+// Patch defines model for patch.
+type Patch struct {
+	InstalledAt *time.Time `json:"installed_at,omitempty"`
+
+	// Name the patch name or number
+	Name     string `json:"name"`
+	Revision string `json:"revision"`
+}
+
 // System defines model for system.
 type System struct {
 	Package *[]Package `json:"package,omitempty"`
+	Patches *[]Patch   `json:"patches,omitempty"`
 }
 
 // Version defines model for version.
@@ -232,6 +313,15 @@ type N401 = Problem
 // N403 defines model for 403.
 type N403 = Problem
 
+// N409 defines model for 409.
+type N409 = Problem
+
+// N413 defines model for 413.
+type N413 = Problem
+
+// N415 defines model for 415.
+type N415 = Problem
+
 // N500 defines model for 500.
 type N500 = Problem
 
@@ -253,6 +343,12 @@ type DaemonStatusAccepted struct {
 	ObjectWithoutConfig *[]string `json:"object_without_config,omitempty"`
 }
 
+// basicAuthContextKey is the context key for basicAuth security scheme
+type basicAuthContextKey string
+
+// bearerAuthContextKey is the context key for bearerAuth security scheme
+type bearerAuthContextKey string
+
 // PostInstanceStatusParams defines parameters for PostInstanceStatus.
 type PostInstanceStatusParams struct {
 	Sync *InQuerySync `form:"sync,omitempty" json:"sync,omitempty"`
@@ -267,6 +363,9 @@ type PostDaemonPingJSONRequestBody = PostDaemonPing
 // PostDaemonStatusJSONRequestBody defines body for PostDaemonStatus for application/json ContentType.
 type PostDaemonStatusJSONRequestBody = PostDaemonStatus
 
+// PostDaemonStatusPatchJSONRequestBody defines body for PostDaemonStatusPatch for application/json ContentType.
+type PostDaemonStatusPatchJSONRequestBody = PostDaemonStatusPatch
+
 // PostInstanceActionJSONRequestBody defines body for PostInstanceAction for application/json ContentType.
 type PostInstanceActionJSONRequestBody = Action
 
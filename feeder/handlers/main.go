@@ -10,6 +10,7 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/daemonstatus"
 	"github.com/opensvc/oc3/feeder"
 	"github.com/opensvc/oc3/util/echolog"
 )
@@ -23,6 +24,9 @@ type (
 
 		// SyncTimeout is the timeout for synchronous api calls
 		SyncTimeout time.Duration
+
+		// Formats are the daemon status formats processed by the worker
+		Formats daemonstatus.Formats
 	}
 )
 
@@ -0,0 +1,46 @@
+package feederhandlers
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// bodySizeBuckets are 1KiB to 256MiB payload size buckets
+	bodySizeBuckets = prometheus.ExponentialBuckets(1024, 4, 10)
+
+	// Size of the Content-Encoding encoded request bodies, as received
+	requestBodyCompressedBytes = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "oc3",
+			Subsystem: "feeder",
+			Name:      "request_body_compressed_bytes",
+			Help:      "Size of the gzip or zstd encoded request bodies in bytes (endpoint={/daemon/status|...}, encoding={gzip|zstd})",
+			Buckets:   bodySizeBuckets,
+		},
+		[]string{"endpoint", "encoding"},
+	)
+
+	// Size of the request bodies after decoding
+	requestBodyUncompressedBytes = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "oc3",
+			Subsystem: "feeder",
+			Name:      "request_body_uncompressed_bytes",
+			Help:      "Size of the decoded request bodies in bytes (endpoint={/daemon/status|...})",
+			Buckets:   bodySizeBuckets,
+		},
+		[]string{"endpoint"},
+	)
+
+	// Daemon status data fields not conforming to the payload schema
+	daemonStatusDecodeFailures = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "oc3",
+			Subsystem: "feeder",
+			Name:      "daemon_status_decode_failures_total",
+			Help:      "Counter of the daemon status data fields not conforming to the payload schema (schema={v2|v3|v4|v2-instance}, field={cluster.node.*.status.frozen_at|...})",
+		},
+		[]string{"schema", "field"},
+	)
+)
@@ -0,0 +1,147 @@
+package feederhandlers
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/util/echolog"
+	"github.com/opensvc/oc3/util/logkey"
+)
+
+type (
+	// BodyLimits are the maximum decoded request body sizes in bytes. A
+	// zero size means no limit.
+	BodyLimits struct {
+		// MaxSize applies to the endpoints without an entry in MaxSizes
+		MaxSize int64
+
+		// MaxSizes is indexed by operation id, like PostDaemonStatus. The
+		// ids are matched case-insensitively, like the configuration keys.
+		MaxSizes map[string]int64
+	}
+
+	// countReader counts the bytes read from r.
+	countReader struct {
+		r io.Reader
+		n int64
+	}
+)
+
+func (t *countReader) Read(p []byte) (int, error) {
+	n, err := t.r.Read(p)
+	t.n += int64(n)
+	return n, err
+}
+
+// BodyMiddlewares returns the middlewares of the operations with a request
+// body, indexed by operation id, for RegisterHandlersOptions.
+func BodyMiddlewares(limits BodyLimits) map[string][]echo.MiddlewareFunc {
+	m := make(map[string][]echo.MiddlewareFunc)
+	if SCHEMA.Paths == nil {
+		return m
+	}
+	maxSizes := make(map[string]int64, len(limits.MaxSizes))
+	for operationID, v := range limits.MaxSizes {
+		maxSizes[strings.ToLower(operationID)] = v
+	}
+	for path, item := range SCHEMA.Paths.Map() {
+		for _, op := range item.Operations() {
+			if op.RequestBody == nil || op.OperationID == "" {
+				continue
+			}
+			maxSize := limits.MaxSize
+			if v, ok := maxSizes[strings.ToLower(op.OperationID)]; ok {
+				maxSize = v
+				delete(maxSizes, strings.ToLower(op.OperationID))
+			}
+			m[op.OperationID] = append(m[op.OperationID], BodyMiddleware(path, maxSize))
+		}
+	}
+	for operationID := range maxSizes {
+		slog.Warn(fmt.Sprintf("body max size of %s ignored: not an operation id with a request body", operationID))
+	}
+	return m
+}
+
+// BodyMiddleware returns a middleware reading the request body of the
+// endpoint, decoding the gzip or zstd Content-Encoding. It responds 413 if
+// the decoded body exceeds maxSize, and 415 on unsupported encodings. The
+// handlers read the decoded body from the request as usual.
+func BodyMiddleware(endpoint string, maxSize int64) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			r := c.Request()
+			if r.Body == nil || r.Body == http.NoBody {
+				return next(c)
+			}
+			log := echolog.GetLogHandler(c, "BodyMiddleware")
+			body := r.Body
+			defer func() {
+				if err := body.Close(); err != nil {
+					log.Warn("request body Close", logkey.Error, err)
+				}
+			}()
+
+			encoding := strings.ToLower(strings.TrimSpace(r.Header.Get(echo.HeaderContentEncoding)))
+			raw := &countReader{r: body}
+			var decoded io.Reader
+			switch encoding {
+			case "", "identity":
+				if maxSize > 0 && r.ContentLength > maxSize {
+					return JSONProblemf(c, http.StatusRequestEntityTooLarge, "request body size %d exceeds the %d bytes limit", r.ContentLength, maxSize)
+				}
+				encoding = ""
+				decoded = raw
+			case "gzip", "x-gzip":
+				zr, err := gzip.NewReader(raw)
+				if err != nil {
+					return JSONProblemf(c, http.StatusBadRequest, "gzip: %s", err)
+				}
+				defer func() { _ = zr.Close() }()
+				encoding = "gzip"
+				decoded = zr
+			case "zstd":
+				zr, err := zstd.NewReader(raw, zstd.WithDecoderConcurrency(1))
+				if err != nil {
+					return JSONProblemf(c, http.StatusBadRequest, "zstd: %s", err)
+				}
+				defer zr.Close()
+				decoded = zr
+			default:
+				return JSONProblemf(c, http.StatusUnsupportedMediaType, "unsupported content encoding %s: expect gzip or zstd", encoding)
+			}
+
+			if maxSize > 0 {
+				// read one more byte to detect the bodies exceeding the limit
+				decoded = io.LimitReader(decoded, maxSize+1)
+			}
+			b, err := io.ReadAll(decoded)
+			if err != nil {
+				log.Debug("request body read", logkey.Error, err)
+				return JSONProblemf(c, http.StatusBadRequest, "read request body: %s", err)
+			}
+			if maxSize > 0 && int64(len(b)) > maxSize {
+				log.Warn("request body too large", "endpoint", endpoint, "max_size", maxSize)
+				return JSONProblemf(c, http.StatusRequestEntityTooLarge, "decoded request body exceeds the %d bytes limit", maxSize)
+			}
+
+			if encoding != "" {
+				requestBodyCompressedBytes.WithLabelValues(endpoint, encoding).Observe(float64(raw.n))
+			}
+			requestBodyUncompressedBytes.WithLabelValues(endpoint).Observe(float64(len(b)))
+
+			r.Body = io.NopCloser(bytes.NewReader(b))
+			r.ContentLength = int64(len(b))
+			r.Header.Del(echo.HeaderContentEncoding)
+			return next(c)
+		}
+	}
+}
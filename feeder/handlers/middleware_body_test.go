@@ -0,0 +1,57 @@
+package feederhandlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+)
+
+func TestBodyMiddlewaresMaxSizes(t *testing.T) {
+	// the max sizes are read from a configuration whose keys are lowercased
+	// by viper
+	v := viper.New()
+	v.SetConfigType("yaml")
+	if err := v.ReadConfig(strings.NewReader("body:\n  max_size: 10b\n  max_sizes:\n    PostDaemonStatus: 100b\n")); err != nil {
+		t.Fatalf("ReadConfig: %s", err)
+	}
+	limits := BodyLimits{
+		MaxSize:  int64(v.GetSizeInBytes("body.max_size")),
+		MaxSizes: make(map[string]int64),
+	}
+	for operationID := range v.GetStringMap("body.max_sizes") {
+		limits.MaxSizes[operationID] = int64(v.GetSizeInBytes("body.max_sizes." + operationID))
+	}
+	m := BodyMiddlewares(limits)
+
+	cases := []struct {
+		operationID string
+		wantStatus  int
+	}{
+		{operationID: "PostDaemonStatus", wantStatus: http.StatusOK},
+		{operationID: "PostDaemonPing", wantStatus: http.StatusRequestEntityTooLarge},
+	}
+	for _, tc := range cases {
+		t.Run(tc.operationID, func(t *testing.T) {
+			l := m[tc.operationID]
+			if len(l) != 1 {
+				t.Fatalf("%s middlewares = %d, want 1", tc.operationID, len(l))
+			}
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(strings.Repeat("x", 50)))
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			h := l[0](func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			})
+			if err := h(c); err != nil {
+				t.Fatalf("middleware: %s", err)
+			}
+			if rec.Code != tc.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tc.wantStatus)
+			}
+		})
+	}
+}
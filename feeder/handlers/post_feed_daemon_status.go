@@ -1,9 +1,14 @@
 package feederhandlers
 
 import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"strings"
 
@@ -11,24 +16,28 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/opensvc/oc3/cachekeys"
+	"github.com/opensvc/oc3/daemonstatus"
 	"github.com/opensvc/oc3/feeder"
 	"github.com/opensvc/oc3/util/logkey"
 )
 
+var (
+	// swapDaemonStatusScript sets the node daemon status only if still the
+	// patched one, compared by sha1
+	swapDaemonStatusScript = redis.NewScript(`
+if redis.sha1hex(redis.call("hget", KEYS[1], ARGV[1]) or "") == ARGV[2] then
+	redis.call("hset", KEYS[1], ARGV[1], ARGV[3])
+	return 1
+end
+return 0`)
+)
+
 func (a *Api) PostDaemonStatus(c echo.Context) error {
 	nodeID, clusterID, log := getNodeIDClusterIDAndLogger(c, "PostDaemonStatus")
 	if nodeID == "" {
 		return JSONNodeAuthProblem(c)
 	}
 
-	mChange := make(map[string]struct{})
-
-	mergeChanges := func(s string) {
-		for _, v := range strings.Fields(s) {
-			mChange[v] = struct{}{}
-		}
-	}
-
 	body := c.Request().Body
 	b, err := io.ReadAll(body)
 	defer func() {
@@ -44,19 +53,85 @@ func (a *Api) PostDaemonStatus(c echo.Context) error {
 	if err := json.Unmarshal(b, postData); err != nil {
 		log.Debug("request Unmarshal", logkey.Error, err)
 		return JSONProblem(c, http.StatusBadRequest, err.Error())
-	} else {
-		mergeChanges(strings.Join(postData.Changes, " "))
 	}
-	if !strings.HasPrefix(postData.Version, "2.") && !strings.HasPrefix(postData.Version, "3.") {
-		msg := fmt.Sprintf("unexpected version %s", postData.Version)
+	format, ok := a.Formats.Lookup(postData.Version)
+	if !ok {
+		msg := fmt.Sprintf("unexpected version %s: expected %s", postData.Version, strings.Join(a.Formats.Versions(), ", "))
 		log.Debug(msg)
 		return JSONProblem(c, http.StatusBadRequest, msg)
 	}
+	if err := a.validateDaemonStatus(c.Request().Context(), log, nodeID, format, postData.Version, postData.Data); err != nil {
+		log.Warn("validateDaemonStatus", logkey.Error, err)
+		return JSONProblem(c, http.StatusBadRequest, err.Error())
+	}
+	return a.acceptDaemonStatus(c, log, nodeID, clusterID, nil, b, postData.Changes)
+}
+
+// validateDaemonStatus validates the daemon status data of the format,
+// counts the fields not conforming and stores the node validation report if
+// it differs from the stored one, so the report checked_at is the first check
+// with this result. It returns the report error if the data does not conform.
+func (a *Api) validateDaemonStatus(ctx context.Context, log *slog.Logger, nodeID string, format daemonstatus.Format, version string, data map[string]any) error {
+	report := format.Validate(version, data)
+	for _, e := range report.Errors {
+		daemonStatusDecodeFailures.WithLabelValues(report.Schema, e.Field).Inc()
+	}
+	var stored daemonstatus.Report
+	if b, err := a.Redis.HGet(ctx, cachekeys.FeedDaemonStatusReportH, nodeID).Bytes(); err == nil {
+		if err := json.Unmarshal(b, &stored); err == nil && stored.Equal(report) {
+			return report.Err()
+		}
+	} else if !errors.Is(err, redis.Nil) {
+		log.Warn("HGet FeedDaemonStatusReportH", logkey.Error, err)
+	}
+	if b, err := json.Marshal(report); err != nil {
+		log.Warn("validation report Marshal", logkey.Error, err)
+	} else if err := a.Redis.HSet(ctx, cachekeys.FeedDaemonStatusReportH, nodeID, string(b)).Err(); err != nil {
+		log.Warn("HSet FeedDaemonStatusReportH", logkey.Error, err)
+	}
+	return report.Err()
+}
+
+// acceptDaemonStatus stores the node daemon status b, merges the changes to
+// the not yet applied changes, queues the node daemon status processing and
+// responds the cluster pending actions and missing object configs.
+//
+// A nil previous stores a full daemon status, and flags it for a full
+// processing. Else b is a patch of previous, stored only if the stored status
+// is still previous, and the processing is limited to the changes.
+func (a *Api) acceptDaemonStatus(c echo.Context, log *slog.Logger, nodeID, clusterID string, previous, b []byte, changes []string) error {
+	mChange := make(map[string]struct{})
+
+	mergeChanges := func(s string) {
+		for _, v := range strings.Fields(s) {
+			mChange[v] = struct{}{}
+		}
+	}
+	mergeChanges(strings.Join(changes, " "))
+
 	ctx := c.Request().Context()
-	log.Debug("HSet FeedDaemonStatusH")
-	if err := a.Redis.HSet(ctx, cachekeys.FeedDaemonStatusH, nodeID, string(b)).Err(); err != nil {
-		log.Error("HSet FeedDaemonStatusH", logkey.Error, err)
-		return JSONError(c)
+	if previous == nil {
+		log.Debug("HSet FeedDaemonStatusH")
+		if err := a.Redis.HSet(ctx, cachekeys.FeedDaemonStatusH, nodeID, string(b)).Err(); err != nil {
+			log.Error("HSet FeedDaemonStatusH", logkey.Error, err)
+			return JSONError(c)
+		}
+		log.Debug("HSet FeedDaemonStatusFullH")
+		if err := a.Redis.HSet(ctx, cachekeys.FeedDaemonStatusFullH, nodeID, "1").Err(); err != nil {
+			log.Error("HSet FeedDaemonStatusFullH", logkey.Error, err)
+			return JSONError(c)
+		}
+	} else {
+		log.Debug("swap FeedDaemonStatusH")
+		sum := sha1.Sum(previous)
+		swapped, err := swapDaemonStatusScript.Run(ctx, a.Redis, []string{cachekeys.FeedDaemonStatusH}, nodeID, hex.EncodeToString(sum[:]), string(b)).Int()
+		if err != nil {
+			log.Error("swap FeedDaemonStatusH", logkey.Error, err)
+			return JSONError(c)
+		} else if swapped == 0 {
+			log.Debug("need resync: daemon status changed during the patch")
+			return JSONProblemf(c, http.StatusConflict, "daemon status changed during the patch: %s", resyncHint)
+		}
 	}
 	if len(mChange) > 0 {
 		// request contains changes, merge them to not yet applied changes
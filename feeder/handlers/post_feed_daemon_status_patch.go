@@ -0,0 +1,217 @@
+package feederhandlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/cachekeys"
+	"github.com/opensvc/oc3/daemonstatus"
+	"github.com/opensvc/oc3/feeder"
+	"github.com/opensvc/oc3/util/jsonpatch"
+	"github.com/opensvc/oc3/util/logkey"
+)
+
+const (
+	resyncHint = "POST /daemon/status is required"
+)
+
+// PostDaemonStatusPatch applies a RFC 6902 json patch to the data of the
+// last accepted node daemon status, and queues the processing of the objects
+// and instances touched by the patch.
+//
+// The patch is rejected with 409 when the agent and the feeder status differ,
+// so the agent posts its full status.
+func (a *Api) PostDaemonStatusPatch(c echo.Context) error {
+	nodeID, clusterID, log := getNodeIDClusterIDAndLogger(c, "PostDaemonStatusPatch")
+	if nodeID == "" {
+		return JSONNodeAuthProblem(c)
+	}
+
+	body := c.Request().Body
+	b, err := io.ReadAll(body)
+	defer func() {
+		if err := body.Close(); err != nil {
+			log.Warn("request body Close", logkey.Error, err)
+		}
+	}()
+	if err != nil {
+		log.Warn("request ReadAll", logkey.Error, err)
+		return JSONProblemf(c, http.StatusBadRequest, "ReadAll: %s", err)
+	}
+	postData := &feeder.PostDaemonStatusPatch{}
+	if err := json.Unmarshal(b, postData); err != nil {
+		log.Debug("request Unmarshal", logkey.Error, err)
+		return JSONProblem(c, http.StatusBadRequest, err.Error())
+	}
+	format, ok := a.Formats.Lookup(postData.Version)
+	if !ok {
+		msg := fmt.Sprintf("unexpected version %s: expected %s", postData.Version, strings.Join(a.Formats.Versions(), ", "))
+		log.Debug(msg)
+		return JSONProblem(c, http.StatusBadRequest, msg)
+	}
+	patch := make(jsonpatch.Patch, len(postData.Patch))
+	for i, op := range postData.Patch {
+		patch[i] = jsonpatch.Operation{Op: string(op.Op), Path: op.Path, Value: op.Value}
+		if op.From != nil {
+			patch[i].From = *op.From
+		}
+	}
+	paths, err := patch.Paths()
+	if err != nil {
+		log.Debug("patch Paths", logkey.Error, err)
+		return JSONProblem(c, http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	log.Debug("HGet FeedDaemonStatusH")
+	previous, err := a.Redis.HGet(ctx, cachekeys.FeedDaemonStatusH, nodeID).Bytes()
+	switch {
+	case errors.Is(err, redis.Nil):
+		log.Debug("need resync: no daemon status")
+		return JSONProblemf(c, http.StatusConflict, "no daemon status to patch: %s", resyncHint)
+	case err != nil:
+		log.Error("HGet FeedDaemonStatusH", logkey.Error, err)
+		return JSONError(c)
+	}
+	status := &feeder.PostDaemonStatus{}
+	if err := json.Unmarshal(previous, status); err != nil {
+		log.Warn("need resync: daemon status Unmarshal", logkey.Error, err)
+		return JSONProblemf(c, http.StatusConflict, "unexpected daemon status to patch: %s", resyncHint)
+	}
+	switch {
+	case status.Seq == nil:
+		log.Debug("need resync: daemon status has no seq")
+		return JSONProblemf(c, http.StatusConflict, "daemon status has no seq: %s", resyncHint)
+	case *status.Seq != postData.BaseSeq:
+		log.Debug(fmt.Sprintf("need resync: daemon status seq %d, patch base_seq %d", *status.Seq, postData.BaseSeq))
+		return JSONProblemf(c, http.StatusConflict, "daemon status seq %d differs from base_seq %d: %s", *status.Seq, postData.BaseSeq, resyncHint)
+	case status.Version != postData.Version:
+		log.Debug(fmt.Sprintf("need resync: daemon status version %s, patch version %s", status.Version, postData.Version))
+		return JSONProblemf(c, http.StatusConflict, "daemon status version %s differs from %s: %s", status.Version, postData.Version, resyncHint)
+	}
+
+	patched, err := patch.Apply(status.Data)
+	switch {
+	case errors.Is(err, jsonpatch.ErrInvalid):
+		log.Debug("patch Apply", logkey.Error, err)
+		return JSONProblem(c, http.StatusBadRequest, err.Error())
+	case err != nil:
+		log.Debug("need resync: patch Apply", logkey.Error, err)
+		return JSONProblemf(c, http.StatusConflict, "%s: %s", err, resyncHint)
+	}
+	data, ok := patched.(map[string]any)
+	if !ok {
+		log.Debug("patched data is not an object")
+		return JSONProblem(c, http.StatusBadRequest, "patched data is not an object")
+	}
+	if err := a.validateDaemonStatus(ctx, log, nodeID, format, postData.Version, data); err != nil {
+		log.Warn("validateDaemonStatus", logkey.Error, err)
+		return JSONProblem(c, http.StatusBadRequest, err.Error())
+	}
+
+	changes := daemonStatusPatchChanges(format, data, paths)
+	if postData.Changes != nil {
+		changes = append(changes, *postData.Changes...)
+	}
+	status.Data = data
+	status.Changes = changes
+	status.Seq = &postData.Seq
+	status.UpdatedAt = postData.UpdatedAt
+	status.PreviousUpdatedAt = postData.PreviousUpdatedAt
+	if b, err = json.Marshal(status); err != nil {
+		log.Error("patched daemon status Marshal", logkey.Error, err)
+		return JSONError(c)
+	}
+	log.Debug("patched", logkey.Changes, strings.Join(changes, " "))
+	return a.acceptDaemonStatus(c, log, nodeID, clusterID, previous, b, changes)
+}
+
+// daemonStatusPatchChanges returns the object and instance changes of the
+// patched paths. The paths above the objects or instances, like a replace of
+// the whole object map, change all the objects or instances of the patched
+// data.
+func daemonStatusPatchChanges(format daemonstatus.Format, data map[string]any, paths [][]string) []string {
+	m := make(map[string]struct{})
+	for _, tokens := range paths {
+		for _, layout := range [][]string{format.Objects, format.Instances} {
+			if name, ok := layoutChange(layout, tokens); ok && name != "" {
+				m[name] = struct{}{}
+			} else if ok {
+				for _, name := range layoutKeys(layout, data) {
+					m[name] = struct{}{}
+				}
+			}
+		}
+	}
+	return slices.Sorted(maps.Keys(m))
+}
+
+// layoutChange returns the change name, <object> or <object>@<node>, of the
+// tokens at or under the layout location, and true. It returns an empty name
+// and true if the tokens are above the layout location, and false if the
+// tokens are outside the layout location.
+func layoutChange(layout, tokens []string) (string, bool) {
+	var objectName, nodename string
+	for i, token := range layout {
+		if i >= len(tokens) {
+			return "", true
+		}
+		switch token {
+		case daemonstatus.ObjectKey:
+			objectName = tokens[i]
+		case daemonstatus.NodeKey:
+			nodename = tokens[i]
+		case tokens[i]:
+		default:
+			return "", false
+		}
+	}
+	return changeName(objectName, nodename), true
+}
+
+// layoutKeys returns the change names of all the objects or instances at the
+// layout location in data.
+func layoutKeys(layout []string, data any) []string {
+	var walk func(v any, i int, objectName, nodename string) []string
+	walk = func(v any, i int, objectName, nodename string) []string {
+		if i == len(layout) {
+			return []string{changeName(objectName, nodename)}
+		}
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		var l []string
+		switch layout[i] {
+		case daemonstatus.ObjectKey:
+			for k, e := range m {
+				l = append(l, walk(e, i+1, k, nodename)...)
+			}
+		case daemonstatus.NodeKey:
+			for k, e := range m {
+				l = append(l, walk(e, i+1, objectName, k)...)
+			}
+		default:
+			l = walk(m[layout[i]], i+1, objectName, nodename)
+		}
+		return l
+	}
+	return walk(data, 0, "", "")
+}
+
+// changeName returns the <object> or <object>@<node> change name.
+func changeName(objectName, nodename string) string {
+	if nodename != "" {
+		return objectName + "@" + nodename
+	}
+	return objectName
+}
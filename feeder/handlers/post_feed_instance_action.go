@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -51,7 +50,7 @@ func (a *Api) PostInstanceAction(c echo.Context) error {
 		return JSONProblem(c, http.StatusBadRequest, err.Error())
 	}
 
-	if !strings.HasPrefix(payload.Version, "2.") && !strings.HasPrefix(payload.Version, "3.") {
+	if _, ok := a.Formats.Lookup(payload.Version); !ok {
 		log.Debug("unsupported data client version")
 		return JSONProblemf(c, http.StatusBadRequest, "unsupported data client version: %s", payload.Version)
 	}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"strings"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -52,10 +51,19 @@ func (a *Api) PostInstanceStatus(c echo.Context, params feeder.PostInstanceStatu
 		}
 	}
 
-	if !strings.HasPrefix(payload.Version, "2.") {
+	format, ok := a.Formats.Lookup(payload.Version)
+	if !ok || format.Instance == nil {
 		log.Error(fmt.Sprintf("unexpected version %s", payload.Version))
 		return JSONProblemf(c, http.StatusBadRequest, "unsupported data client version: %s", payload.Version)
 	}
+	report := format.ValidateInstance(payload.Version, payload.Data)
+	for _, e := range report.Errors {
+		daemonStatusDecodeFailures.WithLabelValues(report.Schema, e.Field).Inc()
+	}
+	if err := report.Err(); err != nil {
+		log.Warn("ValidateInstance", logkey.Error, err)
+		return JSONProblem(c, http.StatusBadRequest, err.Error())
+	}
 
 	if payload.Path == "" {
 		return JSONProblem(c, http.StatusBadRequest, "missing or empty instance path")
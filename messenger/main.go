@@ -1,15 +1,13 @@
 package messenger
 
 import (
-	"crypto/hmac"
-	"crypto/md5"
 	"crypto/tls"
-	"encoding/hex"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/prometheus/client_golang/prometheus"
@@ -23,6 +21,16 @@ type CmdComet struct {
 	RequireToken bool
 	KeyFile      string
 	CertFile     string
+
+	// Algorithm is the HMAC hash used to sign messages: sha256 or sha512
+	Algorithm string
+
+	// ReplayWindow is the maximum age of a signed message timestamp
+	ReplayWindow time.Duration
+
+	// LegacyMD5 accepts messages signed with the HMAC-MD5 of the message
+	// only, without timestamp.
+	LegacyMD5 bool
 }
 
 var (
@@ -30,6 +38,7 @@ var (
 	names     = make(map[*Client]string)
 	tokens    = make(map[string]*Client)
 	hmacKey   string
+	sigVerify *verifier
 	useTokens bool
 	mu        sync.RWMutex
 	upgrader  = websocket.Upgrader{
@@ -80,8 +89,29 @@ var (
 			Name:      "receive_message_total",
 			Help:      "Total number of received messages",
 		}, []string{"group"})
+	rejectMessageTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "oc3",
+			Subsystem: "messenger",
+			Name:      "reject_message_total",
+			Help:      "Total number of messages rejected by the signature verification",
+		}, []string{"reason"})
 )
 
+// authorized verifies the request signature when a key is configured, and
+// reports the rejection reason.
+func authorized(r *http.Request, group string) bool {
+	if hmacKey == "" {
+		return true
+	}
+	if err := sigVerify.verify(r, group); err != nil {
+		slog.Debug(fmt.Sprintf("reject message to %s: %s", group, err))
+		rejectMessageTotal.WithLabelValues(err.Error()).Inc()
+		return false
+	}
+	return true
+}
+
 func postHandler(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
@@ -104,21 +134,14 @@ func postHandler(w http.ResponseWriter, r *http.Request) {
 		group = "default"
 	}
 
+	if !authorized(r, r.FormValue("group")) {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
 	slog.Debug(fmt.Sprintf("MESSAGE to %s:%s", group, message))
 	sendMessageTotal.WithLabelValues(group).Inc()
 
-	if hmacKey != "" {
-		signature := r.FormValue("signature")
-		h := hmac.New(md5.New, []byte(hmacKey))
-		h.Write([]byte(message))
-		expectedSig := hex.EncodeToString(h.Sum(nil))
-
-		if signature != expectedSig {
-			http.Error(w, "Unauthorized", http.StatusUnauthorized)
-			return
-		}
-	}
-
 	mu.RLock()
 	clients := listeners[group]
 	mu.RUnlock()
@@ -152,16 +175,9 @@ func tokenHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if hmacKey != "" {
-		signature := r.FormValue("signature")
-		h := hmac.New(md5.New, []byte(hmacKey))
-		h.Write([]byte(message))
-		expectedSig := hex.EncodeToString(h.Sum(nil))
-
-		if signature != expectedSig {
-			http.Error(w, "Unauthorized", http.StatusUnauthorized)
-			return
-		}
+	if !authorized(r, r.FormValue("group")) {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
 	}
 
 	mu.Lock()
@@ -278,6 +294,16 @@ func (c *Client) WriteMessage(messageType int, data []byte) error {
 func (c *CmdComet) Run() error {
 	hmacKey = c.Key
 	useTokens = c.RequireToken
+	if hmacKey != "" {
+		v, err := newVerifier(c.Key, c.Algorithm, c.ReplayWindow, c.LegacyMD5)
+		if err != nil {
+			return err
+		}
+		sigVerify = v
+		if c.LegacyMD5 {
+			slog.Warn("legacy HMAC-MD5 message signatures are accepted")
+		}
+	}
 
 	http.HandleFunc("/", postHandler)
 	http.HandleFunc("/token", tokenHandler)
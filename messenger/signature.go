@@ -0,0 +1,129 @@
+package messenger
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/opensvc/oc3/oc2websocket"
+)
+
+type (
+	// verifier checks the signature of the messages posted to the messenger.
+	//
+	// The signed payload is the one defined by oc2websocket.SignedPayload.
+	// Posts outside the replay window or reusing a nonce seen within the
+	// window are refused.
+	verifier struct {
+		key       []byte
+		algorithm string
+		window    time.Duration
+		legacyMD5 bool
+
+		mu        sync.Mutex
+		nonces    map[string]time.Time
+		lastPurge time.Time
+	}
+)
+
+var (
+	errMissingSignature = errors.New("missing signature")
+	errMissingTimestamp = errors.New("missing timestamp")
+	errBadTimestamp     = errors.New("invalid timestamp")
+	errExpired          = errors.New("timestamp outside the replay window")
+	errReplayed         = errors.New("nonce already used")
+	errBadSignature     = errors.New("signature mismatch")
+)
+
+func newVerifier(key, algorithm string, window time.Duration, legacyMD5 bool) (*verifier, error) {
+	if algorithm == oc2websocket.AlgorithmMD5 && !legacyMD5 {
+		// the md5 producers sign the message only, without timestamp
+		return nil, fmt.Errorf("signature algorithm %s is only accepted with the legacy md5 flag", algorithm)
+	}
+	if _, err := oc2websocket.HashFunc(algorithm); err != nil {
+		return nil, err
+	}
+	if window <= 0 {
+		return nil, fmt.Errorf("invalid replay window: %s", window)
+	}
+	return &verifier{
+		key:       []byte(key),
+		algorithm: algorithm,
+		window:    window,
+		legacyMD5: legacyMD5,
+		nonces:    make(map[string]time.Time),
+	}, nil
+}
+
+// verify checks the signature of the request form values. The group value
+// is the one the message is delivered to.
+func (v *verifier) verify(r *http.Request, group string) error {
+	signature := r.FormValue("signature")
+	if signature == "" {
+		return errMissingSignature
+	}
+	message := r.FormValue("message")
+	timestamp := r.FormValue("timestamp")
+	if timestamp == "" {
+		if v.legacyMD5 {
+			return v.verifyMD5(message, signature)
+		}
+		return errMissingTimestamp
+	}
+	sec, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errBadTimestamp
+	}
+	now := time.Now()
+	if d := now.Sub(time.Unix(sec, 0)); d > v.window || d < -v.window {
+		return errExpired
+	}
+	nonce := r.FormValue("nonce")
+	newHash, _ := oc2websocket.HashFunc(v.algorithm)
+	h := hmac.New(newHash, v.key)
+	h.Write(oc2websocket.SignedPayload(group, timestamp, nonce, message))
+	if !hmac.Equal([]byte(signature), []byte(hex.EncodeToString(h.Sum(nil)))) {
+		return errBadSignature
+	}
+	if nonce == "" {
+		// without nonce, the signature itself identifies the post
+		nonce = signature
+	}
+	return v.remember(nonce, now)
+}
+
+func (v *verifier) verifyMD5(message, signature string) error {
+	h := hmac.New(md5.New, v.key)
+	h.Write([]byte(message))
+	if !hmac.Equal([]byte(signature), []byte(hex.EncodeToString(h.Sum(nil)))) {
+		return errBadSignature
+	}
+	return nil
+}
+
+// remember records the nonce and refuses it if already seen in the replay
+// window. Expired nonces are dropped at most once per window.
+func (v *verifier) remember(nonce string, now time.Time) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if now.Sub(v.lastPurge) > v.window {
+		for k, t := range v.nonces {
+			// a timestamp can be ahead of now by up to the window
+			if now.Sub(t) > 2*v.window {
+				delete(v.nonces, k)
+			}
+		}
+		v.lastPurge = now
+	}
+	if _, ok := v.nonces[nonce]; ok {
+		return errReplayed
+	}
+	v.nonces[nonce] = now
+	return nil
+}
@@ -0,0 +1,345 @@
+// Package notify delivers the dashboard alert transitions to email, http
+// webhook and chat channels, according to routing rules.
+//
+// The channels and routes are read from the scheduler.task.notify
+// configuration section:
+//
+//	scheduler:
+//	  task:
+//	    notify:
+//	      group_window: 5m
+//	      dedup_window: 1h
+//	      channels:
+//	        ops:
+//	          type: smtp
+//	          addr: localhost:25
+//	          from: oc3@example.com
+//	          to: [ops@example.com]
+//	        chat:
+//	          type: slack
+//	          url: https://hooks.slack.com/services/xxx
+//	      routes:
+//	        - channels: [ops, chat]
+//	          envs: [PRD]
+//	          min_severity: 3
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+type (
+	// Event is a dashboard alert transition.
+	Event struct {
+		Kind     string    `json:"kind"`
+		Key      string    `json:"key"`
+		Type     string    `json:"type"`
+		Severity int       `json:"severity"`
+		Env      string    `json:"env"`
+		App      string    `json:"app"`
+		NodeID   string    `json:"node_id"`
+		Nodename string    `json:"nodename"`
+		SvcID    string    `json:"svc_id"`
+		Svcname  string    `json:"svcname"`
+		Text     string    `json:"text"`
+		At       time.Time `json:"at"`
+	}
+
+	// Message is the grouped events sent to a channel at once.
+	Message struct {
+		Subject string  `json:"subject"`
+		Text    string  `json:"text"`
+		Events  []Event `json:"events"`
+	}
+
+	// Channel delivers messages.
+	Channel interface {
+		Name() string
+
+		// Type is the channel type, recorded as the alerts_sent msg_type
+		Type() string
+
+		// Recipients describes the message destination, recorded as the
+		// alerts sent_to
+		Recipients() string
+
+		Send(ctx context.Context, m Message) error
+	}
+
+	// Route selects the events sent to its channels. An empty criteria
+	// matches all events.
+	Route struct {
+		Channels []string `mapstructure:"channels"`
+
+		// Types are the dashboard alert types, with optional shell
+		// wildcards like "check *"
+		Types []string `mapstructure:"types"`
+
+		Envs        []string `mapstructure:"envs"`
+		Apps        []string `mapstructure:"apps"`
+		MinSeverity int      `mapstructure:"min_severity"`
+
+		// Events are the transition kinds, raise or clear
+		Events []string `mapstructure:"events"`
+	}
+
+	ChannelConfig struct {
+		// Type is smtp, webhook, slack or teams
+		Type string `mapstructure:"type"`
+
+		// Addr, From, To, Username and Password are the smtp channel
+		// settings. The message is sent unauthenticated if Username is
+		// empty.
+		Addr     string   `mapstructure:"addr"`
+		From     string   `mapstructure:"from"`
+		To       []string `mapstructure:"to"`
+		Username string   `mapstructure:"username"`
+		Password string   `mapstructure:"password"`
+
+		// URL, Headers, Template and Timeout are the webhook channels
+		// settings. Template is a text/template rendering the request
+		// body from a Message, only used by the webhook type.
+		URL      string            `mapstructure:"url"`
+		Headers  map[string]string `mapstructure:"headers"`
+		Template string            `mapstructure:"template"`
+		Timeout  time.Duration     `mapstructure:"timeout"`
+	}
+
+	Config struct {
+		// GroupWindow is the delay a channel waits for more events after
+		// its first queued event, before sending them in a single message.
+		GroupWindow time.Duration `mapstructure:"group_window"`
+
+		// DedupWindow is the delay after a raise notification during
+		// which the same alert raised again is not notified.
+		DedupWindow time.Duration `mapstructure:"dedup_window"`
+
+		// MaxAge is the age of the queued events dropped if their
+		// channel still fails to send them.
+		MaxAge time.Duration `mapstructure:"max_age"`
+
+		Channels map[string]ChannelConfig `mapstructure:"channels"`
+		Routes   []Route                  `mapstructure:"routes"`
+	}
+
+	// Notifier routes the events to its channels.
+	Notifier struct {
+		Config
+		channels map[string]Channel
+	}
+)
+
+const (
+	EventRaise = "raise"
+	EventClear = "clear"
+
+	DefaultGroupWindow = 5 * time.Minute
+	DefaultDedupWindow = time.Hour
+	DefaultMaxAge      = 24 * time.Hour
+
+	configSection = "scheduler.task.notify"
+)
+
+var (
+	reFmtKey = regexp.MustCompile(`%\(([^)]+)\)[sd]`)
+)
+
+// LoadConfig returns the notification configuration, with the unset
+// windows set to their default.
+func LoadConfig() (Config, error) {
+	var cfg Config
+	if err := viper.UnmarshalKey(configSection, &cfg); err != nil {
+		return cfg, fmt.Errorf("%s: %w", configSection, err)
+	}
+	if cfg.GroupWindow <= 0 {
+		cfg.GroupWindow = DefaultGroupWindow
+	}
+	if cfg.DedupWindow <= 0 {
+		cfg.DedupWindow = DefaultDedupWindow
+	}
+	if cfg.MaxAge <= 0 {
+		cfg.MaxAge = DefaultMaxAge
+	}
+	// viper lowercases the channels map keys
+	for _, r := range cfg.Routes {
+		for i, name := range r.Channels {
+			r.Channels[i] = strings.ToLower(name)
+		}
+	}
+	return cfg, nil
+}
+
+// New returns a Notifier with the configured channels. It returns an error
+// if a channel is invalid or if a route references an unknown channel.
+func New(cfg Config) (*Notifier, error) {
+	n := &Notifier{Config: cfg, channels: make(map[string]Channel)}
+	for name, c := range cfg.Channels {
+		ch, err := newChannel(name, c)
+		if err != nil {
+			return nil, fmt.Errorf("%s.channels.%s: %w", configSection, name, err)
+		}
+		n.channels[name] = ch
+	}
+	for i, r := range cfg.Routes {
+		if len(r.Channels) == 0 {
+			return nil, fmt.Errorf("%s.routes[%d]: no channels", configSection, i)
+		}
+		for _, name := range r.Channels {
+			if _, ok := n.channels[name]; !ok {
+				return nil, fmt.Errorf("%s.routes[%d]: unknown channel %s", configSection, i, name)
+			}
+		}
+		for _, kind := range r.Events {
+			if kind != EventRaise && kind != EventClear {
+				return nil, fmt.Errorf("%s.routes[%d]: invalid event %s: expect %s or %s", configSection, i, kind, EventRaise, EventClear)
+			}
+		}
+	}
+	return n, nil
+}
+
+func newChannel(name string, c ChannelConfig) (Channel, error) {
+	switch c.Type {
+	case "smtp":
+		return newSMTPChannel(name, c)
+	case "webhook", "slack", "teams":
+		return newWebhookChannel(name, c)
+	default:
+		return nil, fmt.Errorf("invalid type %q: expect smtp, webhook, slack or teams", c.Type)
+	}
+}
+
+// Enabled returns true if at least one route is configured.
+func (n *Notifier) Enabled() bool {
+	return len(n.Routes) > 0
+}
+
+// Channel returns the named channel, or nil if not configured.
+func (n *Notifier) Channel(name string) Channel {
+	return n.channels[strings.ToLower(name)]
+}
+
+// Route returns the sorted names of the channels the event must be sent
+// to. A channel matched by several routes is returned once.
+func (n *Notifier) Route(e Event) []string {
+	var l []string
+	for _, r := range n.Routes {
+		if !r.Match(e) {
+			continue
+		}
+		for _, name := range r.Channels {
+			if !slices.Contains(l, name) {
+				l = append(l, name)
+			}
+		}
+	}
+	sort.Strings(l)
+	return l
+}
+
+// Match returns true if the event satisfies all the route criteria.
+func (r Route) Match(e Event) bool {
+	if e.Severity < r.MinSeverity {
+		return false
+	}
+	if len(r.Events) > 0 && !slices.Contains(r.Events, e.Kind) {
+		return false
+	}
+	if len(r.Envs) > 0 && !slices.Contains(r.Envs, e.Env) {
+		return false
+	}
+	if len(r.Apps) > 0 && !slices.Contains(r.Apps, e.App) {
+		return false
+	}
+	if len(r.Types) > 0 && !slices.ContainsFunc(r.Types, func(pattern string) bool {
+		matched, _ := path.Match(pattern, e.Type)
+		return matched
+	}) {
+		return false
+	}
+	return true
+}
+
+// NewMessage returns the message grouping the events.
+func NewMessage(events []Event) Message {
+	m := Message{Events: events}
+	var raised, cleared int
+	lines := make([]string, len(events))
+	for i, e := range events {
+		if e.Kind == EventRaise {
+			raised++
+		} else {
+			cleared++
+		}
+		lines[i] = e.String()
+	}
+	m.Text = strings.Join(lines, "\n")
+	if len(events) == 1 {
+		m.Subject = "[oc3] " + events[0].Summary()
+	} else {
+		m.Subject = fmt.Sprintf("[oc3] %d alerts raised, %d cleared", raised, cleared)
+	}
+	return m
+}
+
+// Summary returns a short description of the event, like
+// "raise sev 4 PRD uid conflict on node1".
+func (e Event) Summary() string {
+	s := fmt.Sprintf("%s sev %d", e.Kind, e.Severity)
+	if e.Env != "" {
+		s += " " + e.Env
+	}
+	s += " " + e.Type
+	switch {
+	case e.Svcname != "" && e.Nodename != "":
+		s += fmt.Sprintf(" on %s@%s", e.Svcname, e.Nodename)
+	case e.Svcname != "":
+		s += " on " + e.Svcname
+	case e.Nodename != "":
+		s += " on " + e.Nodename
+	}
+	return s
+}
+
+// String returns the event summary followed by its text.
+func (e Event) String() string {
+	s := e.At.Format(time.DateTime) + " " + e.Summary()
+	if e.App != "" {
+		s += " (app " + e.App + ")"
+	}
+	if e.Text != "" {
+		s += ": " + e.Text
+	}
+	return s
+}
+
+// FormatDashboard returns the dashboard alert message, with the %(key)s
+// placeholders of the dash_fmt replaced by the values of the dash_dict
+// json object.
+func FormatDashboard(format, dict string) string {
+	if dict == "" || !strings.Contains(format, "%(") {
+		return format
+	}
+	var m map[string]any
+	if err := json.Unmarshal([]byte(dict), &m); err != nil {
+		return format
+	}
+	return reFmtKey.ReplaceAllStringFunc(format, func(s string) string {
+		key := reFmtKey.FindStringSubmatch(s)[1]
+		v, ok := m[key]
+		if !ok {
+			return s
+		}
+		return fmt.Sprint(v)
+	})
+}
@@ -0,0 +1,80 @@
+package notify
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNotifierRoute(t *testing.T) {
+	n, err := New(Config{
+		Channels: map[string]ChannelConfig{
+			"ops":  {Type: "smtp", Addr: "localhost:25", From: "a@b", To: []string{"c@d"}},
+			"chat": {Type: "slack", URL: "https://hooks.example.com/xxx"},
+		},
+		Routes: []Route{
+			{Channels: []string{"ops", "chat"}, Envs: []string{"PRD"}, MinSeverity: 3},
+			{Channels: []string{"chat"}, Types: []string{"check *"}, Events: []string{EventRaise}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("new: %s", err)
+	}
+	cases := []struct {
+		name string
+		e    Event
+		want []string
+	}{
+		{"prd", Event{Kind: EventRaise, Env: "PRD", Severity: 3, Type: "uid conflict"}, []string{"chat", "ops"}},
+		{"low severity", Event{Kind: EventRaise, Env: "PRD", Severity: 2, Type: "uid conflict"}, nil},
+		{"other env", Event{Kind: EventRaise, Env: "DEV", Severity: 4, Type: "uid conflict"}, nil},
+		{"type wildcard", Event{Kind: EventRaise, Env: "DEV", Type: "check out of bounds"}, []string{"chat"}},
+		{"type wildcard clear", Event{Kind: EventClear, Env: "DEV", Type: "check out of bounds"}, nil},
+		{"matched twice", Event{Kind: EventRaise, Env: "PRD", Severity: 4, Type: "check out of bounds"}, []string{"chat", "ops"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := n.Route(tc.e); !slices.Equal(got, tc.want) {
+				t.Errorf("route = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNewInvalid(t *testing.T) {
+	ops := map[string]ChannelConfig{"ops": {Type: "smtp", Addr: "localhost:25", From: "a@b", To: []string{"c@d"}}}
+	cases := []struct {
+		name string
+		cfg  Config
+	}{
+		{"invalid channel type", Config{Channels: map[string]ChannelConfig{"x": {Type: "fax"}}}},
+		{"route without channel", Config{Channels: ops, Routes: []Route{{}}}},
+		{"unknown channel", Config{Channels: ops, Routes: []Route{{Channels: []string{"chat"}}}}},
+		{"invalid event", Config{Channels: ops, Routes: []Route{{Channels: []string{"ops"}, Events: []string{"ack"}}}}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := New(tc.cfg); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+func TestFormatDashboard(t *testing.T) {
+	cases := []struct {
+		format string
+		dict   string
+		want   string
+	}{
+		{"plain", `{"a": 1}`, "plain"},
+		{"%(name)s has %(n)d ids", `{"name": "foo", "n": 2}`, "foo has 2 ids"},
+		{"%(name)s has %(missing)s", `{"name": "foo"}`, "foo has %(missing)s"},
+		{"%(name)s", "", "%(name)s"},
+		{"%(name)s", "not json", "%(name)s"},
+	}
+	for _, tc := range cases {
+		if got := FormatDashboard(tc.format, tc.dict); got != tc.want {
+			t.Errorf("FormatDashboard(%q, %q) = %q, want %q", tc.format, tc.dict, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+type (
+	// SMTPChannel sends the messages by email.
+	SMTPChannel struct {
+		name     string
+		addr     string
+		from     string
+		to       []string
+		username string
+		password string
+	}
+)
+
+func newSMTPChannel(name string, c ChannelConfig) (*SMTPChannel, error) {
+	if c.Addr == "" {
+		return nil, fmt.Errorf("addr is required")
+	}
+	if _, _, err := net.SplitHostPort(c.Addr); err != nil {
+		return nil, fmt.Errorf("invalid addr %s: %w", c.Addr, err)
+	}
+	if c.From == "" {
+		return nil, fmt.Errorf("from is required")
+	}
+	if len(c.To) == 0 {
+		return nil, fmt.Errorf("to is required")
+	}
+	return &SMTPChannel{
+		name:     name,
+		addr:     c.Addr,
+		from:     c.From,
+		to:       c.To,
+		username: c.Username,
+		password: c.Password,
+	}, nil
+}
+
+func (t *SMTPChannel) Name() string { return t.name }
+
+func (t *SMTPChannel) Type() string { return "smtp" }
+
+func (t *SMTPChannel) Recipients() string { return strings.Join(t.to, ",") }
+
+// Send sends the message as a plain text email. The connection is upgraded
+// with STARTTLS if the server supports it.
+func (t *SMTPChannel) Send(ctx context.Context, m Message) error {
+	var auth smtp.Auth
+	if t.username != "" {
+		host, _, _ := net.SplitHostPort(t.addr)
+		auth = smtp.PlainAuth("", t.username, t.password, host)
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", t.from)
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(t.to, ", "))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", m.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(m.Text, "\n", "\r\n"))
+	buf.WriteString("\r\n")
+
+	// smtp.SendMail does not support a context, so run it aside
+	errC := make(chan error, 1)
+	go func() {
+		errC <- smtp.SendMail(t.addr, auth, t.from, t.to, buf.Bytes())
+	}()
+	select {
+	case err := <-errC:
+		if err != nil {
+			return fmt.Errorf("smtp %s: %w", t.addr, err)
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,181 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+)
+
+type (
+	// fakeSMTPServer accepts a single mail transaction and records it.
+	fakeSMTPServer struct {
+		addr  string
+		mailC chan fakeMail
+	}
+
+	fakeMail struct {
+		auth string
+		from string
+		to   []string
+		data string
+	}
+)
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %s", err)
+	}
+	t.Cleanup(func() { _ = l.Close() })
+	s := &fakeSMTPServer{addr: l.Addr().String(), mailC: make(chan fakeMail, 1)}
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		s.serve(textproto.NewConn(conn))
+	}()
+	return s
+}
+
+func (s *fakeSMTPServer) serve(c *textproto.Conn) {
+	var mail fakeMail
+	_ = c.PrintfLine("220 localhost fake smtp")
+	for {
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			_ = c.PrintfLine("250-localhost")
+			_ = c.PrintfLine("250 AUTH PLAIN")
+		case "AUTH":
+			_, b64, _ := strings.Cut(arg, " ")
+			b, _ := base64.StdEncoding.DecodeString(b64)
+			mail.auth = string(b)
+			_ = c.PrintfLine("235 ok")
+		case "MAIL":
+			mail.from = strings.Trim(strings.TrimPrefix(arg, "FROM:"), "<>")
+			_ = c.PrintfLine("250 ok")
+		case "RCPT":
+			mail.to = append(mail.to, strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>"))
+			_ = c.PrintfLine("250 ok")
+		case "DATA":
+			_ = c.PrintfLine("354 go ahead")
+			b, err := c.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			mail.data = string(b)
+			_ = c.PrintfLine("250 ok")
+			s.mailC <- mail
+		case "QUIT":
+			_ = c.PrintfLine("221 bye")
+			return
+		default:
+			_ = c.PrintfLine("502 not implemented")
+		}
+	}
+}
+
+func TestSMTPChannelSend(t *testing.T) {
+	cases := []struct {
+		name     string
+		username string
+		wantAuth string
+	}{
+		{name: "unauthenticated"},
+		{name: "authenticated", username: "oc3", wantAuth: "\x00oc3\x00secret"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := newFakeSMTPServer(t)
+			ch, err := newSMTPChannel("ops", ChannelConfig{
+				Type:     "smtp",
+				Addr:     srv.addr,
+				From:     "oc3@example.com",
+				To:       []string{"ops@example.com", "oncall@example.com"},
+				Username: tc.username,
+				Password: "secret",
+			})
+			if err != nil {
+				t.Fatalf("new channel: %s", err)
+			}
+			m := NewMessage([]Event{
+				{Kind: EventRaise, Type: "uid conflict", Severity: 4, Nodename: "node1", Text: "line1"},
+				{Kind: EventClear, Type: "gid conflict", Severity: 3, Nodename: "node2", Text: "line2"},
+			})
+			if err := ch.Send(context.Background(), m); err != nil {
+				t.Fatalf("send: %s", err)
+			}
+			mail := <-srv.mailC
+			if mail.auth != tc.wantAuth {
+				t.Errorf("auth = %q, want %q", mail.auth, tc.wantAuth)
+			}
+			if mail.from != "oc3@example.com" {
+				t.Errorf("from = %s, want oc3@example.com", mail.from)
+			}
+			if got := strings.Join(mail.to, ","); got != "ops@example.com,oncall@example.com" {
+				t.Errorf("to = %s", got)
+			}
+			r := textproto.NewReader(bufio.NewReader(strings.NewReader(mail.data)))
+			header, err := r.ReadMIMEHeader()
+			if err != nil {
+				t.Fatalf("read header: %s", err)
+			}
+			if v := header.Get("Subject"); v != "[oc3] 1 alerts raised, 1 cleared" {
+				t.Errorf("subject = %s", v)
+			}
+			if v := header.Get("To"); v != "ops@example.com, oncall@example.com" {
+				t.Errorf("to header = %s", v)
+			}
+			if v := header.Get("Content-Type"); v != "text/plain; charset=utf-8" {
+				t.Errorf("content type = %s", v)
+			}
+			if _, body, _ := strings.Cut(mail.data, "\n\n"); body != m.Text+"\n" {
+				t.Errorf("body = %q, want %q", body, m.Text+"\n")
+			}
+			if ch.Recipients() != "ops@example.com,oncall@example.com" {
+				t.Errorf("recipients = %s", ch.Recipients())
+			}
+		})
+	}
+}
+
+func TestSMTPChannelSendError(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %s", err)
+	}
+	addr := l.Addr().String()
+	_ = l.Close()
+	ch, err := newSMTPChannel("ops", ChannelConfig{Addr: addr, From: "oc3@example.com", To: []string{"ops@example.com"}})
+	if err != nil {
+		t.Fatalf("new channel: %s", err)
+	}
+	if err := ch.Send(context.Background(), testMessage()); err == nil {
+		t.Errorf("expected an error on a closed port")
+	}
+}
+
+func TestNewSMTPChannelInvalid(t *testing.T) {
+	cases := []ChannelConfig{
+		{From: "a@b", To: []string{"c@d"}},
+		{Addr: "localhost", From: "a@b", To: []string{"c@d"}},
+		{Addr: "localhost:25", To: []string{"c@d"}},
+		{Addr: "localhost:25", From: "a@b"},
+	}
+	for _, c := range cases {
+		if _, err := newSMTPChannel("ops", c); err == nil {
+			t.Errorf("%+v: expected an error", c)
+		}
+	}
+}
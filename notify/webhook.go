@@ -0,0 +1,123 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"text/template"
+	"time"
+)
+
+type (
+	// WebhookChannel posts the messages to a http endpoint, with a body
+	// rendered by a template.
+	//
+	// The slack and teams channel types are webhooks with a predefined
+	// template, compatible with the slack and teams incoming webhooks.
+	WebhookChannel struct {
+		name     string
+		typ      string
+		url      string
+		headers  map[string]string
+		template *template.Template
+		client   *http.Client
+	}
+)
+
+const (
+	defaultWebhookTimeout = 10 * time.Second
+
+	// maxWebhookErrorBody is the maximum size of the response body
+	// reported in the send errors.
+	maxWebhookErrorBody = 512
+)
+
+var (
+	webhookTemplates = map[string]string{
+		"webhook": `{{ json . }}`,
+		"slack":   `{"text": {{ json (printf "*%s*\n%s" .Subject .Text) }}}`,
+		"teams":   `{"@type": "MessageCard", "@context": "https://schema.org/extensions", "summary": {{ json .Subject }}, "title": {{ json .Subject }}, "text": {{ json (printf "<pre>%s</pre>" .Text) }}}`,
+	}
+
+	webhookFuncs = template.FuncMap{
+		"json": func(v any) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}
+)
+
+func newWebhookChannel(name string, c ChannelConfig) (*WebhookChannel, error) {
+	if c.URL == "" {
+		return nil, fmt.Errorf("url is required")
+	}
+	if u, err := url.Parse(c.URL); err != nil {
+		return nil, fmt.Errorf("invalid url: %w", err)
+	} else if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid url %s: expect a http or https scheme", c.URL)
+	}
+	text := webhookTemplates[c.Type]
+	if c.Type == "webhook" && c.Template != "" {
+		text = c.Template
+	}
+	tmpl, err := template.New(name).Funcs(webhookFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	timeout := c.Timeout
+	if timeout <= 0 {
+		timeout = defaultWebhookTimeout
+	}
+	return &WebhookChannel{
+		name:     name,
+		typ:      c.Type,
+		url:      c.URL,
+		headers:  c.Headers,
+		template: tmpl,
+		client:   &http.Client{Timeout: timeout},
+	}, nil
+}
+
+func (t *WebhookChannel) Name() string { return t.name }
+
+func (t *WebhookChannel) Type() string { return t.typ }
+
+// Recipients returns the url host only, as the url path of the chat
+// webhooks embeds a secret token.
+func (t *WebhookChannel) Recipients() string {
+	if u, err := url.Parse(t.url); err == nil {
+		return u.Host
+	}
+	return t.name
+}
+
+// Send posts the rendered message and expects a 2xx response status.
+func (t *WebhookChannel) Send(ctx context.Context, m Message) error {
+	var body bytes.Buffer
+	if err := t.template.Execute(&body, m); err != nil {
+		return fmt.Errorf("render %s template: %w", t.name, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.url, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range t.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post %s: %w", t.Recipients(), err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, maxWebhookErrorBody))
+		return fmt.Errorf("post %s: unexpected status %s: %s", t.Recipients(), resp.Status, bytes.TrimSpace(b))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
@@ -0,0 +1,162 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type (
+	capturedRequest struct {
+		method  string
+		path    string
+		headers http.Header
+		body    []byte
+	}
+)
+
+func newWebhookServer(t *testing.T, status int) (*httptest.Server, <-chan capturedRequest) {
+	t.Helper()
+	reqC := make(chan capturedRequest, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		reqC <- capturedRequest{method: r.Method, path: r.URL.Path, headers: r.Header, body: b}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("some reason\n"))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, reqC
+}
+
+func testMessage() Message {
+	return NewMessage([]Event{{
+		Kind:     EventRaise,
+		Type:     "uid conflict",
+		Severity: 4,
+		Env:      "PRD",
+		Nodename: "node1",
+		Text:     `user "foo" has different ids`,
+		At:       time.Date(2024, 9, 2, 10, 0, 0, 0, time.UTC),
+	}})
+}
+
+func TestWebhookChannelSend(t *testing.T) {
+	cases := []struct {
+		typ      string
+		template string
+		check    func(t *testing.T, m Message, body map[string]any)
+	}{
+		{
+			typ: "webhook",
+			check: func(t *testing.T, m Message, body map[string]any) {
+				if body["subject"] != m.Subject {
+					t.Errorf("subject = %v, want %s", body["subject"], m.Subject)
+				}
+				events, _ := body["events"].([]any)
+				if len(events) != 1 {
+					t.Fatalf("events = %v, want 1 event", body["events"])
+				}
+				if e, _ := events[0].(map[string]any); e["kind"] != EventRaise || e["nodename"] != "node1" {
+					t.Errorf("event = %v", e)
+				}
+			},
+		},
+		{
+			typ:      "webhook",
+			template: `{"summary": {{ json .Subject }}}`,
+			check: func(t *testing.T, m Message, body map[string]any) {
+				if len(body) != 1 || body["summary"] != m.Subject {
+					t.Errorf("body = %v, want the templated summary %s", body, m.Subject)
+				}
+			},
+		},
+		{
+			typ: "slack",
+			check: func(t *testing.T, m Message, body map[string]any) {
+				if want := "*" + m.Subject + "*\n" + m.Text; body["text"] != want {
+					t.Errorf("text = %q, want %q", body["text"], want)
+				}
+			},
+		},
+		{
+			typ: "teams",
+			check: func(t *testing.T, m Message, body map[string]any) {
+				if body["@type"] != "MessageCard" || body["title"] != m.Subject {
+					t.Errorf("body = %v", body)
+				}
+				if want := "<pre>" + m.Text + "</pre>"; body["text"] != want {
+					t.Errorf("text = %q, want %q", body["text"], want)
+				}
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.typ+tc.template, func(t *testing.T) {
+			srv, reqC := newWebhookServer(t, http.StatusOK)
+			ch, err := newWebhookChannel("test", ChannelConfig{
+				Type:     tc.typ,
+				URL:      srv.URL + "/hooks/secret",
+				Template: tc.template,
+				Headers:  map[string]string{"X-Token": "abc"},
+			})
+			if err != nil {
+				t.Fatalf("new channel: %s", err)
+			}
+			m := testMessage()
+			if err := ch.Send(context.Background(), m); err != nil {
+				t.Fatalf("send: %s", err)
+			}
+			req := <-reqC
+			if req.method != http.MethodPost || req.path != "/hooks/secret" {
+				t.Errorf("request = %s %s, want POST /hooks/secret", req.method, req.path)
+			}
+			if v := req.headers.Get("Content-Type"); v != "application/json" {
+				t.Errorf("content type = %s, want application/json", v)
+			}
+			if v := req.headers.Get("X-Token"); v != "abc" {
+				t.Errorf("X-Token header = %s, want abc", v)
+			}
+			var body map[string]any
+			if err := json.Unmarshal(req.body, &body); err != nil {
+				t.Fatalf("decode body %s: %s", req.body, err)
+			}
+			tc.check(t, m, body)
+		})
+	}
+}
+
+func TestWebhookChannelSendError(t *testing.T) {
+	srv, _ := newWebhookServer(t, http.StatusBadRequest)
+	ch, err := newWebhookChannel("test", ChannelConfig{Type: "slack", URL: srv.URL + "/hooks/secret"})
+	if err != nil {
+		t.Fatalf("new channel: %s", err)
+	}
+	err = ch.Send(context.Background(), testMessage())
+	if err == nil {
+		t.Fatalf("expected an error on a 400 response")
+	}
+	if s := err.Error(); !strings.Contains(s, "400") || !strings.Contains(s, "some reason") {
+		t.Errorf("error %q, want the status and the response body", s)
+	}
+	if strings.Contains(err.Error(), "secret") {
+		t.Errorf("error %q reveals the url path", err)
+	}
+}
+
+func TestNewWebhookChannelInvalid(t *testing.T) {
+	cases := []ChannelConfig{
+		{Type: "webhook"},
+		{Type: "webhook", URL: "ftp://example.com"},
+		{Type: "webhook", URL: "http://example.com", Template: "{{ .Unclosed"},
+	}
+	for _, c := range cases {
+		if _, err := newWebhookChannel("test", c); err == nil {
+			t.Errorf("%+v: expected an error", c)
+		}
+	}
+}
@@ -5,11 +5,17 @@ package oc2websocket
 import (
 	"crypto/hmac"
 	"crypto/md5"
+	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"hash"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -25,25 +31,71 @@ type (
 		Url string
 		// Key is the sign key for pushed messages
 		Key []byte
+
+		// Algorithm is the HMAC hash used to sign pushed messages: sha256,
+		// sha512, or md5 for the legacy signature of the message only.
+		Algorithm string
 	}
 )
 
+const (
+	AlgorithmSHA256 = "sha256"
+	AlgorithmSHA512 = "sha512"
+	AlgorithmMD5    = "md5"
+
+	group = "generic"
+)
+
+// HashFunc returns the hash constructor for the signature algorithm name.
+func HashFunc(algorithm string) (func() hash.Hash, error) {
+	switch algorithm {
+	case AlgorithmSHA256, "":
+		return sha256.New, nil
+	case AlgorithmSHA512:
+		return sha512.New, nil
+	case AlgorithmMD5:
+		return md5.New, nil
+	default:
+		return nil, fmt.Errorf("unsupported signature algorithm: %s", algorithm)
+	}
+}
+
+// SignedPayload returns the bytes covered by a message signature:
+// "<group>\n<timestamp>\n<nonce>\n<message>", where timestamp is the unix
+// time in seconds.
+func SignedPayload(group, timestamp, nonce, message string) []byte {
+	return []byte(group + "\n" + timestamp + "\n" + nonce + "\n" + message)
+}
+
 func (s *T) pub(e *event) error {
 	b, err := json.Marshal(e)
 	if err != nil {
 		return err
 	}
-	h := hmac.New(md5.New, s.Key)
-	if _, err := h.Write(b); err != nil {
-		return err
-	}
-	sum := h.Sum(nil)
-	signature := hex.EncodeToString(sum)
-
 	params := url.Values{}
 	params.Add("message", string(b))
-	params.Add("signature", signature)
-	params.Add("group", "generic")
+	params.Add("group", group)
+	if s.Algorithm == AlgorithmMD5 {
+		h := hmac.New(md5.New, s.Key)
+		if _, err := h.Write(b); err != nil {
+			return err
+		}
+		params.Add("signature", hex.EncodeToString(h.Sum(nil)))
+	} else {
+		newHash, err := HashFunc(s.Algorithm)
+		if err != nil {
+			return err
+		}
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		nonce := uuid.NewString()
+		h := hmac.New(newHash, s.Key)
+		if _, err := h.Write(SignedPayload(group, timestamp, nonce, string(b))); err != nil {
+			return err
+		}
+		params.Add("timestamp", timestamp)
+		params.Add("nonce", nonce)
+		params.Add("signature", hex.EncodeToString(h.Sum(nil)))
+	}
 	resp, err := http.PostForm(s.Url, params)
 	if err != nil {
 		return err
@@ -0,0 +1,223 @@
+package runner
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/opensvc/oc3/cdb"
+)
+
+type (
+	// ActionParam describes a parameter accepted by an action verb.
+	ActionParam struct {
+		// Flag is the agent command flag rendered for the parameter
+		Flag string
+
+		// Bool parameters are rendered as a flag without value when true.
+		// Other parameters are strings validated by Pattern.
+		Bool bool
+
+		Pattern *regexp.Regexp
+	}
+
+	// ActionVerb describes an allowed action verb.
+	ActionVerb struct {
+		// V2 is the verb words for the opensvc v2 agent svcmgr and nodemgr
+		// commands. The v3 agent om command uses the same words unless V3
+		// is set.
+		V2 []string
+		V3 []string
+
+		Params map[string]ActionParam
+	}
+)
+
+var (
+	// the values can't start with a dash to not be parsed as a flag
+	reRID      = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9_#.,-]*$`)
+	reNodename = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9_.-]*$`)
+	reSvcname  = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9_./=-]*$`)
+
+	paramRID   = ActionParam{Flag: "--rid", Pattern: reRID}
+	paramForce = ActionParam{Flag: "--force", Bool: true}
+	paramLocal = ActionParam{Flag: "--local", Bool: true}
+	paramTo    = ActionParam{Flag: "--to", Pattern: reNodename}
+
+	// ObjectVerbs is the allow-list of the verbs of actions targeting an
+	// object instance.
+	ObjectVerbs = map[string]ActionVerb{
+		"start":       {V2: []string{"start"}, Params: map[string]ActionParam{"rid": paramRID, "local": paramLocal, "force": paramForce}},
+		"stop":        {V2: []string{"stop"}, Params: map[string]ActionParam{"rid": paramRID, "local": paramLocal, "force": paramForce}},
+		"restart":     {V2: []string{"restart"}, Params: map[string]ActionParam{"rid": paramRID, "local": paramLocal, "force": paramForce}},
+		"freeze":      {V2: []string{"freeze"}, Params: map[string]ActionParam{"local": paramLocal}},
+		"thaw":        {V2: []string{"thaw"}, Params: map[string]ActionParam{"local": paramLocal}},
+		"clear":       {V2: []string{"clear"}},
+		"enable":      {V2: []string{"enable"}, Params: map[string]ActionParam{"rid": paramRID}},
+		"disable":     {V2: []string{"disable"}, Params: map[string]ActionParam{"rid": paramRID}},
+		"switch":      {V2: []string{"switch"}, Params: map[string]ActionParam{"to": paramTo}},
+		"giveback":    {V2: []string{"giveback"}},
+		"provision":   {V2: []string{"provision"}, Params: map[string]ActionParam{"rid": paramRID, "local": paramLocal}},
+		"unprovision": {V2: []string{"unprovision"}, Params: map[string]ActionParam{"rid": paramRID, "local": paramLocal}},
+		"run":         {V2: []string{"run"}, Params: map[string]ActionParam{"rid": paramRID}},
+		"sync_all":    {V2: []string{"sync", "all"}, V3: []string{"sync", "update"}, Params: map[string]ActionParam{"rid": paramRID, "force": paramForce}},
+	}
+
+	// NodeVerbs is the allow-list of the verbs of actions targeting a node.
+	NodeVerbs = map[string]ActionVerb{
+		"pushasset": {V2: []string{"pushasset"}, V3: []string{"push", "asset"}},
+		"pushdisks": {V2: []string{"pushdisks"}, V3: []string{"push", "disks"}},
+		"pushpkg":   {V2: []string{"pushpkg"}, V3: []string{"push", "pkg"}},
+		"pushpatch": {V2: []string{"pushpatch"}, V3: []string{"push", "patch"}},
+		"pushstats": {V2: []string{"pushstats"}, V3: []string{"push", "stats"}},
+		"checks":    {V2: []string{"checks"}, V3: []string{"checks"}},
+		"sysreport": {V2: []string{"sysreport"}, V3: []string{"sysreport"}},
+		"freeze":    {V2: []string{"freeze"}, V3: []string{"freeze"}},
+		"thaw":      {V2: []string{"thaw"}, V3: []string{"thaw"}},
+	}
+)
+
+// ParseActionParams returns the action parameters from their json
+// representation. An empty string is a valid empty parameter set.
+func ParseActionParams(s string) (map[string]any, error) {
+	params := make(map[string]any)
+	if s == "" {
+		return params, nil
+	}
+	if err := json.Unmarshal([]byte(s), &params); err != nil {
+		return nil, fmt.Errorf("invalid action params: %w", err)
+	}
+	return params, nil
+}
+
+// ValidateAction verifies the verb is allowed for the target kind and the
+// parameters are accepted by the verb.
+func ValidateAction(verb string, isObject bool, params map[string]any) (ActionVerb, error) {
+	verbs, kind := NodeVerbs, "node"
+	if isObject {
+		verbs, kind = ObjectVerbs, "object"
+	}
+	v, ok := verbs[verb]
+	if !ok {
+		return v, fmt.Errorf("%s action verb not allowed: %s", kind, verb)
+	}
+	for name, value := range params {
+		p, ok := v.Params[name]
+		if !ok {
+			return v, fmt.Errorf("%s action %s: unknown param: %s", kind, verb, name)
+		}
+		if p.Bool {
+			if _, ok := value.(bool); !ok {
+				return v, fmt.Errorf("%s action %s: param %s: expect a boolean", kind, verb, name)
+			}
+			continue
+		}
+		s, ok := value.(string)
+		if !ok {
+			return v, fmt.Errorf("%s action %s: param %s: expect a string", kind, verb, name)
+		}
+		if !p.Pattern.MatchString(s) {
+			return v, fmt.Errorf("%s action %s: param %s: invalid value: %s", kind, verb, name, s)
+		}
+	}
+	return v, nil
+}
+
+// RenderAction returns the agent command argv of a structured action.
+//
+// The svcname is empty for node actions. The agentVersion selects the
+// svcmgr/nodemgr commands for the v2 agents, and the om command for the
+// others.
+func RenderAction(verb string, svcname string, agentVersion string, params map[string]any) ([]string, error) {
+	isObject := svcname != ""
+	v, err := ValidateAction(verb, isObject, params)
+	if err != nil {
+		return nil, err
+	}
+	if isObject && !reSvcname.MatchString(svcname) {
+		return nil, fmt.Errorf("invalid object name: %s", svcname)
+	}
+	isV2 := strings.HasPrefix(agentVersion, "2.")
+	var argv []string
+	switch {
+	case isObject && isV2:
+		argv = append([]string{"svcmgr", "-s", svcname}, v.V2...)
+	case isObject:
+		argv = append([]string{"om", svcname}, v.v3()...)
+	case isV2:
+		argv = append([]string{"nodemgr"}, v.V2...)
+	default:
+		argv = append([]string{"om", "node"}, v.v3()...)
+	}
+
+	// render flags in a stable order
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		p := v.Params[name]
+		switch value := params[name].(type) {
+		case bool:
+			if value {
+				argv = append(argv, p.Flag)
+			}
+		case string:
+			argv = append(argv, p.Flag, value)
+		}
+	}
+	return argv, nil
+}
+
+// renderEntry returns the argv of a structured action entry, or nil for a
+// free-form command entry allowed by the app policy.
+//
+// Free-form commands are allowed only for the apps listed in
+// runner.free_form.apps, "*" allowing all apps. The default empty list
+// enforces the structured actions.
+func renderEntry(e cdb.ActionQueueEntry) ([]string, error) {
+	if e.Action != nil && *e.Action != "" {
+		var params map[string]any
+		var svcname, agentVersion string
+		var err error
+		if e.ActionParams != nil {
+			if params, err = ParseActionParams(*e.ActionParams); err != nil {
+				return nil, err
+			}
+		}
+		if e.SvcId != "" {
+			if e.Svcname == nil || *e.Svcname == "" {
+				return nil, fmt.Errorf("object %s not found", e.SvcId)
+			}
+			svcname = *e.Svcname
+		}
+		if e.AgentVersion != nil {
+			agentVersion = *e.AgentVersion
+		}
+		return RenderAction(*e.Action, svcname, agentVersion, params)
+	}
+	if err := validateCommand(e.Command); err != nil {
+		return nil, err
+	}
+	apps := viper.GetStringSlice("runner.free_form.apps")
+	if slices.Contains(apps, "*") || (e.App != nil && slices.Contains(apps, *e.App)) {
+		return nil, nil
+	}
+	if e.App == nil {
+		return nil, fmt.Errorf("free-form command not allowed")
+	}
+	return nil, fmt.Errorf("free-form command not allowed for app %s", *e.App)
+}
+
+func (v ActionVerb) v3() []string {
+	if v.V3 != nil {
+		return v.V3
+	}
+	return v.V2
+}
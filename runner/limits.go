@@ -0,0 +1,134 @@
+package runner
+
+import (
+	"strconv"
+
+	"github.com/spf13/viper"
+
+	"github.com/opensvc/oc3/cdb"
+)
+
+type (
+	// inFlightLimits are the maximum numbers of actions dispatched and not
+	// yet terminated, by scope. A zero or negative value means unlimited.
+	inFlightLimits struct {
+		total   int
+		node    int
+		cluster int
+		user    int
+	}
+
+	// inFlight tracks the actions dispatched to the workers and not yet
+	// terminated.
+	inFlight struct {
+		total   int
+		node    map[string]int
+		cluster map[string]int
+		user    map[string]int
+
+		// keys is the scope keys of the dispatched actions, indexed by id
+		keys map[int]inFlightKeys
+	}
+
+	inFlightKeys struct {
+		node    string
+		cluster string
+		user    string
+	}
+)
+
+const (
+	scopeTotal   = "total"
+	scopeNode    = "node"
+	scopeCluster = "cluster"
+	scopeUser    = "user"
+)
+
+func newInFlightLimits(nbWorkers int) inFlightLimits {
+	return inFlightLimits{
+		// the worker pool is the hard limit, so the dispatch never blocks
+		total:   nbWorkers,
+		node:    viper.GetInt("runner.max_in_flight.node"),
+		cluster: viper.GetInt("runner.max_in_flight.cluster"),
+		user:    viper.GetInt("runner.max_in_flight.user"),
+	}
+}
+
+func newInFlight() *inFlight {
+	return &inFlight{
+		node:    make(map[string]int),
+		cluster: make(map[string]int),
+		user:    make(map[string]int),
+		keys:    make(map[int]inFlightKeys),
+	}
+}
+
+func newInFlightKeys(e cdb.ActionQueueEntry) inFlightKeys {
+	k := inFlightKeys{
+		node:    e.NodeId,
+		cluster: e.ClusterID,
+	}
+	if e.UserId != nil {
+		k.user = strconv.Itoa(*e.UserId)
+	}
+	return k
+}
+
+// admit returns true and records the action as in flight if no limit is
+// reached. Otherwise, it returns false and the scope of the reached limit.
+func (f *inFlight) admit(e cdb.ActionQueueEntry, l inFlightLimits) (string, bool) {
+	k := newInFlightKeys(e)
+	switch {
+	case f.total >= l.total:
+		return scopeTotal, false
+	case reached(f.node, k.node, l.node):
+		return scopeNode, false
+	case reached(f.cluster, k.cluster, l.cluster):
+		return scopeCluster, false
+	case reached(f.user, k.user, l.user):
+		return scopeUser, false
+	}
+	f.total++
+	incr(f.node, k.node)
+	incr(f.cluster, k.cluster)
+	incr(f.user, k.user)
+	f.keys[e.ID] = k
+	return "", true
+}
+
+// done forgets the terminated action id.
+func (f *inFlight) done(id int) {
+	k, ok := f.keys[id]
+	if !ok {
+		return
+	}
+	delete(f.keys, id)
+	f.total--
+	decr(f.node, k.node)
+	decr(f.cluster, k.cluster)
+	decr(f.user, k.user)
+}
+
+func reached(m map[string]int, key string, limit int) bool {
+	if key == "" || limit <= 0 {
+		return false
+	}
+	return m[key] >= limit
+}
+
+func incr(m map[string]int, key string) {
+	if key != "" {
+		m[key]++
+	}
+}
+
+func decr(m map[string]int, key string) {
+	if key == "" {
+		return
+	}
+	if m[key] <= 1 {
+		delete(m, key)
+	} else {
+		m[key]--
+	}
+}
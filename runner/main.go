@@ -1,14 +1,12 @@
 package runner
 
 import (
-	"bytes"
 	"context"
 	"database/sql"
-	"errors"
 	"fmt"
 	"log/slog"
 	"net"
-	"os/exec"
+	"strconv"
 	"strings"
 	"time"
 
@@ -38,9 +36,10 @@ type (
 	}
 
 	Worker struct {
-		dispatchC chan cdb.ActionQueueEntry
-		cmdC      chan any
-		ctx       context.Context
+		dispatchC  chan cdb.ActionQueueEntry
+		cmdC       chan any
+		ctx        context.Context
+		transports map[string]Transport
 	}
 
 	cmdSetUnreachable struct {
@@ -84,6 +83,10 @@ const (
 	DefaultNotificationTimeout = 5 * time.Second
 	DefaultCommandTimeout      = 10 * time.Second
 	DefaultPurgeTimeout        = 24 * time.Hour
+
+	// columnsRefreshInterval is the interval between the checks of the
+	// optional action_queue columns existence
+	columnsRefreshInterval = time.Minute
 )
 
 var (
@@ -129,6 +132,20 @@ var (
 			Name:      "db_requests_total",
 			Help:      "Total number of database requests by operation",
 		}, []string{"op"})
+	queueDepth = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "oc3",
+			Subsystem: "runner",
+			Name:      "queue_depth",
+			Help:      "Number of waiting actions held back by the last poll, by reached in-flight limit scope",
+		}, []string{"scope"})
+	actionInFlight = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "oc3",
+			Subsystem: "runner",
+			Name:      "action_in_flight",
+			Help:      "Number of actions dispatched to the workers and not yet terminated",
+		})
 )
 
 func (d *ActionDaemon) Run() error {
@@ -137,11 +154,15 @@ func (d *ActionDaemon) Run() error {
 	odb := cdb.New(d.DB)
 	dispatchC := make(chan cdb.ActionQueueEntry)
 	cmdC := make(chan any)
+	transports := newTransports(d.Ctx)
+	limits := newInFlightLimits(nbWorkers)
+	running := newInFlight()
 	for i := 0; i < nbWorkers; i++ {
 		w := Worker{
-			dispatchC: dispatchC,
-			cmdC:      cmdC,
-			ctx:       d.Ctx,
+			dispatchC:  dispatchC,
+			cmdC:       cmdC,
+			ctx:        d.Ctx,
+			transports: transports,
 		}
 		go w.Run()
 	}
@@ -156,8 +177,30 @@ func (d *ActionDaemon) Run() error {
 
 	purgeTicker := time.NewTicker(purgeTimeout)
 
-	var nowErrorLogger, setDequeuedToNowErrorLogger, getQueuedErrorLogger dedupLog
+	var (
+		cols          cdb.ActionQueueColumns
+		colsCheckedAt time.Time
+	)
+
+	var nowErrorLogger, setDequeuedToNowErrorLogger, getQueuedErrorLogger, columnsErrorLogger dedupLog
 	pollWaitingActions := func() {
+		// check the optional action_queue columns, so they are read as soon
+		// as the database schema is upgraded
+		if time.Since(colsCheckedAt) > columnsRefreshInterval {
+			c, err := odb.ActionQColumns(d.Ctx)
+			dbRequests.WithLabelValues("columns").Inc()
+			if err != nil {
+				columnsErrorLogger.warnf("check action queue columns: %s", err)
+				dbErrors.WithLabelValues("columns").Inc()
+				if colsCheckedAt.IsZero() {
+					return
+				}
+			} else {
+				columnsErrorLogger.reset()
+				cols, colsCheckedAt = c, time.Now()
+			}
+		}
+
 		// define SQL @now as the time we start processing the queue
 		err := odb.ActionQSetNow(d.Ctx)
 		dbRequests.WithLabelValues("set_now").Inc()
@@ -179,7 +222,7 @@ func (d *ActionDaemon) Run() error {
 		setDequeuedToNowErrorLogger.reset()
 
 		// fetch all actions with type push or pull marked as dequeued at @now
-		lines, err := odb.ActionQPushPullGetQueued(d.Ctx)
+		lines, err := odb.ActionQPushPullGetQueued(d.Ctx, cols)
 		dbRequests.WithLabelValues("get_queued").Inc()
 		if err != nil {
 			getQueuedErrorLogger.warnf("get queued: %s", err)
@@ -189,13 +232,35 @@ func (d *ActionDaemon) Run() error {
 		queueQueued.Add(float64(len(lines)))
 		getQueuedErrorLogger.reset()
 
-		// dispatch each action push or pull to a worker
+		// dispatch each action push or pull to a worker, unless an in-flight
+		// limit is reached
+		var deferredIds []int
+		depth := map[string]int{scopeTotal: 0, scopeNode: 0, scopeCluster: 0, scopeUser: 0}
 		for _, line := range lines {
 			switch line.ActionType {
 			case cdb.ActionQTypePull, cdb.ActionQTypePush:
+				if scope, ok := running.admit(line, limits); !ok {
+					deferredIds = append(deferredIds, line.ID)
+					depth[scope]++
+					continue
+				}
 				dispatchC <- line
 			}
 		}
+		for scope, n := range depth {
+			queueDepth.WithLabelValues(scope).Set(float64(n))
+		}
+		actionInFlight.Set(float64(running.total))
+
+		// keep the deferred actions waiting for the next poll
+		if len(deferredIds) > 0 {
+			err := odb.ActionQResetDequeued(d.Ctx, deferredIds)
+			dbRequests.WithLabelValues("reset_dequeued").Inc()
+			if err != nil {
+				slog.Warn(fmt.Sprintf("reset dequeued: %s", err))
+				dbErrors.WithLabelValues("reset_dequeued").Inc()
+			}
+		}
 	}
 	for {
 		select {
@@ -206,14 +271,17 @@ func (d *ActionDaemon) Run() error {
 			case cmdSetUnreachable:
 				c := cmd.(cmdSetUnreachable)
 				d.unreachableIds = append(d.unreachableIds, c.id)
+				running.done(c.id)
 				actionProcessed.WithLabelValues(c.actionType, "unreachable").Inc()
 			case cmdSetNotified:
 				c := cmd.(cmdSetNotified)
 				d.nIds = append(d.nIds, c.id)
+				running.done(c.id)
 				actionProcessed.WithLabelValues(c.actionType, "notified").Inc()
 			case cmdSetInvalid:
 				c := cmd.(cmdSetInvalid)
 				d.invalidIds = append(d.invalidIds, c.id)
+				running.done(c.id)
 				actionProcessed.WithLabelValues(c.actionType, "invalid").Inc()
 			case cmdSetRunning:
 				c := cmd.(cmdSetRunning)
@@ -221,6 +289,7 @@ func (d *ActionDaemon) Run() error {
 			case cmdSetDone:
 				c := cmd.(cmdSetDone)
 				d.doneEntries = append(d.doneEntries, c)
+				running.done(c.id)
 				result := "success"
 				if c.ret != 0 {
 					result = "failure"
@@ -335,18 +404,19 @@ func (w *Worker) Run() {
 }
 
 func (w *Worker) work(e cdb.ActionQueueEntry) error {
-	if err := w.validateCommand(e.Command); err != nil {
-		w.cmdC <- cmdSetInvalid{
-			id:         e.ID,
-			actionType: e.ActionType,
-		}
-		return fmt.Errorf("invalid command: %s", err)
-	}
 	switch e.ActionType {
 	case cdb.ActionQTypePull:
 		w.workPull(e)
 	case cdb.ActionQTypePush:
-		w.workPush(e)
+		argv, err := renderEntry(e)
+		if err != nil {
+			w.cmdC <- cmdSetInvalid{
+				id:         e.ID,
+				actionType: e.ActionType,
+			}
+			return fmt.Errorf("invalid action %d: %s", e.ID, err)
+		}
+		w.workPush(e, argv)
 	case cdb.ActionQTypeFeed:
 		return fmt.Errorf("unexpected action type: %s", e.ActionType)
 	default:
@@ -356,7 +426,7 @@ func (w *Worker) work(e cdb.ActionQueueEntry) error {
 }
 
 func notifyNode(nodename string, port int) error {
-	addr := fmt.Sprintf("%s:%d", nodename, port)
+	addr := net.JoinHostPort(nodename, strconv.Itoa(port))
 	conn, err := net.DialTimeout("tcp", addr, time.Second)
 	if err != nil {
 		return err
@@ -407,16 +477,24 @@ func (w *Worker) workPull(e cdb.ActionQueueEntry) {
 	}
 }
 
-func (w *Worker) workPush(e cdb.ActionQueueEntry) {
+func (w *Worker) workPush(e cdb.ActionQueueEntry, argv []string) {
 	actionInProgress.WithLabelValues(cdb.ActionQTypePush).Inc()
 	w.cmdC <- cmdSetRunning{
 		id: e.ID,
 	}
 
-	stdout, stderr, returnCode := executeCommand(w.ctx, e.Command)
+	var stdout, stderr string
+	var returnCode int
+	name := transportName(e)
+	if transport, ok := w.transports[name]; ok {
+		stdout, stderr, returnCode = transport.Execute(w.ctx, e, argv)
+	} else {
+		stderr, returnCode = fmt.Sprintf("unknown transport: %s", name), 1
+	}
 
 	slog.Debug("command executed",
 		"action_id", e.ID,
+		"transport", name,
 		"return_code", returnCode,
 		"stdout_len", len(stdout),
 		"stderr_len", len(stderr),
@@ -433,7 +511,7 @@ func (w *Worker) workPush(e cdb.ActionQueueEntry) {
 	actionPullReturnCode.WithLabelValues(fmt.Sprintf("%d", returnCode)).Inc()
 }
 
-func (w *Worker) validateCommand(cmd string) error {
+func validateCommand(cmd string) error {
 	invalid := []string{
 		"opensvc@localhost",
 		"opensvc@localhost.localdomain",
@@ -452,35 +530,6 @@ func (w *Worker) validateCommand(cmd string) error {
 	return nil
 }
 
-func executeCommand(ctx context.Context, cmd string) (string, string, int) {
-	cmdTimeout := getOptionDuration("runner.command_timeout", DefaultCommandTimeout)
-
-	ctx, cancel := context.WithTimeout(ctx, cmdTimeout)
-	defer cancel()
-
-	args := strings.Fields(cmd)
-
-	command := exec.CommandContext(ctx, args[0], args[1:]...)
-
-	var stdout, stderr bytes.Buffer
-	command.Stdout = &stdout
-	command.Stderr = &stderr
-
-	err := command.Run()
-
-	switch {
-	case err == nil:
-		return stdout.String(), stderr.String(), 0
-	case errors.Is(ctx.Err(), context.DeadlineExceeded):
-		return stdout.String(), "command timeout", 1
-	default:
-		if exitErr, ok := err.(*exec.ExitError); ok {
-			return stdout.String(), stderr.String(), exitErr.ExitCode()
-		}
-		return stdout.String(), err.Error(), 1
-	}
-}
-
 func (d *dedupLog) warnf(format string, args ...any) {
 	if !d.notified {
 		slog.Warn(fmt.Sprintf(format, args...))
@@ -0,0 +1,217 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/opensvc/oc3/cdb"
+)
+
+type (
+	// Transport executes a push action and returns its stdout, stderr and
+	// return code.
+	//
+	// argv is the rendered command of a structured action, to execute on
+	// the action node. It is nil for a free-form action, whose command is
+	// executed as is.
+	Transport interface {
+		Name() string
+		Execute(ctx context.Context, e cdb.ActionQueueEntry, argv []string) (string, string, int)
+	}
+
+	// LocalTransport executes the action command on the runner host.
+	LocalTransport struct {
+		MaxOutputSize int
+	}
+
+	// cappedBuffer is a bytes.Buffer that silently discards the bytes written
+	// after max, so a verbose command can't exhaust the runner memory.
+	cappedBuffer struct {
+		bytes.Buffer
+		max       int
+		truncated bool
+	}
+)
+
+const (
+	TransportLocal = "local"
+	TransportSSH   = "ssh"
+
+	DefaultMaxOutputSize = 1024 * 1024
+)
+
+// newTransports returns the transports available to the workers, indexed by
+// name. The ssh transport idle connections are closed until ctx is done.
+func newTransports(ctx context.Context) map[string]Transport {
+	maxOutputSize := getOptionInt("runner.max_output_size", DefaultMaxOutputSize)
+	sshTransport := newSSHTransport(maxOutputSize)
+	go sshTransport.reapIdle(ctx)
+	return map[string]Transport{
+		TransportLocal: &LocalTransport{MaxOutputSize: maxOutputSize},
+		TransportSSH:   sshTransport,
+	}
+}
+
+// transportName returns the transport name to use for the action entry.
+//
+// The entry transport takes precedence over the runner.transport.apps.<app>
+// setting, which takes precedence over the runner.transport.default setting.
+func transportName(e cdb.ActionQueueEntry) string {
+	if e.Transport != nil && *e.Transport != "" {
+		return *e.Transport
+	}
+	if e.App != nil && *e.App != "" {
+		if name := viper.GetString("runner.transport.apps." + *e.App); name != "" {
+			return name
+		}
+	}
+	if name := viper.GetString("runner.transport.default"); name != "" {
+		return name
+	}
+	return TransportLocal
+}
+
+func (t *LocalTransport) Name() string { return TransportLocal }
+
+// Execute runs the free-form command on the runner host, and structured
+// actions on the action node through the ssh client command.
+func (t *LocalTransport) Execute(ctx context.Context, e cdb.ActionQueueEntry, argv []string) (string, string, int) {
+	cmdTimeout := getOptionDuration("runner.command_timeout", DefaultCommandTimeout)
+
+	ctx, cancel := context.WithTimeout(ctx, cmdTimeout)
+	defer cancel()
+
+	var args []string
+	var err error
+	if argv != nil {
+		target, err := newSSHTarget(e, argv)
+		if err != nil {
+			return "", err.Error(), 1
+		}
+		args = []string{"ssh", "-o", "BatchMode=yes", "-p", target.port, "-l", target.user}
+		if target.key != "" {
+			args = append(args, "-i", target.key)
+		}
+		args = append(args, target.host, target.command)
+	} else if args, err = splitCommand(e.Command); err != nil {
+		return "", err.Error(), 1
+	}
+
+	command := exec.CommandContext(ctx, args[0], args[1:]...)
+
+	stdout := newCappedBuffer(t.MaxOutputSize)
+	stderr := newCappedBuffer(t.MaxOutputSize)
+	command.Stdout = stdout
+	command.Stderr = stderr
+
+	err = command.Run()
+
+	switch {
+	case err == nil:
+		return stdout.String(), stderr.String(), 0
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return stdout.String(), "command timeout", 1
+	default:
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return stdout.String(), stderr.String(), exitErr.ExitCode()
+		}
+		return stdout.String(), err.Error(), 1
+	}
+}
+
+func newCappedBuffer(max int) *cappedBuffer {
+	return &cappedBuffer{max: max}
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	n := len(p)
+	if room := b.max - b.Buffer.Len(); room < n {
+		b.truncated = true
+		if room <= 0 {
+			return n, nil
+		}
+		p = p[:room]
+	}
+	b.Buffer.Write(p)
+	return n, nil
+}
+
+func (b *cappedBuffer) String() string {
+	if b.truncated {
+		return b.Buffer.String() + "\n[truncated]"
+	}
+	return b.Buffer.String()
+}
+
+// splitCommand splits a command line into words, honoring single quotes,
+// double quotes and backslash escapes like a posix shell does.
+func splitCommand(s string) ([]string, error) {
+	var (
+		args    []string
+		word    strings.Builder
+		inWord  bool
+		quote   rune
+		escaped bool
+	)
+	for _, r := range s {
+		switch {
+		case escaped:
+			word.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inWord = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inWord = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				args = append(args, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+	if escaped {
+		return nil, fmt.Errorf("trailing backslash")
+	}
+	if inWord {
+		args = append(args, word.String())
+	}
+	if len(args) == 0 {
+		return nil, fmt.Errorf("empty command")
+	}
+	return args, nil
+}
+
+// QuoteCommand joins args in a command line the remote shell splits back
+// to the same words.
+func QuoteCommand(args []string) string {
+	l := make([]string, len(args))
+	for i, arg := range args {
+		if arg != "" && !strings.ContainsAny(arg, " \t\n'\"\\$`;&|<>()*?[]#~!{}") {
+			l[i] = arg
+			continue
+		}
+		l[i] = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+	}
+	return strings.Join(l, " ")
+}
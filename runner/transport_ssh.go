@@ -0,0 +1,428 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"github.com/opensvc/oc3/cdb"
+)
+
+type (
+	// SSHTransport executes the action command on the target node through
+	// a native ssh client.
+	//
+	// Host keys are verified against the runner.ssh.known_hosts file. The
+	// private key is selected by the action app with runner.ssh.keys.<app>,
+	// falling back to runner.ssh.key. Connections are reused by the
+	// following actions on the same node, and closed when idle for
+	// runner.ssh.idle_timeout.
+	SSHTransport struct {
+		MaxOutputSize int
+
+		mu              sync.Mutex
+		conns           map[string]*sshConn
+		signers         map[string]ssh.Signer
+		hostKeyCallback ssh.HostKeyCallback
+	}
+
+	sshConn struct {
+		client   *ssh.Client
+		lastUsed time.Time
+
+		// sessions is the number of sessions running on the connection
+		sessions int
+	}
+
+	// sshTarget is the remote side of a push action
+	sshTarget struct {
+		user    string
+		host    string
+		port    string
+		command string
+
+		// key is the private key path set by the -i flag of a ssh client
+		// command line, empty to use the app or default key.
+		key string
+	}
+)
+
+const (
+	DefaultSSHUser           = "opensvc"
+	DefaultSSHPort           = "22"
+	DefaultSSHConnectTimeout = 5 * time.Second
+	DefaultSSHIdleTimeout    = 5 * time.Minute
+)
+
+// sshFlagsWithArg are the ssh client flags consuming the next argument.
+const sshFlagsWithArg = "BbcDEeFIiJLlmOoPpQRSWw"
+
+var (
+	// sshFlagsIgnored are the ssh client flags of the queued command lines
+	// the native transport behaves as if set: no agent or X11 forwarding,
+	// no tty, no stdin, quiet, ipv4 or ipv6.
+	sshFlagsIgnored = []string{"-4", "-6", "-a", "-n", "-q", "-T", "-x"}
+
+	// sshOptionsIgnored are the ssh client -o options of the queued command
+	// lines the native transport behaves as if set: batch mode, host key
+	// always verified against runner.ssh.known_hosts, no agent forwarding.
+	sshOptionsIgnored = []string{"batchmode", "stricthostkeychecking", "forwardagent", "loglevel"}
+)
+
+func newSSHTransport(maxOutputSize int) *SSHTransport {
+	return &SSHTransport{
+		MaxOutputSize: maxOutputSize,
+		conns:         make(map[string]*sshConn),
+		signers:       make(map[string]ssh.Signer),
+	}
+}
+
+func (t *SSHTransport) Name() string { return TransportSSH }
+
+func (t *SSHTransport) Execute(ctx context.Context, e cdb.ActionQueueEntry, argv []string) (string, string, int) {
+	cmdTimeout := getOptionDuration("runner.command_timeout", DefaultCommandTimeout)
+
+	ctx, cancel := context.WithTimeout(ctx, cmdTimeout)
+	defer cancel()
+
+	target, err := newSSHTarget(e, argv)
+	if err != nil {
+		return "", err.Error(), 1
+	}
+	keyPath := viper.GetString("runner.ssh.key")
+	if e.App != nil {
+		if s := viper.GetString("runner.ssh.keys." + *e.App); s != "" {
+			keyPath = s
+		}
+	}
+	if target.key != "" {
+		keyPath = target.key
+	}
+
+	session, release, err := t.newSession(ctx, target, keyPath)
+	if err != nil {
+		return "", fmt.Sprintf("ssh %s@%s: %s", target.user, target.host, err), 1
+	}
+	defer release()
+	defer func() { _ = session.Close() }()
+
+	stdout := newCappedBuffer(t.MaxOutputSize)
+	stderr := newCappedBuffer(t.MaxOutputSize)
+	session.Stdout = stdout
+	session.Stderr = stderr
+
+	errC := make(chan error, 1)
+	go func() {
+		errC <- session.Run(target.command)
+	}()
+
+	select {
+	case <-ctx.Done():
+		_ = session.Signal(ssh.SIGKILL)
+		_ = session.Close()
+		<-errC
+		return stdout.String(), "command timeout", 1
+	case err = <-errC:
+	}
+
+	var exitErr *ssh.ExitError
+	switch {
+	case err == nil:
+		return stdout.String(), stderr.String(), 0
+	case errors.As(err, &exitErr):
+		return stdout.String(), stderr.String(), exitErr.ExitStatus()
+	default:
+		return stdout.String(), err.Error(), 1
+	}
+}
+
+// newSession returns a new session on a cached or new connection to the
+// target, and the func to call when the session is closed. A cached
+// connection failing to open a session is replaced.
+func (t *SSHTransport) newSession(ctx context.Context, target sshTarget, keyPath string) (*ssh.Session, func(), error) {
+	connKey := target.user + "@" + net.JoinHostPort(target.host, target.port) + "|" + keyPath
+	if client := t.cachedClient(connKey); client != nil {
+		if session, err := client.NewSession(); err == nil {
+			return session, func() { t.releaseClient(connKey, client) }, nil
+		}
+		t.dropClient(connKey, client)
+	}
+	client, err := t.dial(ctx, target, keyPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	session, err := client.NewSession()
+	if err != nil {
+		_ = client.Close()
+		return nil, nil, err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.conns[connKey]; ok {
+		// a concurrent action already cached a connection to this node,
+		// keep it and close ours when the session ends.
+		return session, func() { _ = client.Close() }, nil
+	}
+	t.conns[connKey] = &sshConn{client: client, lastUsed: time.Now(), sessions: 1}
+	return session, func() { t.releaseClient(connKey, client) }, nil
+}
+
+// cachedClient returns the cached client for key, accounting a new session
+// on it.
+func (t *SSHTransport) cachedClient(key string) *ssh.Client {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	c, ok := t.conns[key]
+	if !ok {
+		return nil
+	}
+	c.sessions++
+	c.lastUsed = time.Now()
+	return c.client
+}
+
+// releaseClient accounts the end of a session on the cached client.
+func (t *SSHTransport) releaseClient(key string, client *ssh.Client) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if c, ok := t.conns[key]; ok && c.client == client {
+		c.sessions--
+		c.lastUsed = time.Now()
+	}
+}
+
+// reapIdle closes the cached connections without session for more than
+// runner.ssh.idle_timeout, until ctx is done.
+func (t *SSHTransport) reapIdle(ctx context.Context) {
+	idleTimeout := getOptionDuration("runner.ssh.idle_timeout", DefaultSSHIdleTimeout)
+	ticker := time.NewTicker(max(idleTimeout/2, time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			t.mu.Lock()
+			for k, c := range t.conns {
+				_ = c.client.Close()
+				delete(t.conns, k)
+			}
+			t.mu.Unlock()
+			return
+		case now := <-ticker.C:
+			t.mu.Lock()
+			for k, c := range t.conns {
+				if c.sessions <= 0 && now.Sub(c.lastUsed) > idleTimeout {
+					_ = c.client.Close()
+					delete(t.conns, k)
+				}
+			}
+			t.mu.Unlock()
+		}
+	}
+}
+
+func (t *SSHTransport) dropClient(key string, client *ssh.Client) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if c, ok := t.conns[key]; ok && c.client == client {
+		delete(t.conns, key)
+	}
+	_ = client.Close()
+}
+
+func (t *SSHTransport) dial(ctx context.Context, target sshTarget, keyPath string) (*ssh.Client, error) {
+	connectTimeout := getOptionDuration("runner.ssh.connect_timeout", DefaultSSHConnectTimeout)
+	hostKeyCallback, err := t.getHostKeyCallback()
+	if err != nil {
+		return nil, err
+	}
+	signer, err := t.getSigner(keyPath)
+	if err != nil {
+		return nil, err
+	}
+	config := &ssh.ClientConfig{
+		User:            target.user,
+		Auth:            []ssh.AuthMethod{ssh.PublicKeys(signer)},
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         connectTimeout,
+	}
+	addr := net.JoinHostPort(target.host, target.port)
+	dialer := net.Dialer{Timeout: connectTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	if err := conn.SetDeadline(time.Now().Add(connectTimeout)); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	c, chans, reqs, err := ssh.NewClientConn(conn, addr, config)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+	return ssh.NewClient(c, chans, reqs), nil
+}
+
+func (t *SSHTransport) getHostKeyCallback() (ssh.HostKeyCallback, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.hostKeyCallback != nil {
+		return t.hostKeyCallback, nil
+	}
+	filename := viper.GetString("runner.ssh.known_hosts")
+	if filename == "" {
+		return nil, fmt.Errorf("runner.ssh.known_hosts is not set")
+	}
+	cb, err := knownhosts.New(filename)
+	if err != nil {
+		return nil, fmt.Errorf("load known hosts: %w", err)
+	}
+	t.hostKeyCallback = cb
+	return cb, nil
+}
+
+func (t *SSHTransport) getSigner(keyPath string) (ssh.Signer, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if signer, ok := t.signers[keyPath]; ok {
+		return signer, nil
+	}
+	if keyPath == "" {
+		return nil, fmt.Errorf("runner.ssh.key is not set")
+	}
+	b, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("read private key: %w", err)
+	}
+	signer, err := ssh.ParsePrivateKey(b)
+	if err != nil {
+		return nil, fmt.Errorf("parse private key %s: %w", keyPath, err)
+	}
+	t.signers[keyPath] = signer
+	return signer, nil
+}
+
+// newSSHTarget returns the remote user, host and command of the action.
+//
+// Structured actions argv and free-form commands not starting with ssh are
+// executed on the action node connect_to address, or its fqdn. Free-form
+// commands queued as a ssh client command line, like "ssh opensvc@node om
+// svc1 restart", are executed on the destination of the command line, with
+// the user, port and key of the -l, -p, -i, -o User= and -o Port= flags.
+// The other flags are rejected, unless the native transport already
+// behaves as if they were set.
+func newSSHTarget(e cdb.ActionQueueEntry, argv []string) (sshTarget, error) {
+	target := sshTarget{
+		user: viper.GetString("runner.ssh.user"),
+		port: viper.GetString("runner.ssh.port"),
+	}
+	if target.user == "" {
+		target.user = DefaultSSHUser
+	}
+	if target.port == "" {
+		target.port = DefaultSSHPort
+	}
+	var args []string
+	if argv == nil {
+		var err error
+		if args, err = splitCommand(e.Command); err != nil {
+			return target, err
+		}
+	}
+	if argv != nil || filepath.Base(args[0]) != "ssh" {
+		switch {
+		case e.ConnectTo != nil && *e.ConnectTo != "":
+			target.host = *e.ConnectTo
+		case e.Fqdn != nil && *e.Fqdn != "":
+			target.host = *e.Fqdn
+		default:
+			return target, fmt.Errorf("no address to connect to")
+		}
+		if argv != nil {
+			target.command = QuoteCommand(argv)
+		} else {
+			target.command = e.Command
+		}
+		return target, nil
+	}
+	setPort := func(flag, s string) error {
+		if _, err := strconv.Atoi(s); err != nil {
+			return fmt.Errorf("ssh flag %s: %w", flag, err)
+		}
+		target.port = s
+		return nil
+	}
+	i := 1
+	for ; i < len(args) && strings.HasPrefix(args[i], "-"); i++ {
+		flag := args[i]
+		if len(flag) != 2 || !strings.Contains(sshFlagsWithArg, flag[1:]) {
+			if !slices.Contains(sshFlagsIgnored, flag) {
+				return target, fmt.Errorf("ssh flag %s: not supported", flag)
+			}
+			continue
+		}
+		if i+1 >= len(args) {
+			return target, fmt.Errorf("ssh flag %s: missing value", flag)
+		}
+		i++
+		switch flag {
+		case "-l":
+			target.user = args[i]
+		case "-p":
+			if err := setPort(flag, args[i]); err != nil {
+				return target, err
+			}
+		case "-i":
+			target.key = args[i]
+		case "-o":
+			k, v, _ := strings.Cut(args[i], "=")
+			switch k = strings.ToLower(strings.TrimSpace(k)); {
+			case k == "user":
+				target.user = v
+			case k == "port":
+				if err := setPort("-o Port", v); err != nil {
+					return target, err
+				}
+			case k == "identityfile":
+				target.key = v
+			case slices.Contains(sshOptionsIgnored, k):
+			default:
+				return target, fmt.Errorf("ssh option %s: not supported", args[i])
+			}
+		default:
+			return target, fmt.Errorf("ssh flag %s: not supported", flag)
+		}
+	}
+	if i >= len(args) {
+		return target, fmt.Errorf("ssh command: missing destination")
+	}
+	destination := args[i]
+	if user, host, ok := strings.Cut(destination, "@"); ok {
+		target.user = user
+		destination = host
+	}
+	target.host = destination
+	if i+1 >= len(args) {
+		return target, fmt.Errorf("ssh command: missing remote command")
+	}
+	// quote the remote command words, so the remote shell splits them back
+	// as split from the queued command line
+	target.command = QuoteCommand(args[i+1:])
+	return target, nil
+}
@@ -0,0 +1,441 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/spf13/viper"
+
+	"github.com/opensvc/oc3/cachekeys"
+)
+
+type (
+	// locker acquires leases on names shared by the scheduler replicas.
+	locker interface {
+		// tryLock returns a lease on name, or nil if the name is held by
+		// another replica.
+		tryLock(ctx context.Context, name string) (lease, error)
+	}
+
+	// lease is a lock held by this replica until released or expired.
+	lease interface {
+		// keep extends the lease for another lease time. It returns
+		// errLeaseLost if the lease expired and was taken by another replica,
+		// or another error if the lease state can't be verified.
+		keep(ctx context.Context) error
+
+		release(ctx context.Context)
+	}
+
+	// redisLocker implements locker with redis keys set with a ttl of the
+	// lease time. The key value identifies the holder, so a replica can't
+	// extend or release a lease taken over by another one.
+	redisLocker struct {
+		client    *redis.Client
+		leaseTime time.Duration
+	}
+
+	redisLease struct {
+		client    *redis.Client
+		key       string
+		token     string
+		leaseTime time.Duration
+	}
+
+	// mysqlLocker implements locker with the MariaDB GET_LOCK named locks.
+	//
+	// A named lock is owned by a connection, so each lease holds a dedicated
+	// connection out of the pool. The connection wait_timeout is set to the
+	// lease time, so the server releases the lock of a replica unable to
+	// keep its lease, like a partitioned one.
+	//
+	// The cdb.DBLocker can't be used here, as it only serializes the
+	// goroutines of a single process.
+	mysqlLocker struct {
+		db        *sql.DB
+		leaseTime time.Duration
+	}
+
+	mysqlLease struct {
+		conn *sql.Conn
+		name string
+	}
+)
+
+const (
+	// ElectionNone runs all the tasks on every scheduler replica.
+	ElectionNone = "none"
+
+	// ElectionLeader runs all the tasks on the leader replica only.
+	ElectionLeader = "leader"
+
+	// ElectionTask runs each task on the replica acquiring the task lock.
+	ElectionTask = "task"
+
+	BackendMySQL = "mysql"
+	BackendRedis = "redis"
+
+	DefaultLeaseTime = 15 * time.Second
+
+	// mysqlLockPrefix prefixes the GET_LOCK names, which can't exceed 64
+	// characters.
+	mysqlLockPrefix = "oc3_scheduler_"
+
+	// minTaskLockRetry is the minimum delay before retrying to lock a task
+	minTaskLockRetry = 5 * time.Second
+)
+
+var (
+	errLeaseLost = errors.New("lease lost")
+
+	// redisKeepScript extends the lease only if still held by the caller
+	redisKeepScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("pexpire", KEYS[1], ARGV[2])
+end
+return 0`)
+
+	// redisReleaseScript deletes the lease only if still held by the caller
+	redisReleaseScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+end
+return 0`)
+
+	leaderGauge = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "oc3",
+			Subsystem: "scheduler",
+			Name:      "leader",
+			Help:      "1 if this scheduler replica is the leader, 0 otherwise.",
+		},
+	)
+
+	leaderTransitionCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "oc3",
+			Subsystem: "scheduler",
+			Name:      "leader_transition_count",
+			Help:      "Leadership transition counter",
+		},
+		[]string{"event"},
+	)
+
+	taskLockSkipCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "oc3",
+			Subsystem: "scheduler",
+			Name:      "task_lock_skip_count",
+			Help:      "Task executions skipped because another replica holds the task lock",
+		},
+		[]string{"desc"},
+	)
+)
+
+// electionMode returns the scheduler.election.mode setting, defaulting to
+// ElectionNone.
+func electionMode() (string, error) {
+	switch mode := viper.GetString("scheduler.election.mode"); mode {
+	case "", ElectionNone:
+		return ElectionNone, nil
+	case ElectionLeader, ElectionTask:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid scheduler.election.mode: %s", mode)
+	}
+}
+
+// leaseTime returns the scheduler.election.lease setting. It is the maximum
+// time for a standby replica to take over the tasks of a failed one.
+func leaseTime() time.Duration {
+	if d := viper.GetDuration("scheduler.election.lease"); d > 0 {
+		return d
+	}
+	return DefaultLeaseTime
+}
+
+// newLocker returns the locker of the scheduler.election.backend setting.
+func newLocker(db *sql.DB, client *redis.Client) (locker, error) {
+	switch backend := viper.GetString("scheduler.election.backend"); backend {
+	case "", BackendMySQL:
+		if db == nil {
+			return nil, fmt.Errorf("election backend %s: db is nil", BackendMySQL)
+		}
+		return &mysqlLocker{db: db, leaseTime: leaseTime()}, nil
+	case BackendRedis:
+		if client == nil {
+			return nil, fmt.Errorf("election backend %s: redis client is nil", BackendRedis)
+		}
+		return &redisLocker{client: client, leaseTime: leaseTime()}, nil
+	default:
+		return nil, fmt.Errorf("invalid scheduler.election.backend: %s", backend)
+	}
+}
+
+func (l *redisLocker) tryLock(ctx context.Context, name string) (lease, error) {
+	hostname, _ := os.Hostname()
+	le := &redisLease{
+		client:    l.client,
+		key:       name,
+		token:     fmt.Sprintf("%s:%d:%s", hostname, os.Getpid(), uuid.NewString()),
+		leaseTime: l.leaseTime,
+	}
+	ok, err := l.client.SetNX(ctx, le.key, le.token, l.leaseTime).Result()
+	if err != nil {
+		return nil, fmt.Errorf("lock %s: %w", name, err)
+	}
+	if !ok {
+		return nil, nil
+	}
+	return le, nil
+}
+
+func (le *redisLease) keep(ctx context.Context) error {
+	n, err := redisKeepScript.Run(ctx, le.client, []string{le.key}, le.token, le.leaseTime.Milliseconds()).Int()
+	if err != nil {
+		return fmt.Errorf("keep lock %s: %w", le.key, err)
+	}
+	if n == 0 {
+		return errLeaseLost
+	}
+	return nil
+}
+
+func (le *redisLease) release(ctx context.Context) {
+	_ = redisReleaseScript.Run(ctx, le.client, []string{le.key}, le.token).Err()
+}
+
+func (l *mysqlLocker) tryLock(ctx context.Context, name string) (lease, error) {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("lock %s: %w", name, err)
+	}
+	timeout := int(l.leaseTime.Seconds())
+	if timeout < 1 {
+		timeout = 1
+	}
+	if _, err := conn.ExecContext(ctx, "SET SESSION wait_timeout = ?", timeout); err != nil {
+		discardConn(conn)
+		return nil, fmt.Errorf("lock %s: set wait_timeout: %w", name, err)
+	}
+	var ok sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, 0)", name).Scan(&ok); err != nil {
+		discardConn(conn)
+		return nil, fmt.Errorf("lock %s: %w", name, err)
+	}
+	if !ok.Valid || ok.Int64 != 1 {
+		discardConn(conn)
+		return nil, nil
+	}
+	return &mysqlLease{conn: conn, name: name}, nil
+}
+
+func (le *mysqlLease) keep(ctx context.Context) error {
+	var held sql.NullBool
+	err := le.conn.QueryRowContext(ctx, "SELECT IS_USED_LOCK(?) = CONNECTION_ID()", le.name).Scan(&held)
+	switch {
+	case err != nil:
+		// the connection may be dead, so the lock released by the server
+		// after wait_timeout: the caller retries within the lease time.
+		return fmt.Errorf("keep lock %s: %w", le.name, err)
+	case !held.Valid || !held.Bool:
+		return errLeaseLost
+	default:
+		return nil
+	}
+}
+
+func (le *mysqlLease) release(ctx context.Context) {
+	_, _ = le.conn.ExecContext(ctx, "DO RELEASE_LOCK(?)", le.name)
+	discardConn(le.conn)
+}
+
+// discardConn closes the connection instead of returning it to the pool,
+// where its short wait_timeout would break the other users.
+func discardConn(conn *sql.Conn) {
+	_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+	_ = conn.Close()
+}
+
+// leaderLockName returns the name of the leader lock for the backend.
+func leaderLockName(l locker) string {
+	if _, ok := l.(*redisLocker); ok {
+		return cachekeys.SchedulerLeaderLock
+	}
+	return mysqlLockPrefix + "leader"
+}
+
+// taskLockName returns the name of the task lock for the backend.
+func taskLockName(l locker, name string) string {
+	if _, ok := l.(*redisLocker); ok {
+		return cachekeys.SchedulerTaskLockPrefix + name
+	}
+	return mysqlLockPrefix + "task_" + name
+}
+
+// keepTolerated returns true if the keep error is transient, and the lease
+// last kept at keptAt can't expire before the next keep attempt, after
+// interval. The lease holder then retries instead of stepping down.
+func keepTolerated(err error, keptAt time.Time, interval time.Duration) bool {
+	return !errors.Is(err, errLeaseLost) && time.Since(keptAt)+interval < leaseTime()
+}
+
+// campaign acquires or keeps the leader lease, and returns true if this
+// replica is the leader. A transient keep error keeps the leadership until
+// the lease could expire.
+func (t *Scheduler) campaign(ctx context.Context) bool {
+	ctx, cancel := context.WithTimeout(ctx, t.leaseTime/3)
+	defer cancel()
+	if t.lease != nil {
+		err := t.lease.keep(ctx)
+		if err == nil {
+			t.leaseKeptAt = time.Now()
+			return true
+		}
+		if keepTolerated(err, t.leaseKeptAt, t.leaseTime/3) {
+			t.Warnf("leadership keep failed, retry: %s", err)
+			return true
+		}
+		t.Warnf("leadership lost: %s", err)
+		t.lease.release(ctx)
+		t.lease = nil
+		leaderGauge.Set(0)
+		leaderTransitionCounter.With(prometheus.Labels{"event": "lost"}).Inc()
+		return false
+	}
+	le, err := t.locker.tryLock(ctx, leaderLockName(t.locker))
+	if err != nil {
+		t.Errorf("campaign: %s", err)
+		return false
+	}
+	if le == nil {
+		return false
+	}
+	t.Infof("leadership acquired")
+	t.lease = le
+	t.leaseKeptAt = time.Now()
+	leaderGauge.Set(1)
+	leaderTransitionCounter.With(prometheus.Labels{"event": "acquired"}).Inc()
+	return true
+}
+
+// resign releases the leader lease, so a standby replica can take over
+// without waiting for the lease expiration.
+func (t *Scheduler) resign() {
+	if t.lease == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	t.lease.release(ctx)
+	t.lease = nil
+	leaderGauge.Set(0)
+	leaderTransitionCounter.With(prometheus.Labels{"event": "resigned"}).Inc()
+	t.Infof("leadership released")
+}
+
+// lock acquires the task lock. The last time is the last run time known
+// by this replica.
+//
+// It returns a nil lease if the task is locked by another replica, or was
+// run by another replica since the last time and force is not set. The
+// returned last and next times are then the last run time stored by any
+// replica and the time of the next attempt.
+func (t *Task) lock(ctx context.Context, last time.Time, force bool) (lease, time.Time, time.Time) {
+	le, err := t.locker.tryLock(ctx, taskLockName(t.locker, t.name))
+	if err != nil {
+		t.Errorf("%s", err)
+		return nil, last, time.Now().Add(minTaskLockRetry)
+	}
+	if le == nil {
+		t.Debugf("skip: locked by another replica")
+		taskLockSkipCounter.With(prometheus.Labels{"desc": t.name}).Inc()
+		last = t.storedLastRunAt(ctx, last)
+		return nil, last, t.retryRun(last)
+	}
+	if stored := t.storedLastRunAt(ctx, last); stored != last && !force {
+		le.release(ctx)
+		taskLockSkipCounter.With(prometheus.Labels{"desc": t.name}).Inc()
+		next := t.retryRun(stored)
+		t.Debugf("skip: run by another replica, next at %s", next.Format(time.RFC3339))
+		return nil, stored, next
+	}
+	return le, last, time.Time{}
+}
+
+// storedLastRunAt returns the last run time stored by any replica if more
+// recent than the last time known by this replica. The tolerance absorbs
+// the clock differences between the replicas and the database.
+func (t *Task) storedLastRunAt(ctx context.Context, last time.Time) time.Time {
+	state, err := t.GetState(ctx)
+	if err != nil {
+		t.Errorf("%s", err)
+		return last
+	}
+	if state.LastRunAt.After(last.Add(minTaskLockRetry)) {
+		return state.LastRunAt
+	}
+	return last
+}
+
+// retryRun returns the next run time after last, but not sooner than the
+// minimum lock retry delay.
+func (t *Task) retryRun(last time.Time) time.Time {
+	now := time.Now()
+	next := t.nextRun(last, now)
+	if min := now.Add(minTaskLockRetry); next.Before(min) {
+		return min
+	}
+	return next
+}
+
+// keepLease extends the task lease until the returned func is called. The
+// cancel func is called if the lease is lost, or can't be kept within the
+// lease time, to abort the task execution before another replica starts it.
+func (t *Task) keepLease(ctx context.Context, le lease, cancel func()) func() {
+	d := leaseTime() / 3
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(d)
+		defer ticker.Stop()
+		keptAt := time.Now()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				keepCtx, keepCancel := context.WithTimeout(ctx, d)
+				err := le.keep(keepCtx)
+				keepCancel()
+				switch {
+				case err == nil:
+					keptAt = time.Now()
+				case keepTolerated(err, keptAt, d):
+					t.Warnf("task lock keep failed, retry: %s", err)
+				default:
+					t.Errorf("task lock: %s", err)
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+		releaseCtx, releaseCancel := context.WithTimeout(context.Background(), time.Second)
+		defer releaseCancel()
+		le.release(releaseCtx)
+	}
+}
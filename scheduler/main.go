@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
@@ -22,6 +23,19 @@ type (
 		states  map[string]State
 		cancels map[string]func()
 		sigC    chan os.Signal
+
+		// mu protects runtimes, read by the api handlers
+		mu       sync.Mutex
+		runtimes map[string]*taskRuntime
+
+		// election is the scheduler.election.mode setting
+		election  string
+		locker    locker
+		lease     lease
+		leaseTime time.Duration
+
+		// leaseKeptAt is the time of the last successful leader lease keep
+		leaseKeptAt time.Time
 	}
 
 	eventPublisher interface {
@@ -47,38 +61,77 @@ func (t *Scheduler) Debugf(format string, args ...any) {
 
 func (t *Scheduler) toggleTasks(ctx context.Context, states map[string]State) {
 	for _, task := range Tasks {
-		if task.period == 0 {
-			//task.Debugf("skip: no period")
-			continue
-		}
 		name := task.Name()
 		storedState, _ := states[name]
 		cachedState, hasCachedState := t.states[name]
-		if hasCachedState && cachedState.IsDisabled == storedState.IsDisabled {
+		if hasCachedState && cachedState.IsDisabled == storedState.IsDisabled && cachedState.sameOverrides(storedState) {
 			//task.Debugf("%s: cachedState: %v storedState: %v", cachedState, storedState)
 			continue
 		}
 
 		cancel, hasCancel := t.cancels[name]
-		switch {
-		case storedState.IsDisabled && hasCancel:
-			task.Debugf("stop")
-			cancel()
-			delete(t.cancels, name)
-		case !storedState.IsDisabled && !hasCancel:
-			ctx2, cancel := context.WithCancel(ctx)
-			t.cancels[name] = cancel
-			task.SetDB(t.DB)
-			task.SetRedis(t.Redis)
-			task.SetEv(t.Ev)
-			go func() {
-				task.Start(ctx2)
-			}()
+		if storedState.IsDisabled {
+			if hasCancel {
+				task.Debugf("stop")
+				t.stopTask(name, cancel)
+			}
+			continue
 		}
+		if err := task.configure(storedState); err != nil {
+			task.Errorf("configure: %s", err)
+			continue
+		}
+		if task.period == 0 && task.schedule == nil {
+			// no compiled period and no schedule override
+			if hasCancel {
+				task.Debugf("stop: no schedule")
+				t.stopTask(name, cancel)
+			}
+			continue
+		}
+		if hasCancel {
+			// let the current run finish, the new schedule applies to
+			// the next run
+			task.Infof("schedule changed")
+			t.runtimes[name].reconfigure(task)
+			continue
+		}
+		ctx2, cancel := context.WithCancel(ctx)
+		t.cancels[name] = cancel
+		task.SetDB(t.DB)
+		task.SetRedis(t.Redis)
+		task.SetEv(t.Ev)
+		if t.election == ElectionTask {
+			task.locker = t.locker
+		}
+		task.rt = newTaskRuntime()
+		t.mu.Lock()
+		t.runtimes[name] = task.rt
+		t.mu.Unlock()
+		go func() {
+			task.Start(ctx2)
+		}()
 	}
 	t.states = states
 }
 
+// stopTasks stops all the running tasks, for example when the leadership is
+// lost. The next toggleTasks restarts the enabled tasks.
+func (t *Scheduler) stopTasks() {
+	for name, cancel := range t.cancels {
+		t.stopTask(name, cancel)
+	}
+	t.states = make(map[string]State)
+}
+
+func (t *Scheduler) stopTask(name string, cancel func()) {
+	cancel()
+	delete(t.cancels, name)
+	t.mu.Lock()
+	delete(t.runtimes, name)
+	t.mu.Unlock()
+}
+
 func (t *Scheduler) monitor() error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -86,7 +139,21 @@ func (t *Scheduler) monitor() error {
 	ticker := time.NewTicker(5 * time.Second)
 	defer ticker.Stop()
 
+	// electionC ticks the leader lease renewal, and never ticks if the
+	// leader election is disabled.
+	var electionC <-chan time.Time
+	if t.election == ElectionLeader {
+		electionTicker := time.NewTicker(t.leaseTime / 3)
+		defer electionTicker.Stop()
+		electionC = electionTicker.C
+		defer t.resign()
+	}
+
 	do := func() {
+		if t.election == ElectionLeader && t.lease == nil {
+			// standby replica
+			return
+		}
 		states, err := t.GetStateMap(ctx)
 		if err != nil {
 			t.Errorf("states: %s", err)
@@ -97,7 +164,22 @@ func (t *Scheduler) monitor() error {
 		t.toggleTasks(ctx, states)
 	}
 
-	do()
+	elect := func() {
+		wasLeader := t.lease != nil
+		isLeader := t.campaign(ctx)
+		switch {
+		case isLeader && !wasLeader:
+			do()
+		case !isLeader && wasLeader:
+			t.stopTasks()
+		}
+	}
+
+	if t.election == ElectionLeader {
+		elect()
+	} else {
+		do()
+	}
 
 	for {
 		select {
@@ -105,6 +187,8 @@ func (t *Scheduler) monitor() error {
 			return nil
 		case <-ticker.C:
 			do()
+		case <-electionC:
+			elect()
 		case <-t.sigC:
 			cancel()
 		}
@@ -117,7 +201,11 @@ func (t *Scheduler) GetStateMap(ctx context.Context) (map[string]State, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 
-	query := "SELECT task_name,last_run_at,is_disabled FROM oc3_scheduler"
+	exprs, err := stateOverrideExprs(ctx, t.DB)
+	if err != nil {
+		return states, err
+	}
+	query := "SELECT task_name,last_run_at,is_disabled," + exprs + " FROM oc3_scheduler"
 
 	result, err := t.DB.QueryContext(ctx, query)
 	if err != nil {
@@ -129,7 +217,7 @@ func (t *Scheduler) GetStateMap(ctx context.Context) (map[string]State, error) {
 	for result.Next() {
 		var state State
 		var name string
-		err := result.Scan(&name, &state.LastRunAt, &state.IsDisabled)
+		err := result.Scan(&name, &state.LastRunAt, &state.IsDisabled, &state.Schedule, &state.Timeout, &state.Jitter, &state.Blackout)
 		if err != nil {
 			return states, fmt.Errorf("scan: %w", err)
 		}
@@ -142,16 +230,33 @@ func (t *Scheduler) Run() error {
 	t.states = make(map[string]State)
 	t.cancels = make(map[string]func())
 	t.sigC = make(chan os.Signal, 1)
+	t.mu.Lock()
+	t.runtimes = make(map[string]*taskRuntime)
+	t.mu.Unlock()
 
-	signal.Notify(t.sigC, os.Interrupt, syscall.SIGTERM)
+	mode, err := electionMode()
+	if err != nil {
+		return err
+	}
+	t.election = mode
+	if mode != ElectionNone {
+		if t.locker, err = newLocker(t.DB, t.Redis); err != nil {
+			return err
+		}
+		t.leaseTime = leaseTime()
+		t.Infof("election mode %s, lease time %s", mode, t.leaseTime)
+	}
 
-	t.monitor()
+	signal.Notify(t.sigC, os.Interrupt, syscall.SIGTERM)
 
-	return nil
+	return t.monitor()
 }
 
 func NewTask(name string, db *sql.DB, r *redis.Client, ev eventPublisher) Task {
 	task := Tasks.Get(name)
+	if err := task.configure(State{}); err != nil {
+		task.Warnf("configure: %s", err)
+	}
 	task.SetEv(ev)
 	task.SetDB(db)
 	task.SetRedis(r)
@@ -0,0 +1,381 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// Run is a task execution recorded in the oc3_scheduler_run table.
+	//
+	//	CREATE TABLE `oc3_scheduler_run` (
+	//	  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+	//	  `task_name` varchar(64) NOT NULL,
+	//	  `replica` varchar(255) NOT NULL DEFAULT '',
+	//	  `begin_at` datetime(6) NOT NULL,
+	//	  `end_at` datetime(6) DEFAULT NULL,
+	//	  `status` varchar(16) NOT NULL,
+	//	  `error` text DEFAULT NULL,
+	//	  `rows_affected` bigint(20) NOT NULL DEFAULT 0,
+	//	  PRIMARY KEY (`id`),
+	//	  KEY `k_task_name_begin_at` (`task_name`, `begin_at`),
+	//	  KEY `k_begin_at` (`begin_at`)
+	//	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
+	Run struct {
+		ID       int64
+		TaskName string
+
+		// Replica is the hostname of the scheduler that executed the task
+		Replica string
+
+		BeginAt time.Time
+
+		// EndAt is zero while the task is running
+		EndAt time.Time
+
+		Status       string
+		Error        string
+		RowsAffected int64
+	}
+
+	// TaskInfo is the status of a top-level task.
+	TaskInfo struct {
+		Name       string
+		Schedule   string
+		Timeout    time.Duration
+		IsDisabled bool
+
+		// IsScheduled is true if the task is started on this scheduler
+		// replica. It is false for the disabled tasks, and on the standby
+		// replicas in the leader election mode.
+		IsScheduled bool
+		IsRunning   bool
+		LastRunAt   time.Time
+
+		// NextRunAt is the planned next execution time, known only for
+		// the tasks scheduled on this replica.
+		NextRunAt time.Time
+
+		LastRun  *Run
+		Children []string
+	}
+
+	// taskRuntime is the live state of a task scheduled on this replica,
+	// shared between the task goroutine and the scheduler api.
+	taskRuntime struct {
+		mu        sync.RWMutex
+		nextRunAt time.Time
+		running   bool
+
+		// triggerC receives the requests to run the task now
+		triggerC chan struct{}
+
+		// configC receives the task configured with the changed schedule
+		// overrides, applied after the run in progress if any.
+		configC chan Task
+	}
+)
+
+const (
+	taskExecStatusRunning = "running"
+
+	// maxRunErrorSize is the maximum length of the error stored in the run
+	// history.
+	maxRunErrorSize = 65535
+
+	runColumns = "id,task_name,replica,begin_at,end_at,status,COALESCE(error, ''),rows_affected"
+)
+
+func newTaskRuntime() *taskRuntime {
+	return &taskRuntime{
+		triggerC: make(chan struct{}, 1),
+		configC:  make(chan Task, 1),
+	}
+}
+
+// reconfigure sends the reconfigured task to the task goroutine, replacing
+// a configuration not yet applied.
+func (rt *taskRuntime) reconfigure(task Task) {
+	if rt == nil {
+		return
+	}
+	select {
+	case <-rt.configC:
+	default:
+	}
+	rt.configC <- task
+}
+
+func (rt *taskRuntime) setNextRunAt(tm time.Time) {
+	if rt == nil {
+		return
+	}
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	rt.nextRunAt = tm
+}
+
+func (rt *taskRuntime) setRunning(v bool) {
+	if rt == nil {
+		return
+	}
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	rt.running = v
+}
+
+func (info TaskInfo) state() string {
+	switch {
+	case info.IsDisabled:
+		return "disabled"
+	case info.IsRunning:
+		return "running"
+	case info.IsScheduled:
+		return "scheduled"
+	default:
+		return "enabled"
+	}
+}
+
+// lastResult returns the status, duration, rows affected and error of the
+// last run.
+func (info TaskInfo) lastResult() string {
+	r := info.LastRun
+	if r == nil {
+		return "-"
+	}
+	if r.EndAt.IsZero() {
+		return r.Status
+	}
+	s := fmt.Sprintf("%s in %s, %d rows", r.Status, r.EndAt.Sub(r.BeginAt).Round(time.Millisecond), r.RowsAffected)
+	if r.Error != "" {
+		s += ": " + strings.SplitN(r.Error, "\n", 2)[0]
+	}
+	return s
+}
+
+func formatTime(tm time.Time) string {
+	if tm.IsZero() {
+		return "-"
+	}
+	return tm.Format(time.RFC3339)
+}
+
+// AddRowsAffected adds n to the rows affected by the current task
+// execution, reported in the run history.
+func (t *Task) AddRowsAffected(n int64) {
+	if t.rowsAffected != nil {
+		t.rowsAffected.Add(n)
+	}
+}
+
+// beginRun records the start of the task execution in the run history, and
+// returns the run id.
+func (t *Task) beginRun(ctx context.Context) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+
+	hostname, _ := os.Hostname()
+	query := "INSERT INTO oc3_scheduler_run (task_name, replica, begin_at, status) VALUES (?, ?, NOW(6), ?)"
+	result, err := t.db.ExecContext(ctx, query, t.name, hostname, taskExecStatusRunning)
+	if err != nil {
+		return 0, fmt.Errorf("begin run: %w", err)
+	}
+	return result.LastInsertId()
+}
+
+// endRun records the end of the task execution in the run history.
+func (t *Task) endRun(id int64, status string, runErr error, rows int64) error {
+	// the task context may be done, use a new one
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	var errStr any
+	if runErr != nil {
+		s := runErr.Error()
+		if len(s) > maxRunErrorSize {
+			s = s[:maxRunErrorSize]
+		}
+		errStr = s
+	}
+	query := "UPDATE oc3_scheduler_run SET end_at = NOW(6), status = ?, error = ?, rows_affected = ? WHERE id = ?"
+	if _, err := t.db.ExecContext(ctx, query, status, errStr, rows, id); err != nil {
+		return fmt.Errorf("end run: %w", err)
+	}
+	return nil
+}
+
+// GetRuns returns the most recent runs, filtered by task name and status
+// if not empty.
+func GetRuns(ctx context.Context, db *sql.DB, taskName, status string, limit int) ([]Run, error) {
+	var (
+		where []string
+		args  []any
+	)
+	if taskName != "" {
+		where = append(where, "task_name = ?")
+		args = append(args, taskName)
+	}
+	if status != "" {
+		where = append(where, "status = ?")
+		args = append(args, status)
+	}
+	query := "SELECT " + runColumns + " FROM oc3_scheduler_run"
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY id DESC LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	var l []Run
+	for rows.Next() {
+		r, err := scanRun(rows)
+		if err != nil {
+			return nil, err
+		}
+		l = append(l, r)
+	}
+	return l, rows.Err()
+}
+
+func scanRun(rows *sql.Rows) (Run, error) {
+	var r Run
+	var endAt sql.NullTime
+	if err := rows.Scan(&r.ID, &r.TaskName, &r.Replica, &r.BeginAt, &endAt, &r.Status, &r.Error, &r.RowsAffected); err != nil {
+		return r, fmt.Errorf("scan: %w", err)
+	}
+	r.EndAt = endAt.Time
+	return r, nil
+}
+
+// getLastRuns returns the last run of each task, indexed by task name.
+func getLastRuns(ctx context.Context, db *sql.DB) (map[string]Run, error) {
+	m := make(map[string]Run)
+	query := "SELECT " + runColumns + " FROM oc3_scheduler_run" +
+		" WHERE id IN (SELECT MAX(id) FROM oc3_scheduler_run GROUP BY task_name)"
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return m, err
+	}
+	defer func() { _ = rows.Close() }()
+	for rows.Next() {
+		r, err := scanRun(rows)
+		if err != nil {
+			return m, err
+		}
+		m[r.TaskName] = r
+	}
+	return m, rows.Err()
+}
+
+// GetTaskInfos returns the status of the top-level tasks, as stored in the
+// database.
+func GetTaskInfos(ctx context.Context, db *sql.DB) ([]TaskInfo, error) {
+	return getTaskInfos(ctx, db, nil)
+}
+
+// getTaskInfos returns the status of the top-level tasks, with the live
+// state of the tasks found in runtimes.
+func getTaskInfos(ctx context.Context, db *sql.DB, runtimes map[string]*taskRuntime) ([]TaskInfo, error) {
+	s := &Scheduler{DB: db}
+	states, err := s.GetStateMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lastRuns, err := getLastRuns(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]TaskInfo, 0, len(Tasks))
+	for _, task := range Tasks {
+		state := states[task.name]
+		if err := task.configure(state); err != nil {
+			task.Warnf("configure: %s", err)
+		}
+		info := TaskInfo{
+			Name:       task.name,
+			Schedule:   task.getSchedule().String(),
+			Timeout:    task.timeout,
+			IsDisabled: state.IsDisabled,
+			LastRunAt:  state.LastRunAt,
+		}
+		if task.period == 0 && task.schedule == nil {
+			info.Schedule = ""
+		}
+		if r, ok := lastRuns[task.name]; ok {
+			info.LastRun = &r
+		}
+		for _, child := range task.children {
+			info.Children = append(info.Children, child.name)
+		}
+		if rt, ok := runtimes[task.name]; ok {
+			rt.mu.RLock()
+			info.IsScheduled = true
+			info.IsRunning = rt.running
+			info.NextRunAt = rt.nextRunAt
+			rt.mu.RUnlock()
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// TaskInfos returns the status of the top-level tasks, with the live state
+// of the tasks scheduled on this replica.
+func (t *Scheduler) TaskInfos(ctx context.Context) ([]TaskInfo, error) {
+	t.mu.Lock()
+	runtimes := make(map[string]*taskRuntime, len(t.runtimes))
+	for k, v := range t.runtimes {
+		runtimes[k] = v
+	}
+	t.mu.Unlock()
+	return getTaskInfos(ctx, t.DB, runtimes)
+}
+
+// Runs returns the most recent runs of the tasks.
+func (t *Scheduler) Runs(ctx context.Context, taskName, status string, limit int) ([]Run, error) {
+	return GetRuns(ctx, t.DB, taskName, status, limit)
+}
+
+// SetDisabled disables or enables a top-level task. The change is applied
+// by the scheduler replicas on their next state poll.
+func (t *Scheduler) SetDisabled(ctx context.Context, name string, disabled bool) error {
+	if !Tasks.hasTopLevel(name) {
+		return ErrTaskNotFound
+	}
+	query := "INSERT INTO oc3_scheduler (task_name, is_disabled) VALUES (?, ?) ON DUPLICATE KEY UPDATE is_disabled = VALUES(is_disabled)"
+	if _, err := t.DB.ExecContext(ctx, query, name, disabled); err != nil {
+		return fmt.Errorf("set %s disabled: %w", name, err)
+	}
+	return nil
+}
+
+// Trigger requests the immediate execution of a top-level task scheduled
+// on this replica. A trigger received while a previous one is pending is
+// merged with it.
+func (t *Scheduler) Trigger(name string) error {
+	if !Tasks.hasTopLevel(name) {
+		return ErrTaskNotFound
+	}
+	t.mu.Lock()
+	rt, ok := t.runtimes[name]
+	t.mu.Unlock()
+	if !ok {
+		return ErrTaskNotScheduled
+	}
+	select {
+	case rt.triggerC <- struct{}{}:
+	default:
+	}
+	return nil
+}
@@ -0,0 +1,344 @@
+package scheduler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	// schedule plans the task executions.
+	schedule interface {
+		// next returns the time of the execution following the last one,
+		// not before now. The last time is zero if the task never ran.
+		next(last, now time.Time) time.Time
+
+		String() string
+	}
+
+	// periodSchedule runs the task every period after the last execution.
+	// A task never run or late is run immediately.
+	periodSchedule time.Duration
+
+	// cronSchedule runs the task at the minutes matching a 5-fields cron
+	// expression: minute, hour, day of month, month and day of week.
+	//
+	// A missed execution is not caught up.
+	cronSchedule struct {
+		expr   string
+		minute uint64
+		hour   uint64
+		dom    uint64
+		month  uint64
+		dow    uint64
+
+		// domStar and dowStar are set when the day fields are "*". Like
+		// cron, the days matching any restricted day field are selected.
+		domStar bool
+		dowStar bool
+	}
+
+	// window is a daily blackout window, from begin to end minutes after
+	// midnight. A window ending before its beginning ends the next day.
+	window struct {
+		// days is the bitmask of the week days the window begins, with
+		// bit 0 for sunday.
+		days  uint8
+		begin int
+		end   int
+	}
+
+	// blackout is a list of windows the task executions are postponed out
+	// of.
+	blackout []window
+
+	cronField struct {
+		min, max int
+		names    []string
+	}
+)
+
+var (
+	cronMacros = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+
+	dayNames   = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+	monthNames = []string{"", "jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+
+	cronFields = []cronField{
+		{min: 0, max: 59},
+		{min: 0, max: 23},
+		{min: 1, max: 31},
+		{min: 1, max: 12, names: monthNames},
+		// 7 is an alias of 0 for sunday
+		{min: 0, max: 7, names: dayNames},
+	}
+)
+
+// parseSchedule returns the schedule of a duration like "1h", or of a cron
+// expression like "*/5 * * * *" or "@daily".
+func parseSchedule(s string) (schedule, error) {
+	s = strings.TrimSpace(s)
+	if d, err := time.ParseDuration(s); err == nil {
+		if d <= 0 {
+			return nil, fmt.Errorf("invalid schedule period: %s", s)
+		}
+		return periodSchedule(d), nil
+	}
+	return parseCron(s)
+}
+
+func (p periodSchedule) next(last, now time.Time) time.Time {
+	if last.IsZero() {
+		return now
+	}
+	next := last.Add(time.Duration(p))
+	if next.Before(now) {
+		return now
+	}
+	return next
+}
+
+func (p periodSchedule) String() string {
+	return time.Duration(p).String()
+}
+
+func parseCron(s string) (*cronSchedule, error) {
+	expr := s
+	if macro, ok := cronMacros[s]; ok {
+		expr = macro
+	}
+	words := strings.Fields(expr)
+	if len(words) != len(cronFields) {
+		return nil, fmt.Errorf("invalid schedule %s: expect a duration or a %d fields cron expression", s, len(cronFields))
+	}
+	bits := make([]uint64, len(cronFields))
+	for i, word := range words {
+		b, err := cronFields[i].parse(word)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule %s: %w", s, err)
+		}
+		bits[i] = b
+	}
+	c := &cronSchedule{
+		expr:    s,
+		minute:  bits[0],
+		hour:    bits[1],
+		dom:     bits[2],
+		month:   bits[3],
+		dow:     bits[4],
+		domStar: words[2] == "*",
+		dowStar: words[4] == "*",
+	}
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+	}
+	if c.next(time.Time{}, time.Now()).IsZero() {
+		return nil, fmt.Errorf("invalid schedule %s: never matches", s)
+	}
+	return c, nil
+}
+
+// parse returns the bitmask of the values selected by a cron field word,
+// like "*", "*/5", "1-5", "mon-fri" or "0,30".
+func (f cronField) parse(word string) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(word, ",") {
+		rangeStr, stepStr, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepStr)
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step: %s", item)
+			}
+			step = n
+		}
+		var lo, hi int
+		switch {
+		case rangeStr == "*":
+			lo, hi = f.min, f.max
+		case strings.Contains(rangeStr, "-"):
+			loStr, hiStr, _ := strings.Cut(rangeStr, "-")
+			var err error
+			if lo, err = f.value(loStr); err != nil {
+				return 0, err
+			}
+			if hi, err = f.value(hiStr); err != nil {
+				return 0, err
+			}
+			if hi < lo {
+				return 0, fmt.Errorf("invalid range: %s", rangeStr)
+			}
+		default:
+			var err error
+			if lo, err = f.value(rangeStr); err != nil {
+				return 0, err
+			}
+			hi = lo
+			if hasStep {
+				hi = f.max
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	for i, name := range f.names {
+		if name != "" && strings.EqualFold(s, name) {
+			return i, nil
+		}
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid value: %s", s)
+	}
+	return v, nil
+}
+
+// next returns the first matching minute after the last execution and
+// not before now.
+func (c *cronSchedule) next(last, now time.Time) time.Time {
+	t := now.Truncate(time.Minute)
+	if t.Before(now) {
+		t = t.Add(time.Minute)
+	}
+	if !last.IsZero() && !t.After(last) {
+		t = last.Truncate(time.Minute).Add(time.Minute)
+	}
+	// a matching time is found within a few years, or never
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		switch {
+		case c.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !c.matchDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			// not t.Truncate(time.Hour), which truncates the absolute
+			// time and misses the hours of the zones with a non-whole
+			// hour offset
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (c *cronSchedule) matchDay(t time.Time) bool {
+	domMatch := c.dom&(1<<uint(t.Day())) != 0
+	dowMatch := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+func (c *cronSchedule) String() string {
+	return c.expr
+}
+
+// parseBlackout returns the blackout windows of a ";" separated list of
+// "[days ]HH:MM-HH:MM" windows, like "sat,sun 00:00-23:59; 22:00-06:00".
+// Days are names, or ranges of names like "mon-fri". A window without days
+// applies every day.
+func parseBlackout(s string) (blackout, error) {
+	var l blackout
+	for _, item := range strings.Split(s, ";") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		w := window{days: 0x7f}
+		hours := item
+		if daysStr, rest, ok := strings.Cut(item, " "); ok {
+			hours = strings.TrimSpace(rest)
+			bits, err := cronFields[4].parse(daysStr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid blackout window %s: %w", item, err)
+			}
+			if bits&(1<<7) != 0 {
+				bits |= 1
+			}
+			w.days = uint8(bits & 0x7f)
+		}
+		beginStr, endStr, ok := strings.Cut(hours, "-")
+		if !ok {
+			return nil, fmt.Errorf("invalid blackout window %s: expect HH:MM-HH:MM", item)
+		}
+		var err error
+		if w.begin, err = parseClock(beginStr); err != nil {
+			return nil, fmt.Errorf("invalid blackout window %s: %w", item, err)
+		}
+		if w.end, err = parseClock(endStr); err != nil {
+			return nil, fmt.Errorf("invalid blackout window %s: %w", item, err)
+		}
+		if w.begin == w.end {
+			return nil, fmt.Errorf("invalid blackout window %s: empty", item)
+		}
+		l = append(l, w)
+	}
+	return l, nil
+}
+
+// parseClock returns the minutes after midnight of a HH:MM string.
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time: %s", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// after returns t, or the end of the blackout windows t is in.
+func (l blackout) after(t time.Time) time.Time {
+	// adjacent windows are crossed in a few iterations
+	for i := 0; i < 2*len(l)+1; i++ {
+		end, ok := l.endOf(t)
+		if !ok {
+			return t
+		}
+		t = end
+	}
+	return t
+}
+
+// endOf returns the end of a window t is in.
+func (l blackout) endOf(t time.Time) (time.Time, bool) {
+	m := t.Hour()*60 + t.Minute()
+	today := uint8(1) << uint(t.Weekday())
+	yesterday := uint8(1) << uint((t.Weekday()+6)%7)
+	for _, w := range l {
+		switch {
+		case w.begin < w.end:
+			if w.days&today != 0 && m >= w.begin && m < w.end {
+				return clock(t, 0, w.end), true
+			}
+		case w.days&today != 0 && m >= w.begin:
+			return clock(t, 1, w.end), true
+		case w.days&yesterday != 0 && m < w.end:
+			return clock(t, 0, w.end), true
+		}
+	}
+	return t, false
+}
+
+// clock returns the time at minutes after the midnight of the day of t plus
+// days, in wall clock so the daylight saving time changes are accounted.
+func clock(t time.Time, days, minutes int) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day()+days, 0, minutes, 0, 0, t.Location())
+}
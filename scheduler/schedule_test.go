@@ -0,0 +1,307 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("load location %s: %s", name, err)
+	}
+	return loc
+}
+
+func TestPeriodScheduleNext(t *testing.T) {
+	now := time.Date(2024, 9, 2, 10, 0, 0, 0, time.UTC)
+	p := periodSchedule(time.Hour)
+	cases := []struct {
+		name string
+		last time.Time
+		want time.Time
+	}{
+		{"never run", time.Time{}, now},
+		{"late", now.Add(-2 * time.Hour), now},
+		{"planned", now.Add(-10 * time.Minute), now.Add(50 * time.Minute)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := p.next(tc.last, now); !got.Equal(tc.want) {
+				t.Errorf("next = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCronScheduleNext(t *testing.T) {
+	paris := mustLoadLocation(t, "Europe/Paris")
+	kolkata := mustLoadLocation(t, "Asia/Kolkata")
+	cases := []struct {
+		name string
+		expr string
+		last time.Time
+		now  time.Time
+		want time.Time
+	}{
+		{
+			name: "step",
+			expr: "*/15 * * * *",
+			now:  time.Date(2024, 9, 2, 10, 7, 30, 0, time.UTC),
+			want: time.Date(2024, 9, 2, 10, 15, 0, 0, time.UTC),
+		},
+		{
+			name: "now matches",
+			expr: "0 3 * * *",
+			now:  time.Date(2024, 9, 2, 3, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 9, 2, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "after last",
+			expr: "0 3 * * *",
+			last: time.Date(2024, 9, 2, 3, 0, 0, 0, time.UTC),
+			now:  time.Date(2024, 9, 2, 3, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 9, 3, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "missed not caught up",
+			expr: "0 3 * * *",
+			last: time.Date(2024, 9, 1, 3, 0, 0, 0, time.UTC),
+			now:  time.Date(2024, 9, 2, 4, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 9, 3, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "yearly",
+			expr: "@yearly",
+			now:  time.Date(2024, 9, 2, 10, 0, 0, 0, time.UTC),
+			want: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "month names",
+			expr: "0 0 1 mar-apr *",
+			now:  time.Date(2024, 9, 2, 10, 0, 0, 0, time.UTC),
+			want: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "day of month or day of week",
+			expr: "0 0 13 * fri",
+			now:  time.Date(2024, 9, 1, 10, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 9, 6, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "day of month only",
+			expr: "0 0 13 * *",
+			now:  time.Date(2024, 9, 1, 10, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 9, 13, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "day of week only",
+			expr: "0 0 * * 5",
+			now:  time.Date(2024, 9, 1, 10, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 9, 6, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "sunday as 7",
+			expr: "0 0 * * 7",
+			now:  time.Date(2024, 9, 2, 10, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 9, 8, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "half hour offset zone",
+			expr: "0 12 * * *",
+			now:  time.Date(2024, 9, 2, 10, 20, 0, 0, kolkata),
+			want: time.Date(2024, 9, 2, 12, 0, 0, 0, kolkata),
+		},
+		{
+			name: "dst spring forward skipped hour",
+			expr: "30 2 * * *",
+			now:  time.Date(2024, 3, 30, 3, 0, 0, 0, paris),
+			want: time.Date(2024, 4, 1, 2, 30, 0, 0, paris),
+		},
+		{
+			name: "dst spring forward",
+			expr: "0 3 * * *",
+			now:  time.Date(2024, 3, 31, 0, 0, 0, 0, paris),
+			want: time.Date(2024, 3, 31, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "dst fall back repeated hour",
+			expr: "0 * * * *",
+			last: time.Date(2024, 10, 27, 0, 0, 0, 0, time.UTC),
+			now:  time.Date(2024, 10, 27, 0, 1, 0, 0, time.UTC).In(paris),
+			want: time.Date(2024, 10, 27, 1, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := parseCron(tc.expr)
+			if err != nil {
+				t.Fatalf("parse %s: %s", tc.expr, err)
+			}
+			if got := c.next(tc.last, tc.now); !got.Equal(tc.want) {
+				t.Errorf("next = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCronScheduleMatchDay(t *testing.T) {
+	// 2024-09-13 is a friday
+	fri13 := time.Date(2024, 9, 13, 0, 0, 0, 0, time.UTC)
+	fri6 := time.Date(2024, 9, 6, 0, 0, 0, 0, time.UTC)
+	mon2 := time.Date(2024, 9, 2, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		expr string
+		day  time.Time
+		want bool
+	}{
+		{"0 0 13 * fri", fri13, true},
+		{"0 0 13 * fri", fri6, true},
+		{"0 0 13 * fri", mon2, false},
+		{"0 0 13 * *", fri6, false},
+		{"0 0 * * fri", fri6, true},
+		{"0 0 * * fri", mon2, false},
+		{"0 0 * * *", mon2, true},
+		{"0 0 2 * mon", mon2, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.expr+" "+tc.day.Format(time.DateOnly), func(t *testing.T) {
+			c, err := parseCron(tc.expr)
+			if err != nil {
+				t.Fatalf("parse %s: %s", tc.expr, err)
+			}
+			if got := c.matchDay(tc.day); got != tc.want {
+				t.Errorf("matchDay = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseSchedule(t *testing.T) {
+	cases := []struct {
+		s      string
+		want   string
+		hasErr bool
+	}{
+		{s: "1h", want: "1h0m0s"},
+		{s: " */5 * * * * ", want: "*/5 * * * *"},
+		{s: "@daily", want: "@daily"},
+		{s: "0s", hasErr: true},
+		{s: "-1m", hasErr: true},
+		{s: "* * * *", hasErr: true},
+		{s: "60 * * * *", hasErr: true},
+		{s: "0 0 * * foo", hasErr: true},
+		{s: "5-1 * * * *", hasErr: true},
+		{s: "*/0 * * * *", hasErr: true},
+		{s: "0 0 31 2 *", hasErr: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.s, func(t *testing.T) {
+			sched, err := parseSchedule(tc.s)
+			switch {
+			case tc.hasErr && err == nil:
+				t.Errorf("expected an error, got %s", sched)
+			case !tc.hasErr && err != nil:
+				t.Errorf("unexpected error: %s", err)
+			case !tc.hasErr && sched.String() != tc.want:
+				t.Errorf("schedule = %s, want %s", sched, tc.want)
+			}
+		})
+	}
+}
+
+func TestBlackoutAfter(t *testing.T) {
+	paris := mustLoadLocation(t, "Europe/Paris")
+	// 2024-09-06 is a friday
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, 9, day, hour, minute, 0, 0, time.UTC)
+	}
+	cases := []struct {
+		name     string
+		blackout string
+		t        time.Time
+		want     time.Time
+	}{
+		{"out of window", "01:00-02:00", at(6, 3, 0), at(6, 3, 0)},
+		{"in window", "01:00-02:00", at(6, 1, 30), at(6, 2, 0)},
+		{"window end excluded", "01:00-02:00", at(6, 2, 0), at(6, 2, 0)},
+		{"crossing midnight before", "22:00-06:00", at(6, 23, 0), at(7, 6, 0)},
+		{"crossing midnight after", "22:00-06:00", at(7, 5, 0), at(7, 6, 0)},
+		{"crossing midnight out", "22:00-06:00", at(7, 12, 0), at(7, 12, 0)},
+		{"crossing midnight day begin", "fri 22:00-06:00", at(7, 5, 0), at(7, 6, 0)},
+		{"crossing midnight other day", "fri 22:00-06:00", at(8, 5, 0), at(8, 5, 0)},
+		{"day range", "mon-fri 08:00-18:00", at(6, 9, 0), at(6, 18, 0)},
+		{"day range out", "mon-fri 08:00-18:00", at(7, 9, 0), at(7, 9, 0)},
+		{"adjacent windows", "22:00-23:00; 23:00-01:00", at(6, 22, 30), at(7, 1, 0)},
+		{
+			name:     "dst spring forward",
+			blackout: "01:00-04:00",
+			t:        time.Date(2024, 3, 31, 1, 30, 0, 0, paris),
+			want:     time.Date(2024, 3, 31, 4, 0, 0, 0, paris),
+		},
+		{
+			name:     "dst fall back crossing midnight",
+			blackout: "22:00-06:00",
+			t:        time.Date(2024, 10, 26, 23, 0, 0, 0, paris),
+			want:     time.Date(2024, 10, 27, 6, 0, 0, 0, paris),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			l, err := parseBlackout(tc.blackout)
+			if err != nil {
+				t.Fatalf("parse %s: %s", tc.blackout, err)
+			}
+			if got := l.after(tc.t); !got.Equal(tc.want) {
+				t.Errorf("after = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseBlackout(t *testing.T) {
+	cases := []struct {
+		s      string
+		want   blackout
+		hasErr bool
+	}{
+		{s: "", want: nil},
+		{s: "22:00-06:00", want: blackout{{days: 0x7f, begin: 22 * 60, end: 6 * 60}}},
+		{s: "sat,sun 00:00-23:59", want: blackout{{days: 0x41, begin: 0, end: 23*60 + 59}}},
+		{s: "mon-fri 08:30-18:00; 7 01:00-02:00", want: blackout{
+			{days: 0x3e, begin: 8*60 + 30, end: 18 * 60},
+			{days: 0x01, begin: 60, end: 120},
+		}},
+		{s: "10:00", hasErr: true},
+		{s: "10:00-10:00", hasErr: true},
+		{s: "foo 10:00-11:00", hasErr: true},
+		{s: "25:00-26:00", hasErr: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.s, func(t *testing.T) {
+			l, err := parseBlackout(tc.s)
+			switch {
+			case tc.hasErr && err == nil:
+				t.Errorf("expected an error, got %v", l)
+			case !tc.hasErr && err != nil:
+				t.Errorf("unexpected error: %s", err)
+			case !tc.hasErr && !equalBlackout(l, tc.want):
+				t.Errorf("blackout = %v, want %v", l, tc.want)
+			}
+		})
+	}
+}
+
+func equalBlackout(a, b blackout) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -7,12 +7,18 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"math/rand"
 	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"text/tabwriter"
 	"time"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/spf13/viper"
 
 	"github.com/opensvc/oc3/cdb"
 )
@@ -28,26 +34,97 @@ type (
 		fn       TaskFunc
 		children TaskList
 
+		// after is the list of the sibling children names this child task
+		// must wait for. Only the siblings declared before are accepted.
+		after []string
+
+		// parallel is the maximum number of children executed
+		// concurrently, 1 if not set.
+		parallel int
+
 		db      *sql.DB
 		Redis   *redis.Client
 		ev      eventPublisher
 		session *cdb.Session
+
+		// locker is set in the task election mode, to run the task on a
+		// single scheduler replica at a time.
+		locker locker
+
+		// schedule, jitter and blackout are the runtime overrides of the
+		// compiled period, set by configure.
+		schedule schedule
+		jitter   time.Duration
+		blackout blackout
+
+		// rt is the live state of a task started by the scheduler
+		rt *taskRuntime
+
+		// isChild is set on the children executed by their parent task,
+		// whose executions are not recorded in the run history.
+		isChild bool
+
+		// rowsAffected is shared by a task execution and its children
+		rowsAffected *atomic.Int64
 	}
 
 	TaskList []Task
 
+	// State is a task row of the oc3_scheduler table.
+	//
+	// The schedule overrides are empty when not set, or when the columns
+	// below do not exist yet, so the scheduler can be upgraded before the
+	// database:
+	//
+	//	ALTER TABLE `oc3_scheduler`
+	//	  ADD COLUMN `schedule` varchar(128) DEFAULT NULL,
+	//	  ADD COLUMN `timeout` varchar(32) DEFAULT NULL,
+	//	  ADD COLUMN `jitter` varchar(32) DEFAULT NULL,
+	//	  ADD COLUMN `blackout` varchar(255) DEFAULT NULL;
 	State struct {
 		IsDisabled bool
 		LastRunAt  time.Time
+
+		// Schedule is a period like "1h", or a cron expression like
+		// "*/5 * * * *".
+		Schedule string
+		Timeout  string
+		Jitter   string
+
+		// Blackout is a ";" separated list of windows like
+		// "sat,sun 00:00-23:59" the executions are postponed out of.
+		Blackout string
 	}
 )
 
 const (
 	taskExecStatusOk     = "ok"
 	taskExecStatusFailed = "failed"
+
+	// taskExecStatusSkipped is the status of a child not executed because
+	// one of its dependencies failed.
+	taskExecStatusSkipped = "skipped"
+
+	// stateColumnsRefreshInterval is the interval between the checks of the
+	// optional oc3_scheduler columns.
+	stateColumnsRefreshInterval = time.Minute
 )
 
 var (
+	// stateOverrideColumns are the optional oc3_scheduler columns of the
+	// schedule overrides, in the State fields scan order.
+	stateOverrideColumns = []string{"schedule", "timeout", "jitter", "blackout"}
+
+	// stateColumns caches the select expressions of the schedule overrides.
+	stateColumns struct {
+		sync.Mutex
+		exprs     string
+		checkedAt time.Time
+	}
+
+	ErrTaskNotFound     = errors.New("task not found")
+	ErrTaskNotScheduled = errors.New("task not scheduled on this scheduler replica")
+
 	Tasks = TaskList{
 		TaskChecks,
 		TaskSysreport,
@@ -55,11 +132,14 @@ var (
 		TaskAlertUpdateActionErrors,
 		TaskUpdateVirtualAssets,
 		TaskTrim,
+		TaskPartition,
+		TaskNotify,
 		TaskScrub1M,
 		TaskScrub10M,
 		TaskScrub1H,
 		TaskScrub1D,
 		TaskStat1D,
+		TaskAvailabilityRollup,
 		TaskAlert1M,
 		TaskAlert1H,
 		TaskAlert1D,
@@ -88,17 +168,40 @@ var (
 	)
 )
 
-func (t TaskList) Print() {
-	t.Fprint(os.Stdout)
+func (t TaskList) Print(infos []TaskInfo) {
+	t.Fprint(os.Stdout, infos)
 }
 
-func (t TaskList) Fprint(w io.Writer) {
+// Fprint writes the task tree, with the schedule, state and last result of
+// the top-level tasks found in infos.
+func (t TaskList) Fprint(w io.Writer, infos []TaskInfo) {
+	m := make(map[string]TaskInfo, len(infos))
+	for _, info := range infos {
+		m[info.Name] = info
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tSCHEDULE\tSTATE\tLAST RUN\tLAST RESULT")
 	for _, task := range t {
-		fmt.Fprintln(w, task.name)
+		info, ok := m[task.name]
+		if !ok {
+			info = TaskInfo{Schedule: task.getSchedule().String()}
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", task.name, info.Schedule, info.state(), formatTime(info.LastRunAt), info.lastResult())
 		for _, child := range task.children {
-			fmt.Fprintln(w, "  "+child.name)
+			fmt.Fprintf(tw, "  %s\t\t\t\t\n", child.name)
+		}
+	}
+	_ = tw.Flush()
+}
+
+// hasTopLevel returns true if name is a top-level task of the list.
+func (t TaskList) hasTopLevel(name string) bool {
+	for _, task := range t {
+		if task.name == name {
+			return true
 		}
 	}
+	return false
 }
 
 func (t TaskList) Get(name string) Task {
@@ -115,6 +218,12 @@ func (t TaskList) Get(name string) Task {
 	return Task{}
 }
 
+// sameOverrides returns true if the schedule overrides of the states are
+// equal.
+func (s State) sameOverrides(o State) bool {
+	return s.Schedule == o.Schedule && s.Timeout == o.Timeout && s.Jitter == o.Jitter && s.Blackout == o.Blackout
+}
+
 func (t *Task) IsZero() bool {
 	return t.fn == nil && t.children == nil
 }
@@ -196,44 +305,149 @@ func (t *Task) Start(ctx context.Context) {
 	if err != nil {
 		t.Errorf("%s", err)
 	}
-	var initialDelay time.Duration
-	if !state.LastRunAt.IsZero() {
-		initialDelay = state.LastRunAt.Add(t.period).Sub(time.Now())
-	} else {
-		initialDelay = 0
-	}
-
-	if initialDelay < 0 {
-		initialDelay = 0
-	}
+	last := state.LastRunAt
+	next := t.nextRun(last, time.Now())
 
-	t.Infof("start with period=%s, last was %s, next in %s", t.period, state.LastRunAt.Format(time.RFC3339), initialDelay)
-	timer := time.NewTimer(initialDelay)
+	t.Infof("start with schedule=%s, last was %s, next at %s", t.getSchedule(), last.Format(time.RFC3339), next.Format(time.RFC3339))
+	timer := time.NewTimer(time.Until(next))
 	defer timer.Stop()
+	t.rt.setNextRunAt(next)
+
+	var triggerC <-chan struct{}
+	var configC <-chan Task
+	if t.rt != nil {
+		triggerC = t.rt.triggerC
+		configC = t.rt.configC
+	}
 
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		case <-timer.C:
-			// Update the last run time persistant store
-			if err := t.SetLastRunAt(ctx); err != nil {
-				t.Errorf("%s", err)
-			}
+			last, next = t.runOnce(ctx, last, false)
+		case <-triggerC:
+			t.Infof("triggered")
+			timer.Stop()
+			last, next = t.runOnce(ctx, last, true)
+		case c := <-configC:
+			timer.Stop()
+			t.applyConfig(c)
+			next = t.nextRun(last, time.Now())
+			t.Infof("reconfigured with schedule=%s, next at %s", t.getSchedule(), next.Format(time.RFC3339))
+		}
+		timer.Reset(time.Until(next))
+		t.rt.setNextRunAt(next)
+	}
+}
+
+// runOnce executes the task and returns the last and the next execution
+// times. A forced execution is not skipped if another replica ran the task
+// since the last time.
+func (t *Task) runOnce(ctx context.Context, last time.Time, force bool) (time.Time, time.Time) {
+	if t.locker != nil {
+		le, last, next := t.lock(ctx, last, force)
+		if le == nil {
+			return last, next
+		}
+		var cancel func()
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		defer t.keepLease(ctx, le, cancel)()
+	}
 
-			// Blocking fn execution, no more timer event until terminated.
-			beginAt := time.Now()
-			_ = t.Exec(ctx)
-			endAt := time.Now()
+	// Update the last run time persistant store
+	if err := t.SetLastRunAt(ctx); err != nil {
+		t.Errorf("%s", err)
+	}
 
-			// Plan the next execution, correct the drift
-			nextPeriod := beginAt.Add(t.period).Sub(endAt)
-			if nextPeriod < 0 {
-				nextPeriod = time.Second
-			}
-			timer.Reset(nextPeriod)
+	// Blocking fn execution, no more timer event until terminated.
+	beginAt := time.Now()
+	t.rt.setRunning(true)
+	_ = t.Exec(ctx)
+	t.rt.setRunning(false)
+	endAt := time.Now()
+
+	// Plan the next execution, correct the drift
+	next := t.nextRun(beginAt, endAt)
+	if !next.After(endAt) {
+		next = endAt.Add(time.Second)
+	}
+	return beginAt, next
+}
+
+// nextRun returns the time of the next execution after last, with the
+// jitter added and postponed out of the blackout windows.
+func (t *Task) nextRun(last, now time.Time) time.Time {
+	next := t.getSchedule().next(last, now)
+	if t.jitter > 0 {
+		next = next.Add(time.Duration(rand.Int63n(int64(t.jitter))))
+	}
+	return t.blackout.after(next)
+}
+
+// applyConfig copies the schedule settings of the reconfigured task c.
+func (t *Task) applyConfig(c Task) {
+	t.schedule = c.schedule
+	t.timeout = c.timeout
+	t.jitter = c.jitter
+	t.blackout = c.blackout
+	t.parallel = c.parallel
+}
+
+func (t *Task) getSchedule() schedule {
+	if t.schedule != nil {
+		return t.schedule
+	}
+	return periodSchedule(t.period)
+}
+
+// configure applies the schedule overrides to the compiled task period and
+// timeout. The oc3_scheduler table state values take precedence over the
+// scheduler.task.<name>.{schedule,timeout,jitter,blackout} settings.
+//
+// The scheduler.task.<name>.parallel setting overrides the compiled
+// children parallelism.
+func (t *Task) configure(state State) error {
+	get := func(stored, key string) string {
+		if stored != "" {
+			return stored
+		}
+		return viper.GetString("scheduler.task." + t.name + "." + key)
+	}
+	t.schedule = nil
+	if s := get(state.Schedule, "schedule"); s != "" {
+		sched, err := parseSchedule(s)
+		if err != nil {
+			return err
+		}
+		t.schedule = sched
+	}
+	if s := get(state.Timeout, "timeout"); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil || d <= 0 {
+			return fmt.Errorf("invalid timeout: %s", s)
+		}
+		t.timeout = d
+	}
+	if s := get(state.Jitter, "jitter"); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil || d < 0 {
+			return fmt.Errorf("invalid jitter: %s", s)
+		}
+		t.jitter = d
+	}
+	if n := viper.GetInt("scheduler.task." + t.name + ".parallel"); n > 0 {
+		t.parallel = n
+	}
+	if s := get(state.Blackout, "blackout"); s != "" {
+		l, err := parseBlackout(s)
+		if err != nil {
+			return err
 		}
+		t.blackout = l
 	}
+	return nil
 }
 
 func (t *Task) Exec(ctx context.Context) (err error) {
@@ -241,20 +455,28 @@ func (t *Task) Exec(ctx context.Context) (err error) {
 	status := taskExecStatusOk
 	begin := time.Now()
 
+	// the children have their own timeout, not sharing the parent one
+	childrenCtx := ctx
+
 	ctx, cancel := context.WithTimeout(ctx, t.timeout)
 	defer cancel()
 
+	var runID int64
+	if !t.isChild {
+		t.rowsAffected = &atomic.Int64{}
+		if runID, err = t.beginRun(ctx); err != nil {
+			t.Warnf("%s", err)
+			err = nil
+		}
+	}
+
 	// Execution
 	if t.fn != nil {
 		err = t.fn(ctx, t)
 	}
 
-	for _, child := range t.children {
-		child.db = t.db
-		child.ev = t.ev
-		child.session = t.session
-		child.name = fmt.Sprintf("%s: %s", t.name, child.name)
-		err = errors.Join(err, child.Exec(ctx))
+	if len(t.children) > 0 {
+		err = errors.Join(err, t.execChildren(childrenCtx))
 	}
 
 	duration := time.Since(begin)
@@ -264,20 +486,142 @@ func (t *Task) Exec(ctx context.Context) (err error) {
 	} else {
 		t.Infof("%s [%s]", status, duration)
 	}
+	if runID != 0 {
+		if err := t.endRun(runID, status, err, t.rowsAffected.Load()); err != nil {
+			t.Warnf("%s", err)
+		}
+	}
 	taskExecCounter.With(prometheus.Labels{"desc": t.name, "status": status}).Inc()
 	taskExecDuration.With(prometheus.Labels{"desc": t.name, "status": status}).Observe(duration.Seconds())
 	return
 }
 
+// execChildren executes the children, at most t.parallel at a time. A child
+// waits for the completion of the siblings listed in its after field, and
+// is skipped if one of them failed.
+func (t *Task) execChildren(ctx context.Context) error {
+	parallel := t.parallel
+	if parallel < 1 {
+		parallel = 1
+	}
+	index := make(map[string]int, len(t.children))
+	for i, child := range t.children {
+		for _, dep := range child.after {
+			// only the siblings declared before are accepted, so the
+			// dependencies can't loop
+			if j, ok := index[dep]; !ok || j >= i {
+				return fmt.Errorf("%s: invalid dependency %s: not a preceding sibling", child.name, dep)
+			}
+		}
+		index[child.name] = i
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, parallel)
+	errs := make([]error, len(t.children))
+	dones := make([]chan struct{}, len(t.children))
+	for i := range dones {
+		dones[i] = make(chan struct{})
+	}
+	for i, child := range t.children {
+		child.db = t.db
+		child.Redis = t.Redis
+		child.ev = t.ev
+		child.isChild = true
+		child.rowsAffected = t.rowsAffected
+		child.name = fmt.Sprintf("%s: %s", t.name, child.name)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(dones[i])
+			for _, dep := range child.after {
+				j := index[dep]
+				<-dones[j]
+				if errs[j] != nil {
+					errs[i] = fmt.Errorf("%s: skipped: %s failed", child.name, dep)
+					child.Warnf("skipped: %s failed", dep)
+					taskExecCounter.With(prometheus.Labels{"desc": child.name, "status": taskExecStatusSkipped}).Inc()
+					return
+				}
+			}
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				errs[i] = fmt.Errorf("%s: %w", child.name, ctx.Err())
+				return
+			}
+			defer func() { <-sem }()
+			errs[i] = child.Exec(ctx)
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// stateOverrideExprs returns the select expressions of the schedule
+// overrides, with an empty string for the columns missing from the
+// oc3_scheduler table. The columns are checked again after
+// stateColumnsRefreshInterval, so the overrides are read as soon as the
+// database schema is upgraded.
+func stateOverrideExprs(ctx context.Context, db *sql.DB) (string, error) {
+	stateColumns.Lock()
+	defer stateColumns.Unlock()
+	if !stateColumns.checkedAt.IsZero() && time.Since(stateColumns.checkedAt) < stateColumnsRefreshInterval {
+		return stateColumns.exprs, nil
+	}
+	exprs, err := func() (string, error) {
+		const query = `SELECT COLUMN_NAME FROM information_schema.COLUMNS
+			WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'oc3_scheduler'`
+		rows, err := db.QueryContext(ctx, query)
+		if err != nil {
+			return "", err
+		}
+		defer func() { _ = rows.Close() }()
+		existing := make(map[string]bool)
+		for rows.Next() {
+			var name string
+			if err := rows.Scan(&name); err != nil {
+				return "", err
+			}
+			existing[name] = true
+		}
+		if err := rows.Err(); err != nil {
+			return "", err
+		}
+		l := make([]string, len(stateOverrideColumns))
+		for i, name := range stateOverrideColumns {
+			if existing[name] {
+				l[i] = `COALESCE(` + name + `, "")`
+			} else {
+				l[i] = `""`
+			}
+		}
+		return strings.Join(l, ","), nil
+	}()
+	switch {
+	case err == nil:
+		stateColumns.exprs, stateColumns.checkedAt = exprs, time.Now()
+	case stateColumns.checkedAt.IsZero():
+		return "", fmt.Errorf("check oc3_scheduler columns: %w", err)
+	default:
+		slog.Warn(fmt.Sprintf("check oc3_scheduler columns: %s", err))
+	}
+	return stateColumns.exprs, nil
+}
+
 func (t *Task) GetState(ctx context.Context) (State, error) {
 	var state State
 
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 
-	query := "SELECT last_run_at,is_disabled FROM oc3_scheduler WHERE task_name = ? ORDER BY id DESC LIMIT 1"
+	exprs, err := stateOverrideExprs(ctx, t.db)
+	if err != nil {
+		return state, err
+	}
+	query := "SELECT last_run_at,is_disabled," + exprs + " FROM oc3_scheduler WHERE task_name = ? ORDER BY id DESC LIMIT 1"
 
-	err := t.db.QueryRowContext(ctx, query, t.name).Scan(&state.LastRunAt, &state.IsDisabled)
+	err = t.db.QueryRowContext(ctx, query, t.name).Scan(&state.LastRunAt, &state.IsDisabled, &state.Schedule, &state.Timeout, &state.Jitter, &state.Blackout)
 	if err == sql.ErrNoRows {
 		return state, nil
 	}
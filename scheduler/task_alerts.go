@@ -13,6 +13,7 @@ import (
 var TaskAlert1M = Task{
 	name: "alerts_1m",
 	children: TaskList{
+		TaskAlertHeartbeatPathDown,
 		TaskAlertInstancesNotUpdated,
 	},
 	period:  time.Minute,
@@ -43,11 +44,13 @@ var TaskAlert1D = Task{
 		TaskAlertActionErrorsNotAcked,
 		TaskAlertCompModDiff,
 		TaskAlertCompRsetDiff,
+		TaskAlertGIDConflict,
 		TaskAlertMACDup,
 		TaskAlertNodeCloseToMaintenanceEnd,
 		TaskAlertNodeMaintenanceExpired,
 		TaskAlertNodeWithoutMaintenanceEnd,
 		TaskAlertPurgeActionErrors,
+		TaskAlertUIDConflict,
 		TaskPurgeAlertsOnDeletedInstances,
 	},
 	period:  24 * time.Hour,
@@ -120,6 +123,18 @@ var TaskAlertMACDup = Task{
 	timeout: 5 * time.Minute,
 }
 
+var TaskAlertUIDConflict = Task{
+	name:    "alert_uid_conflict",
+	fn:      taskAlertUIDConflict,
+	timeout: 5 * time.Minute,
+}
+
+var TaskAlertGIDConflict = Task{
+	name:    "alert_gid_conflict",
+	fn:      taskAlertGIDConflict,
+	timeout: 5 * time.Minute,
+}
+
 var TaskAlertNetworkWithWrongMask = Task{
 	name:    "alert_network_with_wrong_mask",
 	fn:      taskAlertNetworkWithWrongMask,
@@ -174,6 +189,12 @@ var TaskAlertInstancesNotUpdated = Task{
 	timeout: 5 * time.Minute,
 }
 
+var TaskAlertHeartbeatPathDown = Task{
+	name:    "alert_heartbeat_path_down",
+	fn:      taskAlertHeartbeatPathDown,
+	timeout: 5 * time.Minute,
+}
+
 var TaskAlertChecksNotUpdated = Task{
 	name:    "alert_checks_not_updated",
 	fn:      taskAlertChecksNotUpdated,
@@ -195,6 +216,36 @@ func taskAlertMACDup(ctx context.Context, task *Task) error {
 	return odb.Commit()
 }
 
+func taskAlertUIDConflict(ctx context.Context, task *Task) error {
+	odb, err := task.DBX(ctx)
+	if err != nil {
+		return err
+	}
+	defer odb.Rollback()
+	if err := odb.AlertUIDConflict(ctx); err != nil {
+		return err
+	}
+	if err := odb.Session.NotifyChanges(ctx); err != nil {
+		return err
+	}
+	return odb.Commit()
+}
+
+func taskAlertGIDConflict(ctx context.Context, task *Task) error {
+	odb, err := task.DBX(ctx)
+	if err != nil {
+		return err
+	}
+	defer odb.Rollback()
+	if err := odb.AlertGIDConflict(ctx); err != nil {
+		return err
+	}
+	if err := odb.Session.NotifyChanges(ctx); err != nil {
+		return err
+	}
+	return odb.Commit()
+}
+
 func taskAlertNetworkWithWrongMask(ctx context.Context, task *Task) error {
 	var severity int
 	odb, err := task.DBX(ctx)
@@ -429,6 +480,22 @@ func taskAlertServiceConfigNotUpdated(ctx context.Context, task *Task) error {
 	return odb.Commit()
 }
 
+func taskAlertHeartbeatPathDown(ctx context.Context, task *Task) error {
+	odb, err := task.DBX(ctx)
+	if err != nil {
+		return err
+	}
+	defer odb.Rollback()
+
+	if err := odb.AlertHeartbeatPathDown(ctx); err != nil {
+		return err
+	}
+	if err := odb.Session.NotifyChanges(ctx); err != nil {
+		return err
+	}
+	return odb.Commit()
+}
+
 func taskAlertInstancesNotUpdated(ctx context.Context, task *Task) error {
 	odb, err := task.DBX(ctx)
 	if err != nil {
@@ -0,0 +1,112 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/opensvc/oc3/availability"
+	"github.com/opensvc/oc3/cdb"
+)
+
+// TaskAvailabilityRollup rolls up the services and instances daily status
+// durations in svc_availability_daily. Each run recomputes the current day
+// and the scheduler.task.availability_rollup.days preceding days, so the
+// late status updates are accounted.
+var TaskAvailabilityRollup = Task{
+	name:    "availability_rollup",
+	period:  time.Hour,
+	fn:      taskAvailabilityRollupRun,
+	timeout: 10 * time.Minute,
+}
+
+const (
+	defaultAvailabilityRollupDays = 1
+)
+
+type availabilityKey struct {
+	svcID  string
+	nodeID string
+}
+
+func taskAvailabilityRollupRun(ctx context.Context, task *Task) error {
+	days := viper.GetInt("scheduler.task.availability_rollup.days")
+	if days <= 0 {
+		days = defaultAvailabilityRollupDays
+	}
+	odb := task.DB()
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	for n := days; n >= 0; n-- {
+		day := today.AddDate(0, 0, -n)
+		if err := rollupAvailabilityDay(ctx, task, odb, day, now); err != nil {
+			return err
+		}
+	}
+	return odb.Session.NotifyChanges(ctx)
+}
+
+// rollupAvailabilityDay computes the day status durations, up to now for
+// the current day.
+func rollupAvailabilityDay(ctx context.Context, task *Task, odb *cdb.DB, day, now time.Time) error {
+	begin := day
+	end := day.AddDate(0, 0, 1)
+	if end.After(now) {
+		end = now
+	}
+	svcIntervals, err := odb.ServicesLogIntervals(ctx, begin, end)
+	if err != nil {
+		return err
+	}
+	instanceIntervals, err := odb.SvcmonLogIntervals(ctx, begin, end)
+	if err != nil {
+		return err
+	}
+	acks, err := odb.AvailabilityAckWindows(ctx, begin, end)
+	if err != nil {
+		return err
+	}
+
+	windows := make(map[string][]availability.Window)
+	for _, w := range acks {
+		windows[w.SvcID] = append(windows[w.SvcID], availability.Window{Begin: w.Begin, End: w.End})
+	}
+	intervals := make(map[availabilityKey][]availability.Interval)
+	for _, i := range svcIntervals {
+		k := availabilityKey{svcID: i.SvcID}
+		intervals[k] = append(intervals[k], availability.Interval{
+			Status: availability.ServiceStatus(i.AvailStatus),
+			Begin:  i.Begin,
+			End:    i.End,
+		})
+	}
+	for _, i := range instanceIntervals {
+		k := availabilityKey{svcID: i.SvcID, nodeID: i.NodeID}
+		intervals[k] = append(intervals[k], availability.Interval{
+			Status: availability.InstanceStatus(i.AvailStatus, i.OverallStatus),
+			Begin:  i.Begin,
+			End:    i.End,
+		})
+	}
+
+	l := make([]cdb.AvailabilityDaily, 0, len(intervals))
+	for k, v := range intervals {
+		d := availability.Measure(v, windows[k.svcID], begin, end)
+		l = append(l, cdb.AvailabilityDaily{
+			Day:      day,
+			SvcID:    k.svcID,
+			NodeID:   k.nodeID,
+			Up:       int64(d.Up.Seconds()),
+			Down:     int64(d.Down.Seconds()),
+			Degraded: int64(d.Degraded.Seconds()),
+			Excluded: int64(d.Excluded.Seconds()),
+			Unknown:  int64(d.Unknown.Seconds()),
+		})
+	}
+	if err := odb.UpsertAvailabilityDaily(ctx, l); err != nil {
+		return err
+	}
+	task.Debugf("%s: rolled up %d services and instances", day.Format(time.DateOnly), len(l))
+	return nil
+}
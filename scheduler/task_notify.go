@@ -0,0 +1,301 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/notify"
+)
+
+var TaskNotify = Task{
+	name:    "notify",
+	period:  time.Minute,
+	fn:      taskNotifyRun,
+	timeout: 5 * time.Minute,
+}
+
+var (
+	notifySentCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "oc3",
+			Subsystem: "scheduler",
+			Name:      "notify_sent_count",
+			Help:      "Alert notification messages sent per channel and status",
+		},
+		[]string{"channel", "status"},
+	)
+)
+
+// taskNotifyRun queues the dashboard alert transitions for the channels of
+// the matching scheduler.task.notify routes, and sends the queued events of
+// the channels whose grouping window is over.
+func taskNotifyRun(ctx context.Context, task *Task) error {
+	cfg, err := notify.LoadConfig()
+	if err != nil {
+		return err
+	}
+	n, err := notify.New(cfg)
+	if err != nil {
+		return err
+	}
+	if !n.Enabled() {
+		task.Debugf("no route configured")
+		return nil
+	}
+	odb := task.DB()
+	if err := queueDashboardTransitions(ctx, task, odb, n); err != nil {
+		return err
+	}
+	if err := sendNotifyQueue(ctx, task, odb, n); err != nil {
+		return err
+	}
+	return odb.Session.NotifyChanges(ctx)
+}
+
+// queueDashboardTransitions compares the dashboard alerts updated since the
+// last successful run to their last notified states, and queues the raise
+// and clear events.
+//
+// An alert raised again during the dedup window following its last raise
+// notification is not notified, neither is its clear. When no state is
+// recorded, like on the first run, the alerts created before the dedup
+// window are recorded as not notified, to not flood the channels, and their
+// clear is not notified either.
+func queueDashboardTransitions(ctx context.Context, task *Task, odb *cdb.DB, n *notify.Notifier) error {
+	states, err := odb.DashboardNotifyStates(ctx)
+	if err != nil {
+		return err
+	}
+	bootstrap := len(states) == 0
+	var since time.Time
+	if !bootstrap {
+		if since, err = notifySince(ctx, task); err != nil {
+			return err
+		}
+	}
+	alerts, err := odb.DashboardAlerts(ctx, since)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+
+	var (
+		changed []cdb.DashboardNotifyState
+		events  []notify.Event
+		skipped int
+	)
+	current := make(map[string]bool, len(alerts))
+	for _, a := range alerts {
+		if current[a.Key] {
+			continue
+		}
+		current[a.Key] = true
+		text := notify.FormatDashboard(a.Fmt, a.Dict)
+		s, ok := states[a.Key]
+		switch {
+		case !ok:
+			s = cdb.DashboardNotifyState{Key: a.Key, Type: a.Type, NodeID: a.NodeID, SvcID: a.SvcID}
+			if bootstrap && a.Created.Before(now.Add(-n.DedupWindow)) {
+				s.RaisedAt = a.Created
+				skipped++
+			} else {
+				s.RaisedAt = now
+				s.NotifiedAt = &now
+				events = append(events, newNotifyEvent(notify.EventRaise, a, text, now))
+			}
+		case s.ClearedAt != nil:
+			s.ClearedAt = nil
+			s.RaisedAt = now
+			if s.NotifiedAt != nil && now.Sub(*s.NotifiedAt) < n.DedupWindow {
+				task.Debugf("alert %s %s raised again during the dedup window", a.Key, a.Type)
+			} else {
+				s.NotifiedAt = &now
+				events = append(events, newNotifyEvent(notify.EventRaise, a, text, now))
+			}
+		case a.Severity > s.Severity:
+			// escalation
+			s.NotifiedAt = &now
+			events = append(events, newNotifyEvent(notify.EventRaise, a, text, now))
+		case a.Severity == s.Severity && s.Text == text && s.Env == a.Env && s.App == a.App:
+			continue
+		}
+		s.Severity = a.Severity
+		s.Nodename = a.Nodename
+		s.Svcname = a.Svcname
+		s.Env = a.Env
+		s.App = a.App
+		s.Text = text
+		changed = append(changed, s)
+	}
+	cleared, err := odb.DashboardClearedAlertKeys(ctx)
+	if err != nil {
+		return err
+	}
+	for _, key := range cleared {
+		s, ok := states[key]
+		if !ok || current[key] || s.ClearedAt != nil {
+			continue
+		}
+		s.ClearedAt = &now
+		changed = append(changed, s)
+		if s.NotifiedAt == nil || s.NotifiedAt.Before(s.RaisedAt) {
+			// the raise was not notified
+			continue
+		}
+		events = append(events, notify.Event{
+			Kind:     notify.EventClear,
+			Key:      s.Key,
+			Type:     s.Type,
+			Severity: s.Severity,
+			Env:      s.Env,
+			App:      s.App,
+			NodeID:   s.NodeID,
+			Nodename: s.Nodename,
+			SvcID:    s.SvcID,
+			Svcname:  s.Svcname,
+			Text:     s.Text,
+			At:       now,
+		})
+	}
+	if skipped > 0 {
+		task.Infof("skip the notification of %d alerts older than %s", skipped, n.DedupWindow)
+	}
+
+	var queued int
+	for _, e := range events {
+		b, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		for _, channel := range n.Route(e) {
+			if err := odb.InsertNotifyQueue(ctx, channel, b, now); err != nil {
+				return err
+			}
+			queued++
+		}
+	}
+	if err := odb.UpsertDashboardNotifyStates(ctx, changed); err != nil {
+		return err
+	}
+	if count, err := odb.PurgeDashboardNotifyStates(ctx, now.Add(-n.DedupWindow)); err != nil {
+		return err
+	} else if count > 0 {
+		task.Debugf("purged %d cleared alert states", count)
+	}
+	if len(events) > 0 {
+		task.Infof("%d alert transitions, %d queued notifications", len(events), queued)
+	}
+	return nil
+}
+
+// notifySince returns the begin time of the last successful run, the
+// dashboard alerts updated since are compared to their notification state.
+// It returns a zero time, to compare all the alerts, if the task never
+// succeeded.
+func notifySince(ctx context.Context, task *Task) (time.Time, error) {
+	if task.db == nil {
+		return time.Time{}, nil
+	}
+	runs, err := GetRuns(ctx, task.db, task.name, taskExecStatusOk, 1)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("get last run: %w", err)
+	}
+	if len(runs) == 0 {
+		return time.Time{}, nil
+	}
+	return runs[0].BeginAt, nil
+}
+
+func newNotifyEvent(kind string, a cdb.DashboardAlert, text string, at time.Time) notify.Event {
+	return notify.Event{
+		Kind:     kind,
+		Key:      a.Key,
+		Type:     a.Type,
+		Severity: a.Severity,
+		Env:      a.Env,
+		App:      a.App,
+		NodeID:   a.NodeID,
+		Nodename: a.Nodename,
+		SvcID:    a.SvcID,
+		Svcname:  a.Svcname,
+		Text:     text,
+		At:       at,
+	}
+}
+
+// sendNotifyQueue sends the queued events of each channel in a single
+// message, once the grouping window following the oldest event is over.
+//
+// The events of a failing channel are kept queued for the next run, until
+// they exceed the max age.
+func sendNotifyQueue(ctx context.Context, task *Task, odb *cdb.DB, n *notify.Notifier) error {
+	entries, err := odb.NotifyQueue(ctx)
+	if err != nil {
+		return err
+	}
+	byChannel := make(map[string][]cdb.NotifyQueueEntry)
+	var channels []string
+	for _, e := range entries {
+		if _, ok := byChannel[e.Channel]; !ok {
+			channels = append(channels, e.Channel)
+		}
+		byChannel[e.Channel] = append(byChannel[e.Channel], e)
+	}
+	now := time.Now()
+	var errs error
+	for _, name := range channels {
+		l := byChannel[name]
+		if now.Sub(l[0].CreatedAt) < n.GroupWindow {
+			continue
+		}
+		if err := sendNotifyChannel(ctx, task, odb, n, name, l, now); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+	return errs
+}
+
+func sendNotifyChannel(ctx context.Context, task *Task, odb *cdb.DB, n *notify.Notifier, name string, l []cdb.NotifyQueueEntry, now time.Time) error {
+	ids := make([]int64, len(l))
+	events := make([]notify.Event, 0, len(l))
+	for i, e := range l {
+		ids[i] = e.ID
+		var ev notify.Event
+		if err := json.Unmarshal(e.Event, &ev); err != nil {
+			task.Warnf("channel %s: drop invalid queued event %d: %s", name, e.ID, err)
+			continue
+		}
+		events = append(events, ev)
+	}
+	ch := n.Channel(name)
+	if ch == nil {
+		task.Warnf("channel %s: drop %d queued events: channel no longer configured", name, len(l))
+		return odb.DeleteNotifyQueue(ctx, ids)
+	}
+	if len(events) == 0 {
+		return odb.DeleteNotifyQueue(ctx, ids)
+	}
+	m := notify.NewMessage(events)
+	if err := ch.Send(ctx, m); err != nil {
+		notifySentCounter.With(prometheus.Labels{"channel": name, "status": "error"}).Inc()
+		if now.Sub(l[0].CreatedAt) > n.MaxAge {
+			task.Warnf("channel %s: drop %d queued events older than %s: %s", name, len(l), n.MaxAge, err)
+			return odb.DeleteNotifyQueue(ctx, ids)
+		}
+		task.Warnf("channel %s: send %d events: %s", name, len(events), err)
+		return nil
+	}
+	notifySentCounter.With(prometheus.Labels{"channel": name, "status": "ok"}).Inc()
+	task.Infof("channel %s: sent %d events to %s", name, len(events), ch.Recipients())
+	if err := odb.DeleteNotifyQueue(ctx, ids); err != nil {
+		return err
+	}
+	return odb.InsertAlertSent(ctx, ch.Type(), ch.Recipients(), m.Subject, m.Text, now)
+}
@@ -0,0 +1,348 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/opensvc/oc3/cdb"
+)
+
+type (
+	// partitionInterval is the time range covered by a partition, "day" or
+	// "month".
+	partitionInterval string
+
+	// partitionBound converts the partition upper bounds to times and back,
+	// according to the partitioning expression.
+	partitionBound int
+
+	// rangePartition is a RANGE partition with a time upper bound.
+	rangePartition struct {
+		cdb.TablePartition
+		upper time.Time
+	}
+
+	// rangePartitions are the partitions of a table.
+	rangePartitions struct {
+		bound partitionBound
+
+		// parts are the bounded partitions, ordered by upper bound
+		parts []rangePartition
+
+		// catchAll is the name of the MAXVALUE partition, if any
+		catchAll string
+	}
+
+	partitionTable struct {
+		table   string
+		dateCol string
+	}
+)
+
+const (
+	partitionIntervalDay   partitionInterval = "day"
+	partitionIntervalMonth partitionInterval = "month"
+
+	// toDaysEpoch is TO_DAYS('1970-01-01')
+	toDaysEpoch = 719528
+
+	partitionMaxValue = "MAXVALUE"
+
+	defaultPartitionPremake = 3
+)
+
+const (
+	boundColumns partitionBound = iota
+	boundToDays
+	boundUnixTimestamp
+)
+
+var TaskPartition = Task{
+	name:    "partition",
+	period:  time.Hour,
+	fn:      taskPartitionRun,
+	timeout: 30 * time.Minute,
+}
+
+// partitionTables are the history tables the partition task can manage,
+// with the date column they are partitioned by.
+//
+// A table is managed if scheduler.task.partition.table.<table>.interval is
+// set to day or month, and the table is already partitioned by RANGE
+// COLUMNS(<date col>), RANGE(TO_DAYS(<date col>)) or
+// RANGE(UNIX_TIMESTAMP(<date col>)), for example with:
+//
+//	ALTER TABLE log PARTITION BY RANGE (TO_DAYS(log_date)) (
+//	  PARTITION p20240101 VALUES LESS THAN (TO_DAYS('2024-01-02')),
+//	  PARTITION pmax VALUES LESS THAN MAXVALUE
+//	)
+//
+// The partition key must be part of the table primary and unique keys.
+var partitionTables = []partitionTable{
+	{table: "svcmon_log", dateCol: "mon_end"},
+	{table: "resmon_log", dateCol: "res_end"},
+	{table: "log", dateCol: "log_date"},
+	{table: "dashboard_events", dateCol: "dash_end"},
+	{table: "comp_log", dateCol: "run_date"},
+}
+
+// taskPartitionRun creates the partitions of the next intervals and drops
+// the partitions expired according to the trim task retention of the
+// managed tables.
+func taskPartitionRun(ctx context.Context, task *Task) (err error) {
+	for _, t := range partitionTables {
+		err = errors.Join(err, managePartitions(ctx, task, t))
+	}
+	return
+}
+
+func managePartitions(ctx context.Context, task *Task, t partitionTable) error {
+	interval, err := getPartitionInterval(t.table)
+	if err != nil || interval == "" {
+		return err
+	}
+	rp, err := getRangePartitions(ctx, task.DB(), t.table)
+	if err != nil {
+		return err
+	} else if rp == nil {
+		task.Debugf("%s: not partitioned, trimmed by batched deletes", t.table)
+		return nil
+	}
+	return errors.Join(
+		createPartitions(ctx, task, t, interval, rp),
+		dropPartitions(ctx, task, t, rp.parts),
+	)
+}
+
+// createPartitions adds the partitions up to the end of the
+// scheduler.task.partition.premake intervals following the current one.
+func createPartitions(ctx context.Context, task *Task, t partitionTable, interval partitionInterval, rp *rangePartitions) error {
+	premake := viper.GetInt("scheduler.task.partition.premake")
+	if premake <= 0 {
+		premake = defaultPartitionPremake
+	}
+	now := time.Now()
+	target := interval.floor(now)
+	for i := 0; i <= premake; i++ {
+		target = interval.next(target)
+	}
+
+	lower := interval.floor(now)
+	if len(rp.parts) > 0 {
+		lower = rp.parts[len(rp.parts)-1].upper
+	}
+	existing := make(map[string]bool, len(rp.parts))
+	for _, p := range rp.parts {
+		existing[p.Name] = true
+	}
+	var defs []cdb.PartitionDefinition
+	for lower.Before(target) {
+		upper := interval.next(interval.floor(lower))
+		name := interval.partitionName(lower)
+		if existing[name] || name == rp.catchAll {
+			return fmt.Errorf("%s: create partition %s: name already used", t.table, name)
+		}
+		defs = append(defs, cdb.PartitionDefinition{Name: name, LessThan: rp.bound.format(upper)})
+		lower = upper
+	}
+	if len(defs) == 0 {
+		return nil
+	}
+	if err := task.DB().AddPartitions(ctx, t.table, rp.catchAll, defs); err != nil {
+		return err
+	}
+	task.Infof("%s: created %d partitions from %s to %s", t.table, len(defs), defs[0].Name, defs[len(defs)-1].Name)
+	return nil
+}
+
+// dropPartitions drops the partitions whose upper bound is older than the
+// trim retention. The last bounded partition is never dropped.
+//
+// The first partition also stores the rows with a NULL date, like the open
+// dashboard events, and is not dropped while such rows exist. The
+// partitions of the archived tables are dropped only once emptied by the
+// trim task.
+func dropPartitions(ctx context.Context, task *Task, t partitionTable, parts []rangePartition) error {
+	retention := getRetentionDays(t.table)
+	if retention <= 0 {
+		return nil
+	}
+	cutoff := time.Now().AddDate(0, 0, -retention)
+	odb := task.DB()
+	var (
+		names []string
+		rows  int64
+	)
+	for i, p := range parts {
+		if i == len(parts)-1 || p.upper.After(cutoff) {
+			break
+		}
+		var where string
+		switch {
+		case isArchived(t.table):
+		case i == 0:
+			where = fmt.Sprintf("`%s` IS NULL", t.dateCol)
+		default:
+			names = append(names, p.Name)
+			rows += p.Rows
+			continue
+		}
+		if hasRows, err := odb.PartitionHasRows(ctx, t.table, p.Name, where); err != nil {
+			return err
+		} else if hasRows {
+			task.Debugf("%s: keep expired partition %s: has rows to keep", t.table, p.Name)
+			break
+		}
+		names = append(names, p.Name)
+		rows += p.Rows
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	if err := odb.DropPartitions(ctx, t.table, names); err != nil {
+		return err
+	}
+	task.AddRowsAffected(rows)
+	task.Infof("%s: dropped %d partitions older than %d days, about %d rows: %s", t.table, len(names), retention, rows, strings.Join(names, ","))
+	return nil
+}
+
+// getRangePartitions returns the partitions of the table, or nil if the
+// table is not partitioned.
+func getRangePartitions(ctx context.Context, odb *cdb.DB, table string) (*rangePartitions, error) {
+	l, err := odb.TablePartitions(ctx, table)
+	if err != nil || len(l) == 0 {
+		return nil, err
+	}
+	bound, err := newPartitionBound(l[0])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", table, err)
+	}
+	rp := &rangePartitions{bound: bound}
+	for _, p := range l {
+		if p.Description == partitionMaxValue {
+			rp.catchAll = p.Name
+			continue
+		}
+		upper, err := bound.parse(p.Description)
+		if err != nil {
+			return nil, fmt.Errorf("%s: partition %s: %w", table, p.Name, err)
+		}
+		rp.parts = append(rp.parts, rangePartition{TablePartition: p, upper: upper})
+	}
+	return rp, nil
+}
+
+// isPartitionManaged returns true if the expired rows of the table are
+// removed by the partition task instead of the trim task batched deletes.
+func isPartitionManaged(ctx context.Context, odb *cdb.DB, table string) (bool, error) {
+	if isArchived(table) || !isPartitionTable(table) {
+		return false, nil
+	}
+	if interval, err := getPartitionInterval(table); err != nil || interval == "" {
+		return false, nil
+	}
+	rp, err := getRangePartitions(ctx, odb, table)
+	if err != nil {
+		return false, err
+	}
+	return rp != nil, nil
+}
+
+func isPartitionTable(table string) bool {
+	for _, t := range partitionTables {
+		if t.table == table {
+			return true
+		}
+	}
+	return false
+}
+
+func getPartitionInterval(table string) (partitionInterval, error) {
+	s := viper.GetString(fmt.Sprintf("scheduler.task.partition.table.%s.interval", table))
+	switch i := partitionInterval(s); i {
+	case "", partitionIntervalDay, partitionIntervalMonth:
+		return i, nil
+	default:
+		return "", fmt.Errorf("%s: invalid partition interval %s: expect day or month", table, s)
+	}
+}
+
+func (i partitionInterval) floor(t time.Time) time.Time {
+	if i == partitionIntervalMonth {
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+func (i partitionInterval) next(t time.Time) time.Time {
+	if i == partitionIntervalMonth {
+		return t.AddDate(0, 1, 0)
+	}
+	return t.AddDate(0, 0, 1)
+}
+
+// partitionName returns the name of the partition beginning at t, like
+// p20240131 or p202401.
+func (i partitionInterval) partitionName(t time.Time) string {
+	if i == partitionIntervalMonth {
+		return "p" + t.Format("200601")
+	}
+	return "p" + t.Format("20060102")
+}
+
+func newPartitionBound(p cdb.TablePartition) (partitionBound, error) {
+	expr := strings.ToLower(strings.ReplaceAll(p.Expression, " ", ""))
+	switch {
+	case p.Method == "RANGE COLUMNS":
+		return boundColumns, nil
+	case p.Method == "RANGE" && strings.HasPrefix(expr, "to_days("):
+		return boundToDays, nil
+	case p.Method == "RANGE" && strings.HasPrefix(expr, "unix_timestamp("):
+		return boundUnixTimestamp, nil
+	default:
+		return 0, fmt.Errorf("unsupported partitioning %s(%s)", p.Method, p.Expression)
+	}
+}
+
+func (b partitionBound) parse(s string) (time.Time, error) {
+	switch b {
+	case boundToDays:
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid bound %s: %w", s, err)
+		}
+		return time.Date(1970, 1, 1+n-toDaysEpoch, 0, 0, 0, 0, time.Local), nil
+	case boundUnixTimestamp:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid bound %s: %w", s, err)
+		}
+		return time.Unix(n, 0), nil
+	default:
+		s = strings.Trim(s, "'")
+		for _, layout := range []string{time.DateTime, time.DateOnly} {
+			if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+				return t, nil
+			}
+		}
+		return time.Time{}, fmt.Errorf("invalid bound %s", s)
+	}
+}
+
+func (b partitionBound) format(t time.Time) string {
+	switch b {
+	case boundToDays:
+		return fmt.Sprintf("TO_DAYS('%s')", t.Format(time.DateOnly))
+	case boundUnixTimestamp:
+		return fmt.Sprintf("UNIX_TIMESTAMP('%s')", t.Format(time.DateTime))
+	default:
+		return fmt.Sprintf("'%s'", t.Format(time.DateOnly))
+	}
+}
@@ -136,8 +136,21 @@ var TaskScrubCompRulesetsServices = Task{
 }
 
 var TaskScrubCompStatus = Task{
-	name:    "scrub_comp_status",
-	fn:      taskScrubCompStatus,
+	name: "scrub_comp_status",
+	fn:   taskScrubCompStatus,
+	after: []string{
+		"scrub_comp_modulesets_nodes",
+		"scrub_comp_modulesets_services",
+		"scrub_comp_rulesets_nodes",
+		"scrub_comp_rulesets_services",
+	},
+	timeout: time.Minute,
+}
+
+var TaskScrubAvailabilityDaily = Task{
+	name:    "scrub_availability_daily",
+	desc:    "purge the availability rollups of the deleted services and nodes",
+	fn:      taskScrubAvailabilityDaily,
 	timeout: time.Minute,
 }
 
@@ -151,6 +164,7 @@ var TaskScrub1D = Task{
 	name:   "scrub_1d",
 	period: 24 * time.Hour,
 	children: TaskList{
+		TaskScrubAvailabilityDaily,
 		TaskScrubChecksLive,
 		TaskScrubCompModulesetsNodes,
 		TaskScrubCompModulesetsServices,
@@ -168,12 +182,13 @@ var TaskScrub1D = Task{
 		TaskScrubSvcdisks,
 		TaskUpdateStorArrayDGQuota,
 	},
-	timeout: 5 * time.Minute,
+	parallel: 4,
+	timeout:  5 * time.Minute,
 }
 
 var TaskScrub1H = Task{
 	name:   "scrub_1h",
-	period: time.Minute,
+	period: time.Hour,
 	children: TaskList{
 		TaskScrubTempviz,
 	},
@@ -251,9 +266,12 @@ func taskScrubResources(ctx context.Context, task *Task) error {
 	}
 
 	// Update the `resmon` table
-	if modified, err := odb.ResourceUpdateStatus(ctx, resources, "undef"); err != nil {
+	modified, err := odb.ResourceUpdateStatus(ctx, resources, "undef")
+	if err != nil {
 		return err
-	} else if int(modified) != n {
+	}
+	task.AddRowsAffected(modified)
+	if int(modified) != n {
 		task.Infof("set %d/%d resmon status to undef (no live instance) amongst %s", modified, n, names)
 	} else {
 		task.Infof("set %d resmon status to undef (no live instance) for %s", n, names)
@@ -312,9 +330,12 @@ func taskScrubObjects(ctx context.Context, task *Task) error {
 	}
 
 	// Update the `services` table
-	if modified, err := odb.ObjectUpdateStatusSimple(ctx, objects, "undef", "undef"); err != nil {
+	modified, err := odb.ObjectUpdateStatusSimple(ctx, objects, "undef", "undef")
+	if err != nil {
 		return err
-	} else if int(modified) != n {
+	}
+	task.AddRowsAffected(modified)
+	if int(modified) != n {
 		task.Infof("set %d/%d services status to undef (no live instance) amongst %s", modified, n, objects)
 	} else {
 		task.Infof("set %d services status to undef (no live instance) for %s", n, objects)
@@ -362,6 +383,22 @@ func taskScrubChecksLive(ctx context.Context, task *Task) error {
 	return odb.Commit()
 }
 
+func taskScrubAvailabilityDaily(ctx context.Context, task *Task) error {
+	odb, err := task.DBX(ctx)
+	if err != nil {
+		return err
+	}
+	defer odb.Rollback()
+
+	if err := odb.PurgeAvailabilityDailyOrphans(ctx); err != nil {
+		return err
+	}
+	if err := odb.Session.NotifyChanges(ctx); err != nil {
+		return err
+	}
+	return odb.Commit()
+}
+
 func taskScrubNodeHBA(ctx context.Context, task *Task) error {
 	odb, err := task.DBX(ctx)
 	if err != nil {
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/spf13/viper"
+
+	"github.com/opensvc/oc3/archive"
 )
 
 var TaskTrim = Task{
@@ -17,12 +19,36 @@ var TaskTrim = Task{
 }
 
 // deleteBatched executes the deletion query in batches until no rows are affected.
+//
+// The rows of the tables with scheduler.task.trim.table.<table>.archive set
+// are archived before deletion. The tables managed by the partition task are
+// skipped.
 func deleteBatched(ctx context.Context, task *Task, table, dateCol, orderbyCol, where string) error {
 	batchSize := getBatchSize(table)
 	retention := getRetentionDays(table)
 	odb := task.DB()
 
-	totalDeleted, batchCount, err := odb.DeleteBatched(ctx, table, dateCol, orderbyCol, batchSize, retention, where)
+	if managed, err := isPartitionManaged(ctx, odb, table); err != nil {
+		task.Warnf("%s: fall back to batched deletes: %s", table, err)
+	} else if managed {
+		task.Debugf("%s: expired partitions dropped by the partition task", table)
+		return nil
+	}
+
+	var (
+		totalDeleted, batchCount int64
+		err                      error
+	)
+	if isArchived(table) {
+		sink := &archive.Sink{Dir: viper.GetString("scheduler.task.trim.archive.directory")}
+		archiveFn := func(rows []map[string]any) error {
+			return archiveRows(sink, table, dateCol, rows)
+		}
+		totalDeleted, batchCount, err = odb.ArchiveBatched(ctx, table, dateCol, orderbyCol, batchSize, retention, where, archiveFn)
+	} else {
+		totalDeleted, batchCount, err = odb.DeleteBatched(ctx, table, dateCol, orderbyCol, batchSize, retention, where)
+	}
+	task.AddRowsAffected(totalDeleted)
 	if err != nil {
 		return err
 	}
@@ -31,6 +57,33 @@ func deleteBatched(ctx context.Context, task *Task, table, dateCol, orderbyCol,
 	return nil
 }
 
+// archiveRows writes the rows to the archive files of the days of their
+// dateCol value.
+func archiveRows(sink *archive.Sink, table, dateCol string, rows []map[string]any) error {
+	var days []time.Time
+	byDay := make(map[time.Time][]map[string]any)
+	for _, row := range rows {
+		var day time.Time
+		if tm, ok := row[dateCol].(time.Time); ok && !tm.IsZero() {
+			day = time.Date(tm.Year(), tm.Month(), tm.Day(), 0, 0, 0, 0, time.Local)
+		}
+		if _, ok := byDay[day]; !ok {
+			days = append(days, day)
+		}
+		byDay[day] = append(byDay[day], row)
+	}
+	for _, day := range days {
+		if err := sink.Write(table, day, byDay[day]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isArchived(table string) bool {
+	return viper.GetBool(fmt.Sprintf("scheduler.task.trim.table.%s.archive", table))
+}
+
 func getBatchSize(table string) int64 {
 	n := viper.GetInt64(fmt.Sprintf("scheduler.task.trim.table.%s.batch_size", table))
 	if n == 0 {
@@ -61,13 +114,16 @@ func taskTrimRun(ctx context.Context, task *Task) (err error) {
 	err = errors.Join(err, deleteBatched(ctx, task, "svcmon_log", "mon_end", "id", ""))
 	err = errors.Join(err, deleteBatched(ctx, task, "svcactions", "begin", "id", ""))
 	err = errors.Join(err, deleteBatched(ctx, task, "dashboard_events", "dash_end", "id", "AND NOT `dash_end` IS NULL"))
+	err = errors.Join(err, deleteBatched(ctx, task, "dashboard_ack", "ack_end", "id", ""))
 	err = errors.Join(err, deleteBatched(ctx, task, "packages", "pkg_updated", "id", ""))
 	err = errors.Join(err, deleteBatched(ctx, task, "patches", "patch_updated", "id", ""))
 	err = errors.Join(err, deleteBatched(ctx, task, "node_ip", "updated", "id", ""))
 	err = errors.Join(err, deleteBatched(ctx, task, "node_users", "updated", "id", ""))
 	err = errors.Join(err, deleteBatched(ctx, task, "node_groups", "updated", "id", ""))
+	err = errors.Join(err, deleteBatched(ctx, task, "node_properties_log", "changed_at", "id", ""))
 	err = errors.Join(err, deleteBatched(ctx, task, "comp_run_ruleset", "date", "id", ""))
 	err = errors.Join(err, deleteBatched(ctx, task, "links", "link_last_consultation_date", "id", ""))
 	err = errors.Join(err, deleteBatched(ctx, task, "services_log", "svc_end", "id", ""))
+	err = errors.Join(err, deleteBatched(ctx, task, "oc3_scheduler_run", "begin_at", "id", ""))
 	return
 }
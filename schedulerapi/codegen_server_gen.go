@@ -0,0 +1,405 @@
+// Package schedulerapi provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.7.1 DO NOT EDIT.
+package schedulerapi
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
+)
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /openapi.json)
+	GetSwagger(ctx echo.Context) error
+
+	// (GET /runs)
+	GetRuns(ctx echo.Context, params GetRunsParams) error
+
+	// (GET /tasks)
+	GetTasks(ctx echo.Context) error
+
+	// (GET /tasks/{task_name})
+	GetTask(ctx echo.Context, taskName InPathTaskName) error
+
+	// (POST /tasks/{task_name}/disable)
+	PostTaskDisable(ctx echo.Context, taskName InPathTaskName) error
+
+	// (POST /tasks/{task_name}/enable)
+	PostTaskEnable(ctx echo.Context, taskName InPathTaskName) error
+
+	// (POST /tasks/{task_name}/run)
+	PostTaskRun(ctx echo.Context, taskName InPathTaskName) error
+
+	// (GET /tasks/{task_name}/runs)
+	GetTaskRuns(ctx echo.Context, taskName InPathTaskName, params GetTaskRunsParams) error
+
+	// (GET /version)
+	GetVersion(ctx echo.Context) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetSwagger converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSwagger(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSwagger(ctx)
+	return err
+}
+
+// GetRuns converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRuns(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRunsParams
+	// ------------- Optional query parameter "task_name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "task_name", ctx.QueryParams(), &params.TaskName, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter task_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "status", ctx.QueryParams(), &params.Status, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRuns(ctx, params)
+	return err
+}
+
+// GetTasks converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTasks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTasks(ctx)
+	return err
+}
+
+// GetTask converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTask(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "task_name" -------------
+	var taskName InPathTaskName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "task_name", ctx.Param("task_name"), &taskName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter task_name: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTask(ctx, taskName)
+	return err
+}
+
+// PostTaskDisable converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskDisable(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "task_name" -------------
+	var taskName InPathTaskName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "task_name", ctx.Param("task_name"), &taskName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter task_name: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskDisable(ctx, taskName)
+	return err
+}
+
+// PostTaskEnable converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskEnable(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "task_name" -------------
+	var taskName InPathTaskName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "task_name", ctx.Param("task_name"), &taskName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter task_name: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskEnable(ctx, taskName)
+	return err
+}
+
+// PostTaskRun converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskRun(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "task_name" -------------
+	var taskName InPathTaskName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "task_name", ctx.Param("task_name"), &taskName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter task_name: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskRun(ctx, taskName)
+	return err
+}
+
+// GetTaskRuns converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskRuns(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "task_name" -------------
+	var taskName InPathTaskName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "task_name", ctx.Param("task_name"), &taskName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter task_name: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaskRunsParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "status", ctx.QueryParams(), &params.Status, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskRuns(ctx, taskName, params)
+	return err
+}
+
+// GetVersion converts echo context to params.
+func (w *ServerInterfaceWrapper) GetVersion(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetVersion(ctx)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlersOptions configures RegisterHandlersWithOptions.
+type RegisterHandlersOptions struct {
+	// BaseURL is prepended to every registered path so the API can be served
+	// under a prefix.
+	BaseURL string
+	// OperationMiddlewares lets the caller attach per-operation middleware at
+	// registration time. The map key is the OpenAPI `operationId` value as it
+	// appears in the spec (the raw, un-normalized form). Operations that have
+	// no entry are registered with no extra middleware. A nil map disables
+	// per-operation middleware entirely.
+	OperationMiddlewares map[string][]echo.MiddlewareFunc
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, RegisterHandlersOptions{})
+}
+
+// RegisterHandlersWithBaseURL registers handlers and prepends BaseURL to the
+// paths so the API can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+	RegisterHandlersWithOptions(router, si, RegisterHandlersOptions{BaseURL: baseURL})
+}
+
+// RegisterHandlersWithOptions registers handlers using the supplied options,
+// including any per-operation middleware.
+func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options RegisterHandlersOptions) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(options.BaseURL+"/openapi.json", wrapper.GetSwagger, options.OperationMiddlewares["GetSwagger"]...)
+	router.GET(options.BaseURL+"/runs", wrapper.GetRuns, options.OperationMiddlewares["GetRuns"]...)
+	router.GET(options.BaseURL+"/tasks", wrapper.GetTasks, options.OperationMiddlewares["GetTasks"]...)
+	router.GET(options.BaseURL+"/tasks/:task_name", wrapper.GetTask, options.OperationMiddlewares["GetTask"]...)
+	router.POST(options.BaseURL+"/tasks/:task_name/disable", wrapper.PostTaskDisable, options.OperationMiddlewares["PostTaskDisable"]...)
+	router.POST(options.BaseURL+"/tasks/:task_name/enable", wrapper.PostTaskEnable, options.OperationMiddlewares["PostTaskEnable"]...)
+	router.POST(options.BaseURL+"/tasks/:task_name/run", wrapper.PostTaskRun, options.OperationMiddlewares["PostTaskRun"]...)
+	router.GET(options.BaseURL+"/tasks/:task_name/runs", wrapper.GetTaskRuns, options.OperationMiddlewares["GetTaskRuns"]...)
+	router.GET(options.BaseURL+"/version", wrapper.GetVersion, options.OperationMiddlewares["GetVersion"]...)
+
+}
+
+// Base64 encoded, compressed with deflate, json marshaled OpenAPI spec.
+// Stored as a slice of fixed-width chunks rather than one concatenated
+// const string: with thousands of chunks the chained `+` fold is several
+// times slower for the Go compiler than parsing a slice literal.
+var swaggerSpec = []string{
+	"7Flfb9s2EP8qBLdH1nbadED9VmztUKzouibbS2IEtHS22EhHlTzlzwJ/9+FI2ZItOXbSJu3WPSUSj7wf",
+	"7373T76RiS1Ki4Dk5fhGltrpAghceDL4XlN2rP35O11AfCPHstSUSSUxvJOk/flZ+F9JB58q4yCVY3IV",
+	"KOmTDArNG+m6ZGFPzuBcLhZKGvyjAnf91hSGWCIFnzhTkrGs4zgDUegrU1SFwKqYghN2JgDJGfCCrHBA",
+	"lcOBVBHTJz6rAZWHU9sAUpjpKic5fj5Ssj5Zjg9GI340WD+qJVCDBHNwbaRHpKnyKytsaPRxta0SkM88",
+	"ka5C5Fsrac+lkjNtckjlRG03SsfkG8raNt9u4wU7xJcWPQTYh6MD/pNYJMBgc12WuUk023z40bPhb1rn",
+	"/ehgJsfyh2HDkWFc9cP3zk5zKKKWddf9ibqizDrzN6RyoeTh6NljqH1t3dSkKWDUefgYOt9ZEq9thfU9",
+	"XzyGzp8tznKTEKt8Pho9hso3SOBQ5+II3AU48co5G0Oj3sxnL/dzFnG2BEcm0o7gqifAX4qsKjQ+caBT",
+	"Pc1BwFWZawzIhS8hMTOTcKBTZrywSVI5B5gApwHK4BTLqG9wirIvkppUdBIRNPFmpx8h2u9DhV28U5gb",
+	"PNMB88y6gv+TqSZ4QiZE3IYyJQHTM91zxwo9kLjMTA6MWXDYCuNFkxD2PD/YuxvhSpp0DaVB+ulQdpMY",
+	"myPwoouRcWXWE2eT2raCvZpWOThRbxOUaRJO4+oafTCdvfRnejaDhGBfXH6VVO+aLVUrC45vdjDApFJt",
+	"VKpoD9W4WzU5fP0mW5jz1njqsifVFKxsCAq/K+aYf4vV6do5fd2BHg7sg8BFoqs/yUyeOsA1DF3TrWlU",
+	"0viz1HiOw7QlP7U2B421wNIx29aXrEl7SOYqEGa2FgSetCNIhcUtnPPgLgzOY7SzRcBTO9hb2nPtifHt",
+	"ae+l+J2CfAvRlES4ap+2fnNOABbz687tl8aK9zd+ee29k8LyhK5OLUpwxqYiN+cgDjIlrBNaJM4iZ1kH",
+	"3rNgX0SZAmxF/VkCriCp+FnUYqpWMCpGfmcObnUrAXWjbJ19G1RaY55q2L0tIL5AUPIx94/KC3DBuh0M",
+	"rQW40kXJnpOjwWhwsNN2y61dfcwDSCpn6PqI4dcVTHuTvKwoW1X/EC/8ttGVEZWx2BucWZYkQwGULQH9",
+	"RdIKSV0a2bqaPBiMBiO+LYvy4lg+C69UGA4CiGG9Nli2IXMInmGbhCr/JpVj+SvQ0aWeczXY6Faf3rG3",
+	"2bRMp4v5/bfYpR1sI8BK/ZCFms51l+yzVit2uywLLULlmnt2bFlNc5PICb8bugp9y07r4JnXIYEU1pNw",
+	"kABSTCa8jQu3zvNuEvWxPerY/APrUmvj3kk/+EZkuDmcLNS+W+rRaf8NcSpcTD6TEztqQUgW3zZTltEd",
+	"/NOK65PJYtLwKFAnsog5sQeNyJZPcriAPJDIi0tDGS8YtyKR4hJNoAQXOKaZ0JieIhdPftrCrOOg/wH9",
+	"tsry/wXHRW+1PDe8WXWpi61ePMrspdAbPrzdb2IPt90jIax9H3rQcI1V+bFdXn9H2CV7+DXoMax7JlZa",
+	"Wt/Dk1+iQIcqA3Hc13FzT27Lpk21eIoxJQQmBVaJ0uZ5H4feWx9IVOt8AC4ddi8YYK5ax++VB4C30+AV",
+	"3pUF2tFn0uAVPjILAL9vEtTTbz8DPlTYcb9AexkpEJ6KypOYwuZgCqd462Qu6sH8Ni7w1P3lifB0CxG4",
+	"4NWgvh061N+Gd8m++FrUuePgsZo5YiLZ3lLcc85Y9/3/Y8a/MFu1RpLWl49eisWf8vgjg1iK9tDpr9XS",
+	"g/lqqX2rr+415Ce61FOTm/AhaLKIJuTfUWIwVC6XYzkYysVk8c8A",
+}
+
+// decodeSpec returns the embedded OpenAPI spec as raw JSON bytes,
+// after base64-decoding and flate-decompressing the embedded blob.
+func decodeSpec() ([]byte, error) {
+	encoded := strings.Join(swaggerSpec, "")
+	compressed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr := flate.NewReader(bytes.NewReader(compressed))
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(zr); err != nil {
+		return nil, fmt.Errorf("read flate: %w", err)
+	}
+	if err := zr.Close(); err != nil {
+		return nil, fmt.Errorf("close flate reader: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cache of the decoded OpenAPI spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSpec returns the OpenAPI specification corresponding to the generated
+// code in this file. External references in the spec are resolved through
+// PathToRawSpec; externally-referenced files must be embedded in their
+// corresponding Go packages (via the import-mapping feature). URL-based
+// external refs are not supported.
+func GetSpec() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
+
+// GetSpecJSON returns the raw JSON bytes of the embedded OpenAPI
+// specification: decompressed but not unmarshaled. External references
+// are not resolved here; the bytes are the spec exactly as embedded by
+// codegen. The result is cached at package init time, so repeated calls
+// are cheap.
+func GetSpecJSON() ([]byte, error) {
+	return rawSpec()
+}
+
+// GetSwagger returns the OpenAPI specification corresponding to the
+// generated code in this file.
+//
+// Deprecated: GetSwagger predates kin-openapi renaming openapi3.Swagger
+// to openapi3.T. Use [GetSpec] instead. This wrapper is retained for
+// backwards compatibility.
+func GetSwagger() (*openapi3.T, error) {
+	return GetSpec()
+}
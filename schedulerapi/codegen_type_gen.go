@@ -0,0 +1,213 @@
+// Package schedulerapi provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.7.1 DO NOT EDIT.
+package schedulerapi
+
+import (
+	"time"
+)
+
+const (
+	BasicAuthScopes basicAuthContextKey = "basicAuth.Scopes"
+)
+
+// Defines values for RunStatus.
+const (
+	RunStatusFailed  RunStatus = "failed"
+	RunStatusOk      RunStatus = "ok"
+	RunStatusRunning RunStatus = "running"
+)
+
+// Valid indicates whether the value is a known member of the RunStatus enum.
+func (e RunStatus) Valid() bool {
+	switch e {
+	case RunStatusFailed:
+		return true
+	case RunStatusOk:
+		return true
+	case RunStatusRunning:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for InQueryStatus.
+const (
+	InQueryStatusFailed  InQueryStatus = "failed"
+	InQueryStatusOk      InQueryStatus = "ok"
+	InQueryStatusRunning InQueryStatus = "running"
+)
+
+// Valid indicates whether the value is a known member of the InQueryStatus enum.
+func (e InQueryStatus) Valid() bool {
+	switch e {
+	case InQueryStatusFailed:
+		return true
+	case InQueryStatusOk:
+		return true
+	case InQueryStatusRunning:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetRunsParamsStatus.
+const (
+	GetRunsParamsStatusFailed  GetRunsParamsStatus = "failed"
+	GetRunsParamsStatusOk      GetRunsParamsStatus = "ok"
+	GetRunsParamsStatusRunning GetRunsParamsStatus = "running"
+)
+
+// Valid indicates whether the value is a known member of the GetRunsParamsStatus enum.
+func (e GetRunsParamsStatus) Valid() bool {
+	switch e {
+	case GetRunsParamsStatusFailed:
+		return true
+	case GetRunsParamsStatusOk:
+		return true
+	case GetRunsParamsStatusRunning:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetTaskRunsParamsStatus.
+const (
+	GetTaskRunsParamsStatusFailed  GetTaskRunsParamsStatus = "failed"
+	GetTaskRunsParamsStatusOk      GetTaskRunsParamsStatus = "ok"
+	GetTaskRunsParamsStatusRunning GetTaskRunsParamsStatus = "running"
+)
+
+// Valid indicates whether the value is a known member of the GetTaskRunsParamsStatus enum.
+func (e GetTaskRunsParamsStatus) Valid() bool {
+	switch e {
+	case GetTaskRunsParamsStatusFailed:
+		return true
+	case GetTaskRunsParamsStatusOk:
+		return true
+	case GetTaskRunsParamsStatusRunning:
+		return true
+	default:
+		return false
+	}
+}
+
+// Problem defines model for Problem.
+type Problem struct {
+	// Text A human-readable explanation specific to this occurrence of the
+	// problem.
+	Text string `json:"text"`
+}
+
+// Run defines model for Run.
+type Run struct {
+	BeginAt time.Time `json:"begin_at"`
+
+	// EndAt unset while the task is running
+	EndAt *time.Time `json:"end_at,omitempty"`
+	Error *string    `json:"error,omitempty"`
+	Id    int64      `json:"id"`
+
+	// Replica the hostname of the scheduler replica that ran the task
+	Replica      string    `json:"replica"`
+	RowsAffected int64     `json:"rows_affected"`
+	Status       RunStatus `json:"status"`
+	TaskName     string    `json:"task_name"`
+}
+
+// RunStatus defines model for Run.Status.
+type RunStatus string
+
+// RunList defines model for RunList.
+type RunList struct {
+	Data []Run `json:"data"`
+}
+
+// Task defines model for Task.
+type Task struct {
+	Children   []string `json:"children"`
+	IsDisabled bool     `json:"is_disabled"`
+	IsRunning  bool     `json:"is_running"`
+
+	// IsScheduled true if the task is started on the scheduler replica serving the
+	// request
+	IsScheduled bool       `json:"is_scheduled"`
+	LastRun     *Run       `json:"last_run,omitempty"`
+	LastRunAt   *time.Time `json:"last_run_at,omitempty"`
+	Name        string     `json:"name"`
+
+	// NextRunAt set only if the task is scheduled on this replica
+	NextRunAt *time.Time `json:"next_run_at,omitempty"`
+
+	// Schedule a period like 1h, or a cron expression
+	Schedule string `json:"schedule"`
+
+	// Timeout the execution timeout, like 10m0s
+	Timeout string `json:"timeout"`
+}
+
+// TaskList defines model for TaskList.
+type TaskList struct {
+	Data []Task `json:"data"`
+}
+
+// Version defines model for version.
+type Version struct {
+	Version string `json:"version"`
+}
+
+// InPathTaskName defines model for inPathTaskName.
+type InPathTaskName = string
+
+// InQueryLimit defines model for inQueryLimit.
+type InQueryLimit = int
+
+// InQueryStatus defines model for inQueryStatus.
+type InQueryStatus string
+
+// InQueryTaskName defines model for inQueryTaskName.
+type InQueryTaskName = string
+
+// N401 defines model for 401.
+type N401 = Problem
+
+// N403 defines model for 403.
+type N403 = Problem
+
+// N404 defines model for 404.
+type N404 = Problem
+
+// N409 defines model for 409.
+type N409 = Problem
+
+// N500 defines model for 500.
+type N500 = Problem
+
+// basicAuthContextKey is the context key for basicAuth security scheme
+type basicAuthContextKey string
+
+// GetRunsParams defines parameters for GetRuns.
+type GetRunsParams struct {
+	TaskName *InQueryTaskName     `form:"task_name,omitempty" json:"task_name,omitempty"`
+	Status   *GetRunsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit The maximum number of entries to return.
+	Limit *InQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetRunsParamsStatus defines parameters for GetRuns.
+type GetRunsParamsStatus string
+
+// GetTaskRunsParams defines parameters for GetTaskRuns.
+type GetTaskRunsParams struct {
+	Status *GetTaskRunsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit The maximum number of entries to return.
+	Limit *InQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetTaskRunsParamsStatus defines parameters for GetTaskRuns.
+type GetTaskRunsParamsStatus string
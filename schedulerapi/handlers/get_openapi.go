@@ -0,0 +1,14 @@
+package schedulerhandlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (a *Api) GetSwagger(ctx echo.Context) error {
+	if !a.UI {
+		return JSONProblem(ctx, http.StatusUnauthorized, "serve schema is disabled by configuration (scheduler.ui.enable = false)")
+	}
+	return ctx.JSON(http.StatusOK, SCHEMA)
+}
@@ -0,0 +1,40 @@
+package schedulerhandlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/schedulerapi"
+	"github.com/opensvc/oc3/util/echolog"
+	"github.com/opensvc/oc3/util/logkey"
+)
+
+// GetRuns handles GET /runs
+func (a *Api) GetRuns(c echo.Context, params schedulerapi.GetRunsParams) error {
+	log := echolog.GetLogHandler(c, "GetRuns")
+	if !IsManager(c) {
+		return JSONManagerProblem(c)
+	}
+	ctx, cancel := context.WithTimeout(c.Request().Context(), a.SyncTimeout)
+	defer cancel()
+
+	var taskName, status string
+	if params.TaskName != nil {
+		taskName = *params.TaskName
+	}
+	if params.Status != nil {
+		status = string(*params.Status)
+	}
+	limit := defaultRunsLimit
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = min(*params.Limit, maxRunsLimit)
+	}
+	runs, err := a.Scheduler.Runs(ctx, taskName, status, limit)
+	if err != nil {
+		log.Error("cannot get runs", logkey.Error, err)
+		return JSONProblem(c, http.StatusInternalServerError, "cannot get runs")
+	}
+	return c.JSON(http.StatusOK, toApiRuns(runs))
+}
@@ -0,0 +1,33 @@
+package schedulerhandlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/util/echolog"
+	"github.com/opensvc/oc3/util/logkey"
+)
+
+// GetTask handles GET /tasks/{task_name}
+func (a *Api) GetTask(c echo.Context, taskName string) error {
+	log := echolog.GetLogHandler(c, "GetTask")
+	if !IsManager(c) {
+		return JSONManagerProblem(c)
+	}
+	ctx, cancel := context.WithTimeout(c.Request().Context(), a.SyncTimeout)
+	defer cancel()
+
+	infos, err := a.Scheduler.TaskInfos(ctx)
+	if err != nil {
+		log.Error("cannot get tasks", logkey.Error, err)
+		return JSONProblem(c, http.StatusInternalServerError, "cannot get tasks")
+	}
+	for _, info := range infos {
+		if info.Name == taskName {
+			return c.JSON(http.StatusOK, toApiTask(info))
+		}
+	}
+	return JSONProblemf(c, http.StatusNotFound, "task %s not found", taskName)
+}
@@ -0,0 +1,41 @@
+package schedulerhandlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/scheduler"
+	"github.com/opensvc/oc3/schedulerapi"
+	"github.com/opensvc/oc3/util/echolog"
+	"github.com/opensvc/oc3/util/logkey"
+)
+
+// GetTaskRuns handles GET /tasks/{task_name}/runs
+func (a *Api) GetTaskRuns(c echo.Context, taskName string, params schedulerapi.GetTaskRunsParams) error {
+	log := echolog.GetLogHandler(c, "GetTaskRuns")
+	if !IsManager(c) {
+		return JSONManagerProblem(c)
+	}
+	if task := scheduler.Tasks.Get(taskName); task.IsZero() {
+		return JSONProblemf(c, http.StatusNotFound, "task %s not found", taskName)
+	}
+	ctx, cancel := context.WithTimeout(c.Request().Context(), a.SyncTimeout)
+	defer cancel()
+
+	var status string
+	if params.Status != nil {
+		status = string(*params.Status)
+	}
+	limit := defaultRunsLimit
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = min(*params.Limit, maxRunsLimit)
+	}
+	runs, err := a.Scheduler.Runs(ctx, taskName, status, limit)
+	if err != nil {
+		log.Error("cannot get task runs", "task_name", taskName, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot get task %s runs", taskName)
+	}
+	return c.JSON(http.StatusOK, toApiRuns(runs))
+}
@@ -0,0 +1,33 @@
+package schedulerhandlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/schedulerapi"
+	"github.com/opensvc/oc3/util/echolog"
+	"github.com/opensvc/oc3/util/logkey"
+)
+
+// GetTasks handles GET /tasks
+func (a *Api) GetTasks(c echo.Context) error {
+	log := echolog.GetLogHandler(c, "GetTasks")
+	if !IsManager(c) {
+		return JSONManagerProblem(c)
+	}
+	ctx, cancel := context.WithTimeout(c.Request().Context(), a.SyncTimeout)
+	defer cancel()
+
+	infos, err := a.Scheduler.TaskInfos(ctx)
+	if err != nil {
+		log.Error("cannot get tasks", logkey.Error, err)
+		return JSONProblem(c, http.StatusInternalServerError, "cannot get tasks")
+	}
+	data := make([]schedulerapi.Task, len(infos))
+	for i, info := range infos {
+		data[i] = toApiTask(info)
+	}
+	return c.JSON(http.StatusOK, schedulerapi.TaskList{Data: data})
+}
@@ -0,0 +1,61 @@
+package schedulerhandlers
+
+import (
+	"time"
+
+	"github.com/opensvc/oc3/scheduler"
+	"github.com/opensvc/oc3/schedulerapi"
+)
+
+func toApiTask(info scheduler.TaskInfo) schedulerapi.Task {
+	task := schedulerapi.Task{
+		Name:        info.Name,
+		Schedule:    info.Schedule,
+		Timeout:     info.Timeout.String(),
+		IsDisabled:  info.IsDisabled,
+		IsScheduled: info.IsScheduled,
+		IsRunning:   info.IsRunning,
+		LastRunAt:   timePtr(info.LastRunAt),
+		NextRunAt:   timePtr(info.NextRunAt),
+		Children:    info.Children,
+	}
+	if task.Children == nil {
+		task.Children = []string{}
+	}
+	if info.LastRun != nil {
+		r := toApiRun(*info.LastRun)
+		task.LastRun = &r
+	}
+	return task
+}
+
+func toApiRun(r scheduler.Run) schedulerapi.Run {
+	run := schedulerapi.Run{
+		Id:           r.ID,
+		TaskName:     r.TaskName,
+		Replica:      r.Replica,
+		BeginAt:      r.BeginAt,
+		EndAt:        timePtr(r.EndAt),
+		Status:       schedulerapi.RunStatus(r.Status),
+		RowsAffected: r.RowsAffected,
+	}
+	if r.Error != "" {
+		run.Error = &r.Error
+	}
+	return run
+}
+
+func toApiRuns(l []scheduler.Run) schedulerapi.RunList {
+	data := make([]schedulerapi.Run, len(l))
+	for i, r := range l {
+		data[i] = toApiRun(r)
+	}
+	return schedulerapi.RunList{Data: data}
+}
+
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
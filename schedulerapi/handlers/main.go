@@ -0,0 +1,37 @@
+package schedulerhandlers
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/opensvc/oc3/scheduler"
+	"github.com/opensvc/oc3/schedulerapi"
+)
+
+type (
+	Api struct {
+		DB        *sql.DB
+		Scheduler *scheduler.Scheduler
+		UI        bool
+
+		// SyncTimeout is the timeout for synchronous api calls
+		SyncTimeout time.Duration
+	}
+)
+
+const (
+	defaultRunsLimit = 50
+	maxRunsLimit     = 1000
+)
+
+var (
+	SCHEMA openapi3.T
+)
+
+func init() {
+	if schema, err := schedulerapi.GetSwagger(); err == nil {
+		SCHEMA = *schema
+	}
+}
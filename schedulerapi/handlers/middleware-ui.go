@@ -0,0 +1,20 @@
+package schedulerhandlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/allenai/go-swaggerui"
+	"github.com/labstack/echo/v4"
+)
+
+func UIMiddleware(_ context.Context, prefix, specUrl string) echo.MiddlewareFunc {
+	uiHandler := http.StripPrefix(prefix, swaggerui.Handler(specUrl))
+	echoUI := echo.WrapHandler(uiHandler)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			return echoUI(c)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package schedulerhandlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/shaj13/go-guardian/v2/auth/strategies/union"
+)
+
+// AuthMiddleware returns auth middleware that authenticates requests from strategies.
+func AuthMiddleware(strategies union.Union) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			_, user, err := strategies.AuthenticateRequest(c.Request())
+			if err != nil {
+				return JSONProblem(c, http.StatusUnauthorized, err.Error())
+			}
+			c.Set("groups", user.GetGroups())
+			c.Set("user", user)
+			return next(c)
+		}
+	}
+}
+
+func UserGroupsFromContext(c echo.Context) []string {
+	groups, ok := c.Get("groups").([]string)
+	if ok {
+		return groups
+	}
+	return nil
+}
+
+func IsManager(c echo.Context) bool {
+	groups := UserGroupsFromContext(c)
+	for _, g := range groups {
+		if g == "Manager" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,34 @@
+package schedulerhandlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/scheduler"
+	"github.com/opensvc/oc3/util/echolog"
+	"github.com/opensvc/oc3/util/logkey"
+)
+
+// PostTaskEnable handles POST /tasks/{task_name}/enable
+func (a *Api) PostTaskEnable(c echo.Context, taskName string) error {
+	log := echolog.GetLogHandler(c, "PostTaskEnable")
+	if !IsManager(c) {
+		return JSONManagerProblem(c)
+	}
+	ctx, cancel := context.WithTimeout(c.Request().Context(), a.SyncTimeout)
+	defer cancel()
+
+	log.Info("called", "task_name", taskName)
+	err := a.Scheduler.SetDisabled(ctx, taskName, false)
+	switch {
+	case errors.Is(err, scheduler.ErrTaskNotFound):
+		return JSONProblemf(c, http.StatusNotFound, "task %s not found", taskName)
+	case err != nil:
+		log.Error("cannot enable task", "task_name", taskName, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot enable task %s", taskName)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
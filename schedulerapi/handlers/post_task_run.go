@@ -0,0 +1,32 @@
+package schedulerhandlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/scheduler"
+	"github.com/opensvc/oc3/util/echolog"
+	"github.com/opensvc/oc3/util/logkey"
+)
+
+// PostTaskRun handles POST /tasks/{task_name}/run
+func (a *Api) PostTaskRun(c echo.Context, taskName string) error {
+	log := echolog.GetLogHandler(c, "PostTaskRun")
+	if !IsManager(c) {
+		return JSONManagerProblem(c)
+	}
+	log.Info("called", "task_name", taskName)
+	err := a.Scheduler.Trigger(taskName)
+	switch {
+	case errors.Is(err, scheduler.ErrTaskNotFound):
+		return JSONProblemf(c, http.StatusNotFound, "task %s not found", taskName)
+	case errors.Is(err, scheduler.ErrTaskNotScheduled):
+		return JSONProblemf(c, http.StatusConflict, "task %s: %s", taskName, err)
+	case err != nil:
+		log.Error("cannot trigger task", "task_name", taskName, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot trigger task %s", taskName)
+	}
+	return c.NoContent(http.StatusAccepted)
+}
@@ -0,0 +1,22 @@
+package schedulerhandlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/schedulerapi"
+)
+
+func JSONProblemf(ctx echo.Context, code int, format string, args ...any) error {
+	return JSONProblem(ctx, code, fmt.Sprintf(format, args...))
+}
+
+func JSONProblem(ctx echo.Context, code int, s string) error {
+	return ctx.JSON(code, schedulerapi.Problem{Text: s})
+}
+
+func JSONManagerProblem(c echo.Context) error {
+	return JSONProblem(c, http.StatusForbidden, "expecting Manager group membership")
+}
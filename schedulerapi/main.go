@@ -0,0 +1,4 @@
+//go:generate go tool oapi-codegen -config codegen_server.yaml ./api.yaml
+//go:generate go tool oapi-codegen -config codegen_type.yaml ./api.yaml
+
+package schedulerapi
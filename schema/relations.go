@@ -1,6 +1,9 @@
 package schema
 
 func init() {
+	ActionQueueNodeID.Ref = NodesNodeID
+	ActionQueueSvcID.Ref = ServicesSvcID
+
 	AppsPublicationsAppID.Ref = AppsID
 	AppsPublicationsGroupID.Ref = AuthGroupID
 	AppsResponsiblesAppID.Ref = AppsID
@@ -14,6 +17,8 @@ func init() {
 
 	NodeIPNodeID.Ref = NodesNodeID
 
+	PackagesNodeID.Ref = NodesNodeID
+
 	SvcmonSvcID.Ref = ServicesSvcID
 	SvcmonLogSvcID.Ref = ServicesSvcID
 }
@@ -53,6 +53,7 @@ var (
 	TCompStatus                   = &Table{Name: "comp_status"}
 	TCompSvcStatus                = &Table{Name: "comp_svc_status"}
 	TDashboard                    = &Table{Name: "dashboard"}
+	TDashboardAck                 = &Table{Name: "dashboard_ack"}
 	TDashboardEvents              = &Table{Name: "dashboard_events"}
 	TDashboardRef                 = &Table{Name: "dashboard_ref"}
 	TDigit                        = &Table{Name: "digit"}
@@ -99,11 +100,13 @@ var (
 	TNodeHBA                      = &Table{Name: "node_hba"}
 	TNodeHW                       = &Table{Name: "node_hw"}
 	TNodeIP                       = &Table{Name: "node_ip"}
+	TNodePropertiesLog            = &Table{Name: "node_properties_log"}
 	TNodePW                       = &Table{Name: "node_pw"}
 	TNodeTags                     = &Table{Name: "node_tags"}
 	TNodeUsers                    = &Table{Name: "node_users"}
 	TObsolescence                 = &Table{Name: "obsolescence"}
 	TOc3Scheduler                 = &Table{Name: "oc3_scheduler"}
+	TOc3SchedulerRun              = &Table{Name: "oc3_scheduler_run"}
 	TPackages                     = &Table{Name: "packages"}
 	TPatches                      = &Table{Name: "patches"}
 	TPkgSigProvider               = &Table{Name: "pkg_sig_provider"}
@@ -189,6 +192,9 @@ var (
 	ActionQueueConnectTo    = &Col{T: TActionQueue, Name: "connect_to", Nullable: true}
 	ActionQueueNodeID       = &Col{T: TActionQueue, Name: "node_id", Nullable: true}
 	ActionQueueSvcID        = &Col{T: TActionQueue, Name: "svc_id", Nullable: true}
+	ActionQueueAction       = &Col{T: TActionQueue, Name: "action", Nullable: true}
+	ActionQueueActionParams = &Col{T: TActionQueue, Name: "action_params", Nullable: true}
+	ActionQueueTransport    = &Col{T: TActionQueue, Name: "transport", Nullable: true}
 )
 
 // Columns of alerts
@@ -648,6 +654,20 @@ var (
 	DashboardDashInstance = &Col{T: TDashboard, Name: "dash_instance", Nullable: true}
 )
 
+// Columns of dashboard_ack
+var (
+	DashboardAckID         = &Col{T: TDashboardAck, Name: "id", Nullable: false}
+	DashboardAckAlertKey   = &Col{T: TDashboardAck, Name: "alert_key", Nullable: false}
+	DashboardAckDashType   = &Col{T: TDashboardAck, Name: "dash_type", Nullable: false}
+	DashboardAckNodeID     = &Col{T: TDashboardAck, Name: "node_id", Nullable: false}
+	DashboardAckSvcID      = &Col{T: TDashboardAck, Name: "svc_id", Nullable: false}
+	DashboardAckAckBegin   = &Col{T: TDashboardAck, Name: "ack_begin", Nullable: false}
+	DashboardAckAckEnd     = &Col{T: TDashboardAck, Name: "ack_end", Nullable: false}
+	DashboardAckAckComment = &Col{T: TDashboardAck, Name: "ack_comment", Nullable: false}
+	DashboardAckAckedBy    = &Col{T: TDashboardAck, Name: "acked_by", Nullable: false}
+	DashboardAckAckedOn    = &Col{T: TDashboardAck, Name: "acked_on", Nullable: false}
+)
+
 // Columns of dashboard_events
 var (
 	DashboardEventsID        = &Col{T: TDashboardEvents, Name: "id", Nullable: false}
@@ -920,36 +940,46 @@ var (
 
 // Columns of hbmon
 var (
-	HbmonID         = &Col{T: THbmon, Name: "id", Nullable: false}
-	HbmonNodeID     = &Col{T: THbmon, Name: "node_id", Nullable: true}
-	HbmonPeerNodeID = &Col{T: THbmon, Name: "peer_node_id", Nullable: true}
-	HbmonHbType     = &Col{T: THbmon, Name: "hb_type", Nullable: true}
-	HbmonHbName     = &Col{T: THbmon, Name: "hb_name", Nullable: true}
-	HbmonHbDesc     = &Col{T: THbmon, Name: "hb_desc", Nullable: true}
-	HbmonHbStatus   = &Col{T: THbmon, Name: "hb_status", Nullable: true}
-	HbmonUpdated    = &Col{T: THbmon, Name: "updated", Nullable: false}
+	HbmonID          = &Col{T: THbmon, Name: "id", Nullable: false}
+	HbmonClusterID   = &Col{T: THbmon, Name: "cluster_id", Nullable: true}
+	HbmonNodeID      = &Col{T: THbmon, Name: "node_id", Nullable: true}
+	HbmonPeerNodeID  = &Col{T: THbmon, Name: "peer_node_id", Nullable: true}
+	HbmonDriver      = &Col{T: THbmon, Name: "driver", Nullable: true}
+	HbmonName        = &Col{T: THbmon, Name: "name", Nullable: true}
+	HbmonDesc        = &Col{T: THbmon, Name: "desc", Nullable: true}
+	HbmonState       = &Col{T: THbmon, Name: "state", Nullable: true}
+	HbmonBeating     = &Col{T: THbmon, Name: "beating", Nullable: true}
+	HbmonLastBeating = &Col{T: THbmon, Name: "last_beating", Nullable: false}
+	HbmonUpdated     = &Col{T: THbmon, Name: "updated", Nullable: false}
 )
 
 // Columns of hbmon_log
 var (
 	HbmonLogID         = &Col{T: THbmonLog, Name: "id", Nullable: false}
+	HbmonLogClusterID  = &Col{T: THbmonLog, Name: "cluster_id", Nullable: true}
 	HbmonLogNodeID     = &Col{T: THbmonLog, Name: "node_id", Nullable: true}
 	HbmonLogPeerNodeID = &Col{T: THbmonLog, Name: "peer_node_id", Nullable: true}
-	HbmonLogHbName     = &Col{T: THbmonLog, Name: "hb_name", Nullable: true}
-	HbmonLogHbStatus   = &Col{T: THbmonLog, Name: "hb_status", Nullable: true}
-	HbmonLogHbBegin    = &Col{T: THbmonLog, Name: "hb_begin", Nullable: false}
-	HbmonLogHbEnd      = &Col{T: THbmonLog, Name: "hb_end", Nullable: false}
+	HbmonLogName       = &Col{T: THbmonLog, Name: "name", Nullable: true}
+	HbmonLogDesc       = &Col{T: THbmonLog, Name: "desc", Nullable: true}
+	HbmonLogState      = &Col{T: THbmonLog, Name: "state", Nullable: true}
+	HbmonLogBeating    = &Col{T: THbmonLog, Name: "beating", Nullable: true}
+	HbmonLogBegin      = &Col{T: THbmonLog, Name: "begin", Nullable: false}
+	HbmonLogEnd        = &Col{T: THbmonLog, Name: "end", Nullable: false}
+	HbmonLogUpdated    = &Col{T: THbmonLog, Name: "updated", Nullable: false}
 )
 
 // Columns of hbmon_log_last
 var (
 	HbmonLogLastID         = &Col{T: THbmonLogLast, Name: "id", Nullable: false}
+	HbmonLogLastClusterID  = &Col{T: THbmonLogLast, Name: "cluster_id", Nullable: true}
 	HbmonLogLastNodeID     = &Col{T: THbmonLogLast, Name: "node_id", Nullable: true}
 	HbmonLogLastPeerNodeID = &Col{T: THbmonLogLast, Name: "peer_node_id", Nullable: true}
-	HbmonLogLastHbName     = &Col{T: THbmonLogLast, Name: "hb_name", Nullable: true}
-	HbmonLogLastHbStatus   = &Col{T: THbmonLogLast, Name: "hb_status", Nullable: true}
-	HbmonLogLastHbBegin    = &Col{T: THbmonLogLast, Name: "hb_begin", Nullable: false}
-	HbmonLogLastHbEnd      = &Col{T: THbmonLogLast, Name: "hb_end", Nullable: false}
+	HbmonLogLastName       = &Col{T: THbmonLogLast, Name: "name", Nullable: true}
+	HbmonLogLastState      = &Col{T: THbmonLogLast, Name: "state", Nullable: true}
+	HbmonLogLastBeating    = &Col{T: THbmonLogLast, Name: "beating", Nullable: true}
+	HbmonLogLastBegin      = &Col{T: THbmonLogLast, Name: "begin", Nullable: false}
+	HbmonLogLastEnd        = &Col{T: THbmonLogLast, Name: "end", Nullable: false}
+	HbmonLogLastUpdated    = &Col{T: THbmonLogLast, Name: "updated", Nullable: false}
 )
 
 // Columns of im_types
@@ -1177,6 +1207,16 @@ var (
 	NodeIPNodeID         = &Col{T: TNodeIP, Name: "node_id", Nullable: true}
 )
 
+// Columns of node_properties_log
+var (
+	NodePropertiesLogID        = &Col{T: TNodePropertiesLog, Name: "id", Nullable: false}
+	NodePropertiesLogNodeID    = &Col{T: TNodePropertiesLog, Name: "node_id", Nullable: false}
+	NodePropertiesLogProp      = &Col{T: TNodePropertiesLog, Name: "prop", Nullable: false}
+	NodePropertiesLogOldValue  = &Col{T: TNodePropertiesLog, Name: "old_value", Nullable: true}
+	NodePropertiesLogNewValue  = &Col{T: TNodePropertiesLog, Name: "new_value", Nullable: true}
+	NodePropertiesLogChangedAt = &Col{T: TNodePropertiesLog, Name: "changed_at", Nullable: false}
+)
+
 // Columns of node_pw
 var (
 	NodePWID      = &Col{T: TNodePW, Name: "id", Nullable: false}
@@ -1222,6 +1262,22 @@ var (
 	Oc3SchedulerTaskName   = &Col{T: TOc3Scheduler, Name: "task_name", Nullable: true}
 	Oc3SchedulerIsDisabled = &Col{T: TOc3Scheduler, Name: "is_disabled", Nullable: true}
 	Oc3SchedulerLastRunAt  = &Col{T: TOc3Scheduler, Name: "last_run_at", Nullable: true}
+	Oc3SchedulerSchedule   = &Col{T: TOc3Scheduler, Name: "schedule", Nullable: true}
+	Oc3SchedulerTimeout    = &Col{T: TOc3Scheduler, Name: "timeout", Nullable: true}
+	Oc3SchedulerJitter     = &Col{T: TOc3Scheduler, Name: "jitter", Nullable: true}
+	Oc3SchedulerBlackout   = &Col{T: TOc3Scheduler, Name: "blackout", Nullable: true}
+)
+
+// Columns of oc3_scheduler_run
+var (
+	Oc3SchedulerRunID           = &Col{T: TOc3SchedulerRun, Name: "id", Nullable: false}
+	Oc3SchedulerRunTaskName     = &Col{T: TOc3SchedulerRun, Name: "task_name", Nullable: false}
+	Oc3SchedulerRunReplica      = &Col{T: TOc3SchedulerRun, Name: "replica", Nullable: false}
+	Oc3SchedulerRunBeginAt      = &Col{T: TOc3SchedulerRun, Name: "begin_at", Nullable: false}
+	Oc3SchedulerRunEndAt        = &Col{T: TOc3SchedulerRun, Name: "end_at", Nullable: true}
+	Oc3SchedulerRunStatus       = &Col{T: TOc3SchedulerRun, Name: "status", Nullable: false}
+	Oc3SchedulerRunError        = &Col{T: TOc3SchedulerRun, Name: "error", Nullable: true}
+	Oc3SchedulerRunRowsAffected = &Col{T: TOc3SchedulerRun, Name: "rows_affected", Nullable: false}
 )
 
 // Columns of packages
@@ -2019,6 +2075,9 @@ var AllCols = []*Col{
 	ActionQueueConnectTo,
 	ActionQueueNodeID,
 	ActionQueueSvcID,
+	ActionQueueAction,
+	ActionQueueActionParams,
+	ActionQueueTransport,
 	AlertsID,
 	AlertsSentAt,
 	AlertsSentTo,
@@ -2288,6 +2347,16 @@ var AllCols = []*Col{
 	DashboardNodeID,
 	DashboardDashMD5,
 	DashboardDashInstance,
+	DashboardAckID,
+	DashboardAckAlertKey,
+	DashboardAckDashType,
+	DashboardAckNodeID,
+	DashboardAckSvcID,
+	DashboardAckAckBegin,
+	DashboardAckAckEnd,
+	DashboardAckAckComment,
+	DashboardAckAckedBy,
+	DashboardAckAckedOn,
 	DashboardEventsID,
 	DashboardEventsSvcID,
 	DashboardEventsDashMD5,
@@ -2443,27 +2512,37 @@ var AllCols = []*Col{
 	GroupHiddenMenuEntriesGroupID,
 	GroupHiddenMenuEntriesMenuEntry,
 	HbmonID,
+	HbmonClusterID,
 	HbmonNodeID,
 	HbmonPeerNodeID,
-	HbmonHbType,
-	HbmonHbName,
-	HbmonHbDesc,
-	HbmonHbStatus,
+	HbmonDriver,
+	HbmonName,
+	HbmonDesc,
+	HbmonState,
+	HbmonBeating,
+	HbmonLastBeating,
 	HbmonUpdated,
 	HbmonLogID,
+	HbmonLogClusterID,
 	HbmonLogNodeID,
 	HbmonLogPeerNodeID,
-	HbmonLogHbName,
-	HbmonLogHbStatus,
-	HbmonLogHbBegin,
-	HbmonLogHbEnd,
+	HbmonLogName,
+	HbmonLogDesc,
+	HbmonLogState,
+	HbmonLogBeating,
+	HbmonLogBegin,
+	HbmonLogEnd,
+	HbmonLogUpdated,
 	HbmonLogLastID,
+	HbmonLogLastClusterID,
 	HbmonLogLastNodeID,
 	HbmonLogLastPeerNodeID,
-	HbmonLogLastHbName,
-	HbmonLogLastHbStatus,
-	HbmonLogLastHbBegin,
-	HbmonLogLastHbEnd,
+	HbmonLogLastName,
+	HbmonLogLastState,
+	HbmonLogLastBeating,
+	HbmonLogLastBegin,
+	HbmonLogLastEnd,
+	HbmonLogLastUpdated,
 	ImTypesID,
 	ImTypesImType,
 	LifecycleOSID,
@@ -2633,6 +2712,12 @@ var AllCols = []*Col{
 	NodeIPUpdated,
 	NodeIPFlagDeprecated,
 	NodeIPNodeID,
+	NodePropertiesLogID,
+	NodePropertiesLogNodeID,
+	NodePropertiesLogProp,
+	NodePropertiesLogOldValue,
+	NodePropertiesLogNewValue,
+	NodePropertiesLogChangedAt,
 	NodePWID,
 	NodePWPW,
 	NodePWUpdated,
@@ -2660,6 +2745,18 @@ var AllCols = []*Col{
 	Oc3SchedulerTaskName,
 	Oc3SchedulerIsDisabled,
 	Oc3SchedulerLastRunAt,
+	Oc3SchedulerSchedule,
+	Oc3SchedulerTimeout,
+	Oc3SchedulerJitter,
+	Oc3SchedulerBlackout,
+	Oc3SchedulerRunID,
+	Oc3SchedulerRunTaskName,
+	Oc3SchedulerRunReplica,
+	Oc3SchedulerRunBeginAt,
+	Oc3SchedulerRunEndAt,
+	Oc3SchedulerRunStatus,
+	Oc3SchedulerRunError,
+	Oc3SchedulerRunRowsAffected,
 	PackagesID,
 	PackagesPkgName,
 	PackagesPkgVersion,
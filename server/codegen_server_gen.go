@@ -1,11 +1,11 @@
 // Package server provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.7.1 DO NOT EDIT.
 package server
 
 import (
 	"bytes"
-	"compress/gzip"
+	"compress/flate"
 	"encoding/base64"
 	"fmt"
 	"net/http"
@@ -21,6 +21,33 @@ import (
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (GET /actions)
+	GetActions(ctx echo.Context, params GetActionsParams) error
+
+	// (POST /actions)
+	PostActions(ctx echo.Context) error
+
+	// (DELETE /actions/{action_id})
+	DeleteAction(ctx echo.Context, actionId InPathActionId) error
+
+	// (GET /actions/{action_id})
+	GetAction(ctx echo.Context, actionId InPathActionId, params GetActionParams) error
+
+	// (GET /actions/{action_id}/output)
+	GetActionOutput(ctx echo.Context, actionId InPathActionId) error
+
+	// (GET /alerts)
+	GetAlerts(ctx echo.Context, params GetAlertsParams) error
+
+	// (GET /alerts/severity/preview)
+	GetAlertSeverityPreview(ctx echo.Context, params GetAlertSeverityPreviewParams) error
+
+	// (POST /alerts/{alert_id}/ack)
+	PostAlertAck(ctx echo.Context, alertId InPathAlertId) error
+
+	// (GET /alerts/{alert_id}/events)
+	GetAlertEvents(ctx echo.Context, alertId InPathAlertId, params GetAlertEventsParams) error
+
 	// (GET /apps)
 	GetApps(ctx echo.Context, params GetAppsParams) error
 
@@ -39,6 +66,9 @@ type ServerInterface interface {
 	// (GET /apps/{app_id}/am_i_responsible)
 	GetAppAmIResponsible(ctx echo.Context, appId string) error
 
+	// (GET /apps/{app_id}/availability)
+	GetAppAvailability(ctx echo.Context, appId string, params GetAppAvailabilityParams) error
+
 	// (GET /apps/{app_id}/publications)
 	GetAppPublications(ctx echo.Context, appId string, params GetAppPublicationsParams) error
 
@@ -51,6 +81,24 @@ type ServerInterface interface {
 	// (POST /auth/node)
 	PostAuthNode(ctx echo.Context) error
 
+	// (GET /clusters)
+	GetClusters(ctx echo.Context, params GetClustersParams) error
+
+	// (GET /clusters/{cluster_id})
+	GetCluster(ctx echo.Context, clusterId InPathClusterId, params GetClusterParams) error
+
+	// (GET /clusters/{cluster_id}/heartbeats)
+	GetClusterHeartbeats(ctx echo.Context, clusterId InPathClusterId, params GetClusterHeartbeatsParams) error
+
+	// (GET /clusters/{cluster_id}/heartbeats/history)
+	GetClusterHeartbeatsHistory(ctx echo.Context, clusterId InPathClusterId, params GetClusterHeartbeatsHistoryParams) error
+
+	// (GET /clusters/{cluster_id}/nodes)
+	GetClusterNodes(ctx echo.Context, clusterId InPathClusterId, params GetClusterNodesParams) error
+
+	// (GET /clusters/{cluster_id}/services)
+	GetClusterServices(ctx echo.Context, clusterId InPathClusterId, params GetClusterServicesParams) error
+
 	// (GET /disks)
 	GetDisks(ctx echo.Context, params GetDisksParams) error
 
@@ -60,12 +108,21 @@ type ServerInterface interface {
 	// (GET /nodes)
 	GetNodes(ctx echo.Context, params GetNodesParams) error
 
+	// (GET /nodes/groups)
+	GetNodesGroups(ctx echo.Context, params GetNodesGroupsParams) error
+
 	// (GET /nodes/hbas)
 	GetNodesHbas(ctx echo.Context, params GetNodesHbasParams) error
 
+	// (GET /nodes/users)
+	GetNodesUsers(ctx echo.Context, params GetNodesUsersParams) error
+
 	// (GET /nodes/{node_id})
 	GetNode(ctx echo.Context, nodeId string, params GetNodeParams) error
 
+	// (GET /nodes/{node_id}/alerts)
+	GetNodeAlerts(ctx echo.Context, nodeId InPathNodeId, params GetNodeAlertsParams) error
+
 	// (GET /nodes/{node_id}/candidate_tags)
 	GetNodeCandidateTags(ctx echo.Context, nodeId string, params GetNodeCandidateTagsParams) error
 
@@ -99,27 +156,57 @@ type ServerInterface interface {
 	// (GET /nodes/{node_id}/disks)
 	GetNodeDisks(ctx echo.Context, nodeId string, params GetNodeDisksParams) error
 
+	// (GET /nodes/{node_id}/groups)
+	GetNodeGroups(ctx echo.Context, nodeId string, params GetNodeGroupsParams) error
+
 	// (GET /nodes/{node_id}/hbas)
 	GetNodeHbas(ctx echo.Context, nodeId string, params GetNodeHbasParams) error
 
+	// (GET /nodes/{node_id}/history)
+	GetNodeHistory(ctx echo.Context, nodeId string, params GetNodeHistoryParams) error
+
 	// (GET /nodes/{node_id}/interfaces)
 	GetNodeInterfaces(ctx echo.Context, nodeId string, params GetNodeInterfacesParams) error
 
+	// (GET /nodes/{node_id}/packages)
+	GetNodePackages(ctx echo.Context, nodeId string, params GetNodePackagesParams) error
+
+	// (GET /nodes/{node_id}/patches)
+	GetNodePatches(ctx echo.Context, nodeId string, params GetNodePatchesParams) error
+
 	// (GET /nodes/{node_id}/tags)
 	GetNodeTags(ctx echo.Context, nodeId string, params GetNodeTagsParams) error
 
+	// (GET /nodes/{node_id}/users)
+	GetNodeUsers(ctx echo.Context, nodeId string, params GetNodeUsersParams) error
+
 	// (GET /nodes/{node_id}/uuid)
 	GetNodeUUID(ctx echo.Context, nodeId string) error
 
 	// (GET /openapi.json)
 	GetSwagger(ctx echo.Context) error
 
+	// (GET /packages)
+	GetPackages(ctx echo.Context, params GetPackagesParams) error
+
+	// (GET /packages/diff)
+	GetPackagesDiff(ctx echo.Context, params GetPackagesDiffParams) error
+
+	// (GET /patches)
+	GetPatches(ctx echo.Context, params GetPatchesParams) error
+
 	// (GET /services)
 	GetServices(ctx echo.Context, params GetServicesParams) error
 
 	// (GET /services/{svc_id})
 	GetService(ctx echo.Context, svcId string, params GetServiceParams) error
 
+	// (GET /services/{svc_id}/alerts)
+	GetServiceAlerts(ctx echo.Context, svcId string, params GetServiceAlertsParams) error
+
+	// (GET /services/{svc_id}/availability)
+	GetServiceAvailability(ctx echo.Context, svcId string, params GetServiceAvailabilityParams) error
+
 	// (GET /services/{svc_id}/candidate_tags)
 	GetServiceCandidateTags(ctx echo.Context, svcId string, params GetServiceCandidateTagsParams) error
 
@@ -162,1666 +249,3409 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
-// GetApps converts echo context to params.
-func (w *ServerInterfaceWrapper) GetApps(ctx echo.Context) error {
+// GetActions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetActions(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAppsParams
+	var params GetActionsParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetApps(ctx, params)
+	err = w.Handler.GetActions(ctx, params)
 	return err
 }
 
-// PostApps converts echo context to params.
-func (w *ServerInterfaceWrapper) PostApps(ctx echo.Context) error {
+// PostActions converts echo context to params.
+func (w *ServerInterfaceWrapper) PostActions(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostApps(ctx)
+	err = w.Handler.PostActions(ctx)
 	return err
 }
 
-// DeleteApps converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteApps(ctx echo.Context) error {
+// DeleteAction converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAction(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "app_id" -------------
-	var appId string
+	// ------------- Path parameter "action_id" -------------
+	var actionId InPathActionId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "app_id", ctx.Param("app_id"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "action_id", ctx.Param("action_id"), &actionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter app_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter action_id: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteApps(ctx, appId)
+	err = w.Handler.DeleteAction(ctx, actionId)
 	return err
 }
 
-// GetApp converts echo context to params.
-func (w *ServerInterfaceWrapper) GetApp(ctx echo.Context) error {
+// GetAction converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAction(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "app_id" -------------
-	var appId string
+	// ------------- Path parameter "action_id" -------------
+	var actionId InPathActionId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "app_id", ctx.Param("app_id"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "action_id", ctx.Param("action_id"), &actionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter app_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter action_id: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAppParams
+	var params GetActionParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetApp(ctx, appId, params)
+	err = w.Handler.GetAction(ctx, actionId, params)
 	return err
 }
 
-// PostApp converts echo context to params.
-func (w *ServerInterfaceWrapper) PostApp(ctx echo.Context) error {
+// GetActionOutput converts echo context to params.
+func (w *ServerInterfaceWrapper) GetActionOutput(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "app_id" -------------
-	var appId string
+	// ------------- Path parameter "action_id" -------------
+	var actionId InPathActionId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "app_id", ctx.Param("app_id"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "action_id", ctx.Param("action_id"), &actionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter app_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter action_id: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostApp(ctx, appId)
+	err = w.Handler.GetActionOutput(ctx, actionId)
 	return err
 }
 
-// GetAppAmIResponsible converts echo context to params.
-func (w *ServerInterfaceWrapper) GetAppAmIResponsible(ctx echo.Context) error {
+// GetAlerts converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAlerts(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "app_id" -------------
-	var appId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "app_id", ctx.Param("app_id"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAlertsParams
+	// ------------- Optional query parameter "dash_type" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "dash_type", ctx.QueryParams(), &params.DashType, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter app_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dash_type: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	// ------------- Optional query parameter "dash_env" -------------
 
-	ctx.Set(BearerAuthScopes, []string{})
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetAppAmIResponsible(ctx, appId)
-	return err
-}
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "dash_env", ctx.QueryParams(), &params.DashEnv, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dash_env: %s", err))
+	}
 
-// GetAppPublications converts echo context to params.
-func (w *ServerInterfaceWrapper) GetAppPublications(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "app_id" -------------
-	var appId string
+	// ------------- Optional query parameter "min_severity" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "app_id", ctx.Param("app_id"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "min_severity", ctx.QueryParams(), &params.MinSeverity, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter app_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min_severity: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	// ------------- Optional query parameter "acked" -------------
 
-	ctx.Set(BearerAuthScopes, []string{})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "acked", ctx.QueryParams(), &params.Acked, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter acked: %s", err))
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAppPublicationsParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetAppPublications(ctx, appId, params)
+	err = w.Handler.GetAlerts(ctx, params)
 	return err
 }
 
-// GetAppResponsibles converts echo context to params.
-func (w *ServerInterfaceWrapper) GetAppResponsibles(ctx echo.Context) error {
+// GetAlertSeverityPreview converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAlertSeverityPreview(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "app_id" -------------
-	var appId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "app_id", ctx.Param("app_id"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAlertSeverityPreviewParams
+	// ------------- Required query parameter "dash_type" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "dash_type", ctx.QueryParams(), &params.DashType, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter app_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dash_type: %s", err))
+	}
+
+	// ------------- Optional query parameter "node_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "node_id", ctx.QueryParams(), &params.NodeId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	// ------------- Optional query parameter "svc_id" -------------
 
-	ctx.Set(BearerAuthScopes, []string{})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "svc_id", ctx.QueryParams(), &params.SvcId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter svc_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "severity" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "severity", ctx.QueryParams(), &params.Severity, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter severity: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAlertSeverityPreview(ctx, params)
+	return err
+}
+
+// PostAlertAck converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAlertAck(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "alert_id" -------------
+	var alertId InPathAlertId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "alert_id", ctx.Param("alert_id"), &alertId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "int64"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter alert_id: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAlertAck(ctx, alertId)
+	return err
+}
+
+// GetAlertEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAlertEvents(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "alert_id" -------------
+	var alertId InPathAlertId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "alert_id", ctx.Param("alert_id"), &alertId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "int64"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter alert_id: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAppResponsiblesParams
+	var params GetAlertEventsParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetAppResponsibles(ctx, appId, params)
+	err = w.Handler.GetAlertEvents(ctx, alertId, params)
 	return err
 }
 
-// GetArrays converts echo context to params.
-func (w *ServerInterfaceWrapper) GetArrays(ctx echo.Context) error {
+// GetApps converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApps(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetArraysParams
+	var params GetAppsParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetArrays(ctx, params)
+	err = w.Handler.GetApps(ctx, params)
 	return err
 }
 
-// PostAuthNode converts echo context to params.
-func (w *ServerInterfaceWrapper) PostAuthNode(ctx echo.Context) error {
+// PostApps converts echo context to params.
+func (w *ServerInterfaceWrapper) PostApps(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostAuthNode(ctx)
+	err = w.Handler.PostApps(ctx)
 	return err
 }
 
-// GetDisks converts echo context to params.
-func (w *ServerInterfaceWrapper) GetDisks(ctx echo.Context) error {
+// DeleteApps converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteApps(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "app_id" -------------
+	var appId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "app_id", ctx.Param("app_id"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter app_id: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteApps(ctx, appId)
+	return err
+}
+
+// GetApp converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApp(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "app_id" -------------
+	var appId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "app_id", ctx.Param("app_id"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter app_id: %s", err))
+	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetDisksParams
+	var params GetAppParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetApp(ctx, appId, params)
+	return err
+}
+
+// PostApp converts echo context to params.
+func (w *ServerInterfaceWrapper) PostApp(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "app_id" -------------
+	var appId string
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindStyledParameterWithOptions("simple", "app_id", ctx.Param("app_id"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter app_id: %s", err))
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
-	}
+	ctx.Set(string(BearerAuthScopes), []string{})
 
-	// ------------- Optional query parameter "meta" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostApp(ctx, appId)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+// GetAppAmIResponsible converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAppAmIResponsible(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "app_id" -------------
+	var appId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "app_id", ctx.Param("app_id"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter app_id: %s", err))
 	}
 
-	// ------------- Optional query parameter "stats" -------------
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAppAmIResponsible(ctx, appId)
+	return err
+}
+
+// GetAppAvailability converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAppAvailability(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "app_id" -------------
+	var appId string
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindStyledParameterWithOptions("simple", "app_id", ctx.Param("app_id"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter app_id: %s", err))
 	}
 
-	// ------------- Optional query parameter "orderby" -------------
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAppAvailabilityParams
+	// ------------- Optional query parameter "from" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "from", ctx.QueryParams(), &params.From, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
 	}
 
-	// ------------- Optional query parameter "groupby" -------------
+	// ------------- Optional query parameter "until" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "until", ctx.QueryParams(), &params.Until, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetDisks(ctx, params)
+	err = w.Handler.GetAppAvailability(ctx, appId, params)
 	return err
 }
 
-// GetDisk converts echo context to params.
-func (w *ServerInterfaceWrapper) GetDisk(ctx echo.Context) error {
+// GetAppPublications converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAppPublications(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "disk_id" -------------
-	var diskId string
+	// ------------- Path parameter "app_id" -------------
+	var appId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "disk_id", ctx.Param("disk_id"), &diskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "app_id", ctx.Param("app_id"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter disk_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter app_id: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetDiskParams
+	var params GetAppPublicationsParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetDisk(ctx, diskId, params)
+	err = w.Handler.GetAppPublications(ctx, appId, params)
 	return err
 }
 
-// GetNodes converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodes(ctx echo.Context) error {
+// GetAppResponsibles converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAppResponsibles(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "app_id" -------------
+	var appId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "app_id", ctx.Param("app_id"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter app_id: %s", err))
+	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetNodesParams
+	var params GetAppResponsiblesParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNodes(ctx, params)
+	err = w.Handler.GetAppResponsibles(ctx, appId, params)
 	return err
 }
 
-// GetNodesHbas converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodesHbas(ctx echo.Context) error {
+// GetArrays converts echo context to params.
+func (w *ServerInterfaceWrapper) GetArrays(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetNodesHbasParams
+	var params GetArraysParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNodesHbas(ctx, params)
+	err = w.Handler.GetArrays(ctx, params)
+	return err
+}
+
+// PostAuthNode converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthNode(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthNode(ctx)
+	return err
+}
+
+// GetClusters converts echo context to params.
+func (w *ServerInterfaceWrapper) GetClusters(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetClustersParams
+	// ------------- Optional query parameter "props" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "meta" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
+	}
+
+	// ------------- Optional query parameter "stats" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetClusters(ctx, params)
+	return err
+}
+
+// GetCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "cluster_id" -------------
+	var clusterId InPathClusterId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster_id", ctx.Param("cluster_id"), &clusterId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cluster_id: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetClusterParams
+	// ------------- Optional query parameter "props" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "meta" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
+	}
+
+	// ------------- Optional query parameter "stats" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCluster(ctx, clusterId, params)
+	return err
+}
+
+// GetClusterHeartbeats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetClusterHeartbeats(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "cluster_id" -------------
+	var clusterId InPathClusterId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster_id", ctx.Param("cluster_id"), &clusterId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cluster_id: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetClusterHeartbeatsParams
+	// ------------- Optional query parameter "props" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "meta" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
+	}
+
+	// ------------- Optional query parameter "stats" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetClusterHeartbeats(ctx, clusterId, params)
+	return err
+}
+
+// GetClusterHeartbeatsHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetClusterHeartbeatsHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "cluster_id" -------------
+	var clusterId InPathClusterId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster_id", ctx.Param("cluster_id"), &clusterId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cluster_id: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetClusterHeartbeatsHistoryParams
+	// ------------- Optional query parameter "begin" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "begin", ctx.QueryParams(), &params.Begin, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter begin: %s", err))
+	}
+
+	// ------------- Optional query parameter "end" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "end", ctx.QueryParams(), &params.End, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end: %s", err))
+	}
+
+	// ------------- Optional query parameter "props" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "meta" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
+	}
+
+	// ------------- Optional query parameter "stats" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetClusterHeartbeatsHistory(ctx, clusterId, params)
+	return err
+}
+
+// GetClusterNodes converts echo context to params.
+func (w *ServerInterfaceWrapper) GetClusterNodes(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "cluster_id" -------------
+	var clusterId InPathClusterId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster_id", ctx.Param("cluster_id"), &clusterId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cluster_id: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetClusterNodesParams
+	// ------------- Optional query parameter "props" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "meta" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
+	}
+
+	// ------------- Optional query parameter "stats" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetClusterNodes(ctx, clusterId, params)
+	return err
+}
+
+// GetClusterServices converts echo context to params.
+func (w *ServerInterfaceWrapper) GetClusterServices(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "cluster_id" -------------
+	var clusterId InPathClusterId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster_id", ctx.Param("cluster_id"), &clusterId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cluster_id: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetClusterServicesParams
+	// ------------- Optional query parameter "props" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "meta" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
+	}
+
+	// ------------- Optional query parameter "stats" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetClusterServices(ctx, clusterId, params)
+	return err
+}
+
+// GetDisks converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDisks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDisksParams
+	// ------------- Optional query parameter "props" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "meta" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
+	}
+
+	// ------------- Optional query parameter "stats" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDisks(ctx, params)
+	return err
+}
+
+// GetDisk converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDisk(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "disk_id" -------------
+	var diskId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "disk_id", ctx.Param("disk_id"), &diskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter disk_id: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDiskParams
+	// ------------- Optional query parameter "props" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "meta" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
+	}
+
+	// ------------- Optional query parameter "stats" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDisk(ctx, diskId, params)
+	return err
+}
+
+// GetNodes converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodes(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodesParams
+	// ------------- Optional query parameter "props" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "meta" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
+	}
+
+	// ------------- Optional query parameter "stats" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodes(ctx, params)
+	return err
+}
+
+// GetNodesGroups converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodesGroups(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodesGroupsParams
+	// ------------- Optional query parameter "props" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "meta" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
+	}
+
+	// ------------- Optional query parameter "stats" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodesGroups(ctx, params)
+	return err
+}
+
+// GetNodesHbas converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodesHbas(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodesHbasParams
+	// ------------- Optional query parameter "props" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "meta" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
+	}
+
+	// ------------- Optional query parameter "stats" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodesHbas(ctx, params)
+	return err
+}
+
+// GetNodesUsers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodesUsers(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodesUsersParams
+	// ------------- Optional query parameter "props" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "meta" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
+	}
+
+	// ------------- Optional query parameter "stats" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodesUsers(ctx, params)
+	return err
+}
+
+// GetNode converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNode(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "node_id" -------------
+	var nodeId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodeParams
+	// ------------- Optional query parameter "props" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "meta" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
+	}
+
+	// ------------- Optional query parameter "stats" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNode(ctx, nodeId, params)
+	return err
+}
+
+// GetNodeAlerts converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeAlerts(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "node_id" -------------
+	var nodeId InPathNodeId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodeAlertsParams
+	// ------------- Optional query parameter "dash_type" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "dash_type", ctx.QueryParams(), &params.DashType, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dash_type: %s", err))
+	}
+
+	// ------------- Optional query parameter "dash_env" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "dash_env", ctx.QueryParams(), &params.DashEnv, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dash_env: %s", err))
+	}
+
+	// ------------- Optional query parameter "min_severity" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "min_severity", ctx.QueryParams(), &params.MinSeverity, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min_severity: %s", err))
+	}
+
+	// ------------- Optional query parameter "acked" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "acked", ctx.QueryParams(), &params.Acked, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter acked: %s", err))
+	}
+
+	// ------------- Optional query parameter "props" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "meta" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
+	}
+
+	// ------------- Optional query parameter "stats" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeAlerts(ctx, nodeId, params)
+	return err
+}
+
+// GetNodeCandidateTags converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeCandidateTags(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "node_id" -------------
+	var nodeId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodeCandidateTagsParams
+	// ------------- Optional query parameter "props" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "meta" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
+	}
+
+	// ------------- Optional query parameter "stats" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeCandidateTags(ctx, nodeId, params)
+	return err
+}
+
+// GetNodeComplianceCandidateModulesets converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeComplianceCandidateModulesets(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "node_id" -------------
+	var nodeId InPathNodeId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodeComplianceCandidateModulesetsParams
+	// ------------- Optional query parameter "props" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "meta" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
+	}
+
+	// ------------- Optional query parameter "stats" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeComplianceCandidateModulesets(ctx, nodeId, params)
+	return err
+}
+
+// GetNodeComplianceCandidateRulesets converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeComplianceCandidateRulesets(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "node_id" -------------
+	var nodeId InPathNodeId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodeComplianceCandidateRulesetsParams
+	// ------------- Optional query parameter "props" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "meta" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
+	}
+
+	// ------------- Optional query parameter "stats" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeComplianceCandidateRulesets(ctx, nodeId, params)
+	return err
+}
+
+// GetNodeComplianceLogs converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeComplianceLogs(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "node_id" -------------
+	var nodeId InPathNodeId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodeComplianceLogsParams
+	// ------------- Optional query parameter "props" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeComplianceLogs(ctx, nodeId, params)
+	return err
+}
+
+// GetNodeComplianceModulesets converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeComplianceModulesets(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "node_id" -------------
+	var nodeId InPathNodeId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodeComplianceModulesetsParams
+	// ------------- Optional query parameter "props" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "meta" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
+	}
+
+	// ------------- Optional query parameter "stats" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeComplianceModulesets(ctx, nodeId, params)
+	return err
+}
+
+// DeleteNodeComplianceModuleset converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteNodeComplianceModuleset(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "node_id" -------------
+	var nodeId InPathNodeId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
+	}
+
+	// ------------- Path parameter "mset_id" -------------
+	var msetId InPathMsetId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "mset_id", ctx.Param("mset_id"), &msetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter mset_id: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteNodeComplianceModuleset(ctx, nodeId, msetId)
+	return err
+}
+
+// PostNodeComplianceModuleset converts echo context to params.
+func (w *ServerInterfaceWrapper) PostNodeComplianceModuleset(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "node_id" -------------
+	var nodeId InPathNodeId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
+	}
+
+	// ------------- Path parameter "mset_id" -------------
+	var msetId InPathMsetId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "mset_id", ctx.Param("mset_id"), &msetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter mset_id: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostNodeComplianceModuleset(ctx, nodeId, msetId)
 	return err
 }
 
-// GetNode converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNode(ctx echo.Context) error {
+// GetNodeComplianceRulesets converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeComplianceRulesets(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "node_id" -------------
+	var nodeId InPathNodeId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodeComplianceRulesetsParams
+	// ------------- Optional query parameter "props" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "meta" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
+	}
+
+	// ------------- Optional query parameter "stats" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeComplianceRulesets(ctx, nodeId, params)
+	return err
+}
+
+// DeleteNodeComplianceRuleset converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteNodeComplianceRuleset(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "node_id" -------------
+	var nodeId InPathNodeId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
+	}
+
+	// ------------- Path parameter "rset_id" -------------
+	var rsetId InPathRsetId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rset_id", ctx.Param("rset_id"), &rsetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rset_id: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteNodeComplianceRuleset(ctx, nodeId, rsetId)
+	return err
+}
+
+// PostNodeComplianceRuleset converts echo context to params.
+func (w *ServerInterfaceWrapper) PostNodeComplianceRuleset(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "node_id" -------------
+	var nodeId InPathNodeId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
+	}
+
+	// ------------- Path parameter "rset_id" -------------
+	var rsetId InPathRsetId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rset_id", ctx.Param("rset_id"), &rsetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rset_id: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostNodeComplianceRuleset(ctx, nodeId, rsetId)
+	return err
+}
+
+// GetNodeDisks converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeDisks(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "node_id" -------------
 	var nodeId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetNodeParams
+	var params GetNodeDisksParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNode(ctx, nodeId, params)
+	err = w.Handler.GetNodeDisks(ctx, nodeId, params)
 	return err
 }
 
-// GetNodeCandidateTags converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodeCandidateTags(ctx echo.Context) error {
+// GetNodeGroups converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeGroups(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "node_id" -------------
 	var nodeId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetNodeCandidateTagsParams
+	var params GetNodeGroupsParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNodeCandidateTags(ctx, nodeId, params)
+	err = w.Handler.GetNodeGroups(ctx, nodeId, params)
 	return err
 }
 
-// GetNodeComplianceCandidateModulesets converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodeComplianceCandidateModulesets(ctx echo.Context) error {
+// GetNodeHbas converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeHbas(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "node_id" -------------
-	var nodeId InPathNodeId
+	var nodeId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetNodeComplianceCandidateModulesetsParams
+	var params GetNodeHbasParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNodeComplianceCandidateModulesets(ctx, nodeId, params)
+	err = w.Handler.GetNodeHbas(ctx, nodeId, params)
 	return err
 }
 
-// GetNodeComplianceCandidateRulesets converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodeComplianceCandidateRulesets(ctx echo.Context) error {
+// GetNodeHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeHistory(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "node_id" -------------
-	var nodeId InPathNodeId
+	var nodeId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetNodeComplianceCandidateRulesetsParams
+	var params GetNodeHistoryParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNodeComplianceCandidateRulesets(ctx, nodeId, params)
+	err = w.Handler.GetNodeHistory(ctx, nodeId, params)
 	return err
 }
 
-// GetNodeComplianceLogs converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodeComplianceLogs(ctx echo.Context) error {
+// GetNodeInterfaces converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeInterfaces(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "node_id" -------------
-	var nodeId InPathNodeId
+	var nodeId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetNodeComplianceLogsParams
+	var params GetNodeInterfacesParams
+	// ------------- Optional query parameter "props" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "meta" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
+	}
+
+	// ------------- Optional query parameter "stats" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeInterfaces(ctx, nodeId, params)
+	return err
+}
+
+// GetNodePackages converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodePackages(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "node_id" -------------
+	var nodeId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
+	}
+
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodePackagesParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "meta" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
+	}
+
+	// ------------- Optional query parameter "stats" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNodeComplianceLogs(ctx, nodeId, params)
+	err = w.Handler.GetNodePackages(ctx, nodeId, params)
 	return err
 }
 
-// GetNodeComplianceModulesets converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodeComplianceModulesets(ctx echo.Context) error {
+// GetNodePatches converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodePatches(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "node_id" -------------
-	var nodeId InPathNodeId
+	var nodeId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetNodeComplianceModulesetsParams
+	var params GetNodePatchesParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNodeComplianceModulesets(ctx, nodeId, params)
+	err = w.Handler.GetNodePatches(ctx, nodeId, params)
 	return err
 }
 
-// DeleteNodeComplianceModuleset converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteNodeComplianceModuleset(ctx echo.Context) error {
+// GetNodeTags converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeTags(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "node_id" -------------
-	var nodeId InPathNodeId
+	var nodeId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
 	}
 
-	// ------------- Path parameter "mset_id" -------------
-	var msetId InPathMsetId
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "mset_id", ctx.Param("mset_id"), &msetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodeTagsParams
+	// ------------- Optional query parameter "props" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter mset_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	// ------------- Optional query parameter "limit" -------------
 
-	ctx.Set(BearerAuthScopes, []string{})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteNodeComplianceModuleset(ctx, nodeId, msetId)
-	return err
-}
+	// ------------- Optional query parameter "offset" -------------
 
-// PostNodeComplianceModuleset converts echo context to params.
-func (w *ServerInterfaceWrapper) PostNodeComplianceModuleset(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "node_id" -------------
-	var nodeId InPathNodeId
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
-	// ------------- Path parameter "mset_id" -------------
-	var msetId InPathMsetId
+	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "mset_id", ctx.Param("mset_id"), &msetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter mset_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	// ------------- Optional query parameter "orderby" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupby" -------------
 
-	ctx.Set(BearerAuthScopes, []string{})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostNodeComplianceModuleset(ctx, nodeId, msetId)
+	err = w.Handler.GetNodeTags(ctx, nodeId, params)
 	return err
 }
 
-// GetNodeComplianceRulesets converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodeComplianceRulesets(ctx echo.Context) error {
+// GetNodeUsers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeUsers(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "node_id" -------------
-	var nodeId InPathNodeId
+	var nodeId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetNodeComplianceRulesetsParams
+	var params GetNodeUsersParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNodeComplianceRulesets(ctx, nodeId, params)
+	err = w.Handler.GetNodeUsers(ctx, nodeId, params)
 	return err
 }
 
-// DeleteNodeComplianceRuleset converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteNodeComplianceRuleset(ctx echo.Context) error {
+// GetNodeUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeUUID(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "node_id" -------------
-	var nodeId InPathNodeId
+	var nodeId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
 	}
 
-	// ------------- Path parameter "rset_id" -------------
-	var rsetId InPathRsetId
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "rset_id", ctx.Param("rset_id"), &rsetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rset_id: %s", err))
-	}
+	ctx.Set(string(BearerAuthScopes), []string{})
 
-	ctx.Set(BasicAuthScopes, []string{})
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeUUID(ctx, nodeId)
+	return err
+}
 
-	ctx.Set(BearerAuthScopes, []string{})
+// GetSwagger converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSwagger(ctx echo.Context) error {
+	var err error
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteNodeComplianceRuleset(ctx, nodeId, rsetId)
+	err = w.Handler.GetSwagger(ctx)
 	return err
 }
 
-// PostNodeComplianceRuleset converts echo context to params.
-func (w *ServerInterfaceWrapper) PostNodeComplianceRuleset(ctx echo.Context) error {
+// GetPackages converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPackages(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "node_id" -------------
-	var nodeId InPathNodeId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
-	}
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	// ------------- Path parameter "rset_id" -------------
-	var rsetId InPathRsetId
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPackagesParams
+	// ------------- Optional query parameter "pkg_name" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "rset_id", ctx.Param("rset_id"), &rsetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "pkg_name", ctx.QueryParams(), &params.PkgName, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rset_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pkg_name: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	// ------------- Optional query parameter "pkg_version" -------------
 
-	ctx.Set(BearerAuthScopes, []string{})
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostNodeComplianceRuleset(ctx, nodeId, rsetId)
-	return err
-}
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "pkg_version", ctx.QueryParams(), &params.PkgVersion, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pkg_version: %s", err))
+	}
 
-// GetNodeDisks converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodeDisks(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "node_id" -------------
-	var nodeId string
+	// ------------- Optional query parameter "pkg_arch" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "pkg_arch", ctx.QueryParams(), &params.PkgArch, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pkg_arch: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	// ------------- Optional query parameter "pkg_type" -------------
 
-	ctx.Set(BearerAuthScopes, []string{})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "pkg_type", ctx.QueryParams(), &params.PkgType, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pkg_type: %s", err))
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetNodeDisksParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNodeDisks(ctx, nodeId, params)
+	err = w.Handler.GetPackages(ctx, params)
 	return err
 }
 
-// GetNodeHbas converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodeHbas(ctx echo.Context) error {
+// GetPackagesDiff converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPackagesDiff(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "node_id" -------------
-	var nodeId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx.Set(string(BasicAuthScopes), []string{})
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPackagesDiffParams
+	// ------------- Optional query parameter "nodes" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "nodes", ctx.QueryParams(), &params.Nodes, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodes: %s", err))
+	}
+
+	// ------------- Optional query parameter "cluster_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cluster_id", ctx.QueryParams(), &params.ClusterId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cluster_id: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPackagesDiff(ctx, params)
+	return err
+}
+
+// GetPatches converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPatches(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetNodeHbasParams
+	var params GetPatchesParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNodeHbas(ctx, nodeId, params)
+	err = w.Handler.GetPatches(ctx, params)
 	return err
 }
 
-// GetNodeInterfaces converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodeInterfaces(ctx echo.Context) error {
+// GetServices converts echo context to params.
+func (w *ServerInterfaceWrapper) GetServices(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "node_id" -------------
-	var nodeId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
-	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetNodeInterfacesParams
+	var params GetServicesParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNodeInterfaces(ctx, nodeId, params)
+	err = w.Handler.GetServices(ctx, params)
 	return err
 }
 
-// GetNodeTags converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodeTags(ctx echo.Context) error {
+// GetService converts echo context to params.
+func (w *ServerInterfaceWrapper) GetService(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "node_id" -------------
-	var nodeId string
+	// ------------- Path parameter "svc_id" -------------
+	var svcId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "svc_id", ctx.Param("svc_id"), &svcId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter svc_id: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetNodeTagsParams
+	var params GetServiceParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNodeTags(ctx, nodeId, params)
+	err = w.Handler.GetService(ctx, svcId, params)
 	return err
 }
 
-// GetNodeUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodeUUID(ctx echo.Context) error {
+// GetServiceAlerts converts echo context to params.
+func (w *ServerInterfaceWrapper) GetServiceAlerts(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "node_id" -------------
-	var nodeId string
+	// ------------- Path parameter "svc_id" -------------
+	var svcId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "node_id", ctx.Param("node_id"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "svc_id", ctx.Param("svc_id"), &svcId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter svc_id: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNodeUUID(ctx, nodeId)
-	return err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetServiceAlertsParams
+	// ------------- Optional query parameter "dash_type" -------------
 
-// GetSwagger converts echo context to params.
-func (w *ServerInterfaceWrapper) GetSwagger(ctx echo.Context) error {
-	var err error
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "dash_type", ctx.QueryParams(), &params.DashType, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dash_type: %s", err))
+	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetSwagger(ctx)
-	return err
-}
+	// ------------- Optional query parameter "dash_env" -------------
 
-// GetServices converts echo context to params.
-func (w *ServerInterfaceWrapper) GetServices(ctx echo.Context) error {
-	var err error
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "dash_env", ctx.QueryParams(), &params.DashEnv, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dash_env: %s", err))
+	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	// ------------- Optional query parameter "min_severity" -------------
 
-	ctx.Set(BearerAuthScopes, []string{})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "min_severity", ctx.QueryParams(), &params.MinSeverity, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min_severity: %s", err))
+	}
+
+	// ------------- Optional query parameter "acked" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "acked", ctx.QueryParams(), &params.Acked, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter acked: %s", err))
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetServicesParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetServices(ctx, params)
+	err = w.Handler.GetServiceAlerts(ctx, svcId, params)
 	return err
 }
 
-// GetService converts echo context to params.
-func (w *ServerInterfaceWrapper) GetService(ctx echo.Context) error {
+// GetServiceAvailability converts echo context to params.
+func (w *ServerInterfaceWrapper) GetServiceAvailability(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "svc_id" -------------
 	var svcId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "svc_id", ctx.Param("svc_id"), &svcId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "svc_id", ctx.Param("svc_id"), &svcId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter svc_id: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetServiceParams
-	// ------------- Optional query parameter "props" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
-	}
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
-	}
-
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
-	}
-
-	// ------------- Optional query parameter "meta" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
-	}
-
-	// ------------- Optional query parameter "stats" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
-	}
-
-	// ------------- Optional query parameter "orderby" -------------
+	var params GetServiceAvailabilityParams
+	// ------------- Optional query parameter "from" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "from", ctx.QueryParams(), &params.From, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
 	}
 
-	// ------------- Optional query parameter "groupby" -------------
+	// ------------- Optional query parameter "until" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "until", ctx.QueryParams(), &params.Until, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetService(ctx, svcId, params)
+	err = w.Handler.GetServiceAvailability(ctx, svcId, params)
 	return err
 }
 
@@ -1831,62 +3661,62 @@ func (w *ServerInterfaceWrapper) GetServiceCandidateTags(ctx echo.Context) error
 	// ------------- Path parameter "svc_id" -------------
 	var svcId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "svc_id", ctx.Param("svc_id"), &svcId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "svc_id", ctx.Param("svc_id"), &svcId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter svc_id: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetServiceCandidateTagsParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
@@ -1902,62 +3732,62 @@ func (w *ServerInterfaceWrapper) GetServiceTags(ctx echo.Context) error {
 	// ------------- Path parameter "svc_id" -------------
 	var svcId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "svc_id", ctx.Param("svc_id"), &svcId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "svc_id", ctx.Param("svc_id"), &svcId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter svc_id: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetServiceTagsParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
@@ -1971,57 +3801,57 @@ func (w *ServerInterfaceWrapper) GetServiceTags(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) GetServicesInstances(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetServicesInstancesParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
@@ -2037,62 +3867,62 @@ func (w *ServerInterfaceWrapper) GetServicesInstance(ctx echo.Context) error {
 	// ------------- Path parameter "svc_id" -------------
 	var svcId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "svc_id", ctx.Param("svc_id"), &svcId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "svc_id", ctx.Param("svc_id"), &svcId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter svc_id: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetServicesInstanceParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
@@ -2106,57 +3936,57 @@ func (w *ServerInterfaceWrapper) GetServicesInstance(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) GetServicesInstancesStatusLog(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetServicesInstancesStatusLogParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
@@ -2170,57 +4000,57 @@ func (w *ServerInterfaceWrapper) GetServicesInstancesStatusLog(ctx echo.Context)
 func (w *ServerInterfaceWrapper) GetTags(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetTagsParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
@@ -2234,57 +4064,57 @@ func (w *ServerInterfaceWrapper) GetTags(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) GetTagsNodes(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetTagsNodesParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
@@ -2298,57 +4128,57 @@ func (w *ServerInterfaceWrapper) GetTagsNodes(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) GetTagsServices(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetTagsServicesParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
@@ -2364,20 +4194,20 @@ func (w *ServerInterfaceWrapper) GetTag(ctx echo.Context) error {
 	// ------------- Path parameter "tag_id" -------------
 	var tagId int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "tag_id", ctx.Param("tag_id"), &tagId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "tag_id", ctx.Param("tag_id"), &tagId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag_id: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetTagParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
@@ -2393,34 +4223,34 @@ func (w *ServerInterfaceWrapper) GetTagNodes(ctx echo.Context) error {
 	// ------------- Path parameter "tag_id" -------------
 	var tagId int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "tag_id", ctx.Param("tag_id"), &tagId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "tag_id", ctx.Param("tag_id"), &tagId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag_id: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetTagNodesParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
@@ -2436,62 +4266,62 @@ func (w *ServerInterfaceWrapper) GetTagServices(ctx echo.Context) error {
 	// ------------- Path parameter "tag_id" -------------
 	var tagId int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "tag_id", ctx.Param("tag_id"), &tagId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "tag_id", ctx.Param("tag_id"), &tagId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag_id: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	ctx.Set(string(BasicAuthScopes), []string{})
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetTagServicesParams
 	// ------------- Optional query parameter "props" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "props", ctx.QueryParams(), &params.Props)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "props", ctx.QueryParams(), &params.Props, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter props: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
 	// ------------- Optional query parameter "meta" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "meta", ctx.QueryParams(), &params.Meta)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "meta", ctx.QueryParams(), &params.Meta, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter meta: %s", err))
 	}
 
 	// ------------- Optional query parameter "stats" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stats", ctx.QueryParams(), &params.Stats)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stats", ctx.QueryParams(), &params.Stats, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stats: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "orderby", ctx.QueryParams(), &params.Orderby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderby: %s", err))
 	}
 
 	// ------------- Optional query parameter "groupby" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "groupby", ctx.QueryParams(), &params.Groupby, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupby: %s", err))
 	}
@@ -2525,135 +4355,244 @@ type EchoRouter interface {
 	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 }
 
+// RegisterHandlersOptions configures RegisterHandlersWithOptions.
+type RegisterHandlersOptions struct {
+	// BaseURL is prepended to every registered path so the API can be served
+	// under a prefix.
+	BaseURL string
+	// OperationMiddlewares lets the caller attach per-operation middleware at
+	// registration time. The map key is the OpenAPI `operationId` value as it
+	// appears in the spec (the raw, un-normalized form). Operations that have
+	// no entry are registered with no extra middleware. A nil map disables
+	// per-operation middleware entirely.
+	OperationMiddlewares map[string][]echo.MiddlewareFunc
+}
+
 // RegisterHandlers adds each server route to the EchoRouter.
 func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
+	RegisterHandlersWithOptions(router, si, RegisterHandlersOptions{})
 }
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
+// RegisterHandlersWithBaseURL registers handlers and prepends BaseURL to the
+// paths so the API can be served under a prefix.
 func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+	RegisterHandlersWithOptions(router, si, RegisterHandlersOptions{BaseURL: baseURL})
+}
+
+// RegisterHandlersWithOptions registers handlers using the supplied options,
+// including any per-operation middleware.
+func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options RegisterHandlersOptions) {
 
 	wrapper := ServerInterfaceWrapper{
 		Handler: si,
 	}
 
-	router.GET(baseURL+"/apps", wrapper.GetApps)
-	router.POST(baseURL+"/apps", wrapper.PostApps)
-	router.DELETE(baseURL+"/apps/:app_id", wrapper.DeleteApps)
-	router.GET(baseURL+"/apps/:app_id", wrapper.GetApp)
-	router.POST(baseURL+"/apps/:app_id", wrapper.PostApp)
-	router.GET(baseURL+"/apps/:app_id/am_i_responsible", wrapper.GetAppAmIResponsible)
-	router.GET(baseURL+"/apps/:app_id/publications", wrapper.GetAppPublications)
-	router.GET(baseURL+"/apps/:app_id/responsibles", wrapper.GetAppResponsibles)
-	router.GET(baseURL+"/arrays", wrapper.GetArrays)
-	router.POST(baseURL+"/auth/node", wrapper.PostAuthNode)
-	router.GET(baseURL+"/disks", wrapper.GetDisks)
-	router.GET(baseURL+"/disks/:disk_id", wrapper.GetDisk)
-	router.GET(baseURL+"/nodes", wrapper.GetNodes)
-	router.GET(baseURL+"/nodes/hbas", wrapper.GetNodesHbas)
-	router.GET(baseURL+"/nodes/:node_id", wrapper.GetNode)
-	router.GET(baseURL+"/nodes/:node_id/candidate_tags", wrapper.GetNodeCandidateTags)
-	router.GET(baseURL+"/nodes/:node_id/compliance/candidate_modulesets", wrapper.GetNodeComplianceCandidateModulesets)
-	router.GET(baseURL+"/nodes/:node_id/compliance/candidate_rulesets", wrapper.GetNodeComplianceCandidateRulesets)
-	router.GET(baseURL+"/nodes/:node_id/compliance/logs", wrapper.GetNodeComplianceLogs)
-	router.GET(baseURL+"/nodes/:node_id/compliance/modulesets", wrapper.GetNodeComplianceModulesets)
-	router.DELETE(baseURL+"/nodes/:node_id/compliance/modulesets/:mset_id", wrapper.DeleteNodeComplianceModuleset)
-	router.POST(baseURL+"/nodes/:node_id/compliance/modulesets/:mset_id", wrapper.PostNodeComplianceModuleset)
-	router.GET(baseURL+"/nodes/:node_id/compliance/rulesets", wrapper.GetNodeComplianceRulesets)
-	router.DELETE(baseURL+"/nodes/:node_id/compliance/rulesets/:rset_id", wrapper.DeleteNodeComplianceRuleset)
-	router.POST(baseURL+"/nodes/:node_id/compliance/rulesets/:rset_id", wrapper.PostNodeComplianceRuleset)
-	router.GET(baseURL+"/nodes/:node_id/disks", wrapper.GetNodeDisks)
-	router.GET(baseURL+"/nodes/:node_id/hbas", wrapper.GetNodeHbas)
-	router.GET(baseURL+"/nodes/:node_id/interfaces", wrapper.GetNodeInterfaces)
-	router.GET(baseURL+"/nodes/:node_id/tags", wrapper.GetNodeTags)
-	router.GET(baseURL+"/nodes/:node_id/uuid", wrapper.GetNodeUUID)
-	router.GET(baseURL+"/openapi.json", wrapper.GetSwagger)
-	router.GET(baseURL+"/services", wrapper.GetServices)
-	router.GET(baseURL+"/services/:svc_id", wrapper.GetService)
-	router.GET(baseURL+"/services/:svc_id/candidate_tags", wrapper.GetServiceCandidateTags)
-	router.GET(baseURL+"/services/:svc_id/tags", wrapper.GetServiceTags)
-	router.GET(baseURL+"/services_instances", wrapper.GetServicesInstances)
-	router.GET(baseURL+"/services_instances/:svc_id", wrapper.GetServicesInstance)
-	router.GET(baseURL+"/services_instances_status_log", wrapper.GetServicesInstancesStatusLog)
-	router.GET(baseURL+"/tags", wrapper.GetTags)
-	router.GET(baseURL+"/tags/nodes", wrapper.GetTagsNodes)
-	router.GET(baseURL+"/tags/services", wrapper.GetTagsServices)
-	router.GET(baseURL+"/tags/:tag_id", wrapper.GetTag)
-	router.GET(baseURL+"/tags/:tag_id/nodes", wrapper.GetTagNodes)
-	router.GET(baseURL+"/tags/:tag_id/services", wrapper.GetTagServices)
-	router.GET(baseURL+"/version", wrapper.GetVersion)
-
-}
-
-// Base64 encoded, gzipped, json marshaled Swagger object
+	router.GET(options.BaseURL+"/actions", wrapper.GetActions, options.OperationMiddlewares["GetActions"]...)
+	router.POST(options.BaseURL+"/actions", wrapper.PostActions, options.OperationMiddlewares["PostActions"]...)
+	router.DELETE(options.BaseURL+"/actions/:action_id", wrapper.DeleteAction, options.OperationMiddlewares["DeleteAction"]...)
+	router.GET(options.BaseURL+"/actions/:action_id", wrapper.GetAction, options.OperationMiddlewares["GetAction"]...)
+	router.GET(options.BaseURL+"/actions/:action_id/output", wrapper.GetActionOutput, options.OperationMiddlewares["GetActionOutput"]...)
+	router.GET(options.BaseURL+"/alerts", wrapper.GetAlerts, options.OperationMiddlewares["GetAlerts"]...)
+	router.GET(options.BaseURL+"/alerts/severity/preview", wrapper.GetAlertSeverityPreview, options.OperationMiddlewares["GetAlertSeverityPreview"]...)
+	router.POST(options.BaseURL+"/alerts/:alert_id/ack", wrapper.PostAlertAck, options.OperationMiddlewares["PostAlertAck"]...)
+	router.GET(options.BaseURL+"/alerts/:alert_id/events", wrapper.GetAlertEvents, options.OperationMiddlewares["GetAlertEvents"]...)
+	router.GET(options.BaseURL+"/apps", wrapper.GetApps, options.OperationMiddlewares["GetApps"]...)
+	router.POST(options.BaseURL+"/apps", wrapper.PostApps, options.OperationMiddlewares["PostApps"]...)
+	router.DELETE(options.BaseURL+"/apps/:app_id", wrapper.DeleteApps, options.OperationMiddlewares["DeleteApps"]...)
+	router.GET(options.BaseURL+"/apps/:app_id", wrapper.GetApp, options.OperationMiddlewares["GetApp"]...)
+	router.POST(options.BaseURL+"/apps/:app_id", wrapper.PostApp, options.OperationMiddlewares["PostApp"]...)
+	router.GET(options.BaseURL+"/apps/:app_id/am_i_responsible", wrapper.GetAppAmIResponsible, options.OperationMiddlewares["GetAppAmIResponsible"]...)
+	router.GET(options.BaseURL+"/apps/:app_id/availability", wrapper.GetAppAvailability, options.OperationMiddlewares["GetAppAvailability"]...)
+	router.GET(options.BaseURL+"/apps/:app_id/publications", wrapper.GetAppPublications, options.OperationMiddlewares["GetAppPublications"]...)
+	router.GET(options.BaseURL+"/apps/:app_id/responsibles", wrapper.GetAppResponsibles, options.OperationMiddlewares["GetAppResponsibles"]...)
+	router.GET(options.BaseURL+"/arrays", wrapper.GetArrays, options.OperationMiddlewares["GetArrays"]...)
+	router.POST(options.BaseURL+"/auth/node", wrapper.PostAuthNode, options.OperationMiddlewares["PostAuthNode"]...)
+	router.GET(options.BaseURL+"/clusters", wrapper.GetClusters, options.OperationMiddlewares["GetClusters"]...)
+	router.GET(options.BaseURL+"/clusters/:cluster_id", wrapper.GetCluster, options.OperationMiddlewares["GetCluster"]...)
+	router.GET(options.BaseURL+"/clusters/:cluster_id/heartbeats", wrapper.GetClusterHeartbeats, options.OperationMiddlewares["GetClusterHeartbeats"]...)
+	router.GET(options.BaseURL+"/clusters/:cluster_id/heartbeats/history", wrapper.GetClusterHeartbeatsHistory, options.OperationMiddlewares["GetClusterHeartbeatsHistory"]...)
+	router.GET(options.BaseURL+"/clusters/:cluster_id/nodes", wrapper.GetClusterNodes, options.OperationMiddlewares["GetClusterNodes"]...)
+	router.GET(options.BaseURL+"/clusters/:cluster_id/services", wrapper.GetClusterServices, options.OperationMiddlewares["GetClusterServices"]...)
+	router.GET(options.BaseURL+"/disks", wrapper.GetDisks, options.OperationMiddlewares["GetDisks"]...)
+	router.GET(options.BaseURL+"/disks/:disk_id", wrapper.GetDisk, options.OperationMiddlewares["GetDisk"]...)
+	router.GET(options.BaseURL+"/nodes", wrapper.GetNodes, options.OperationMiddlewares["GetNodes"]...)
+	router.GET(options.BaseURL+"/nodes/groups", wrapper.GetNodesGroups, options.OperationMiddlewares["GetNodesGroups"]...)
+	router.GET(options.BaseURL+"/nodes/hbas", wrapper.GetNodesHbas, options.OperationMiddlewares["GetNodesHbas"]...)
+	router.GET(options.BaseURL+"/nodes/users", wrapper.GetNodesUsers, options.OperationMiddlewares["GetNodesUsers"]...)
+	router.GET(options.BaseURL+"/nodes/:node_id", wrapper.GetNode, options.OperationMiddlewares["GetNode"]...)
+	router.GET(options.BaseURL+"/nodes/:node_id/alerts", wrapper.GetNodeAlerts, options.OperationMiddlewares["GetNodeAlerts"]...)
+	router.GET(options.BaseURL+"/nodes/:node_id/candidate_tags", wrapper.GetNodeCandidateTags, options.OperationMiddlewares["GetNodeCandidateTags"]...)
+	router.GET(options.BaseURL+"/nodes/:node_id/compliance/candidate_modulesets", wrapper.GetNodeComplianceCandidateModulesets, options.OperationMiddlewares["GetNodeComplianceCandidateModulesets"]...)
+	router.GET(options.BaseURL+"/nodes/:node_id/compliance/candidate_rulesets", wrapper.GetNodeComplianceCandidateRulesets, options.OperationMiddlewares["GetNodeComplianceCandidateRulesets"]...)
+	router.GET(options.BaseURL+"/nodes/:node_id/compliance/logs", wrapper.GetNodeComplianceLogs, options.OperationMiddlewares["GetNodeComplianceLogs"]...)
+	router.GET(options.BaseURL+"/nodes/:node_id/compliance/modulesets", wrapper.GetNodeComplianceModulesets, options.OperationMiddlewares["GetNodeComplianceModulesets"]...)
+	router.DELETE(options.BaseURL+"/nodes/:node_id/compliance/modulesets/:mset_id", wrapper.DeleteNodeComplianceModuleset, options.OperationMiddlewares["DeleteNodeComplianceModuleset"]...)
+	router.POST(options.BaseURL+"/nodes/:node_id/compliance/modulesets/:mset_id", wrapper.PostNodeComplianceModuleset, options.OperationMiddlewares["PostNodeComplianceModuleset"]...)
+	router.GET(options.BaseURL+"/nodes/:node_id/compliance/rulesets", wrapper.GetNodeComplianceRulesets, options.OperationMiddlewares["GetNodeComplianceRulesets"]...)
+	router.DELETE(options.BaseURL+"/nodes/:node_id/compliance/rulesets/:rset_id", wrapper.DeleteNodeComplianceRuleset, options.OperationMiddlewares["DeleteNodeComplianceRuleset"]...)
+	router.POST(options.BaseURL+"/nodes/:node_id/compliance/rulesets/:rset_id", wrapper.PostNodeComplianceRuleset, options.OperationMiddlewares["PostNodeComplianceRuleset"]...)
+	router.GET(options.BaseURL+"/nodes/:node_id/disks", wrapper.GetNodeDisks, options.OperationMiddlewares["GetNodeDisks"]...)
+	router.GET(options.BaseURL+"/nodes/:node_id/groups", wrapper.GetNodeGroups, options.OperationMiddlewares["GetNodeGroups"]...)
+	router.GET(options.BaseURL+"/nodes/:node_id/hbas", wrapper.GetNodeHbas, options.OperationMiddlewares["GetNodeHbas"]...)
+	router.GET(options.BaseURL+"/nodes/:node_id/history", wrapper.GetNodeHistory, options.OperationMiddlewares["GetNodeHistory"]...)
+	router.GET(options.BaseURL+"/nodes/:node_id/interfaces", wrapper.GetNodeInterfaces, options.OperationMiddlewares["GetNodeInterfaces"]...)
+	router.GET(options.BaseURL+"/nodes/:node_id/packages", wrapper.GetNodePackages, options.OperationMiddlewares["GetNodePackages"]...)
+	router.GET(options.BaseURL+"/nodes/:node_id/patches", wrapper.GetNodePatches, options.OperationMiddlewares["GetNodePatches"]...)
+	router.GET(options.BaseURL+"/nodes/:node_id/tags", wrapper.GetNodeTags, options.OperationMiddlewares["GetNodeTags"]...)
+	router.GET(options.BaseURL+"/nodes/:node_id/users", wrapper.GetNodeUsers, options.OperationMiddlewares["GetNodeUsers"]...)
+	router.GET(options.BaseURL+"/nodes/:node_id/uuid", wrapper.GetNodeUUID, options.OperationMiddlewares["GetNodeUUID"]...)
+	router.GET(options.BaseURL+"/openapi.json", wrapper.GetSwagger, options.OperationMiddlewares["GetSwagger"]...)
+	router.GET(options.BaseURL+"/packages", wrapper.GetPackages, options.OperationMiddlewares["GetPackages"]...)
+	router.GET(options.BaseURL+"/packages/diff", wrapper.GetPackagesDiff, options.OperationMiddlewares["GetPackagesDiff"]...)
+	router.GET(options.BaseURL+"/patches", wrapper.GetPatches, options.OperationMiddlewares["GetPatches"]...)
+	router.GET(options.BaseURL+"/services", wrapper.GetServices, options.OperationMiddlewares["GetServices"]...)
+	router.GET(options.BaseURL+"/services/:svc_id", wrapper.GetService, options.OperationMiddlewares["GetService"]...)
+	router.GET(options.BaseURL+"/services/:svc_id/alerts", wrapper.GetServiceAlerts, options.OperationMiddlewares["GetServiceAlerts"]...)
+	router.GET(options.BaseURL+"/services/:svc_id/availability", wrapper.GetServiceAvailability, options.OperationMiddlewares["GetServiceAvailability"]...)
+	router.GET(options.BaseURL+"/services/:svc_id/candidate_tags", wrapper.GetServiceCandidateTags, options.OperationMiddlewares["GetServiceCandidateTags"]...)
+	router.GET(options.BaseURL+"/services/:svc_id/tags", wrapper.GetServiceTags, options.OperationMiddlewares["GetServiceTags"]...)
+	router.GET(options.BaseURL+"/services_instances", wrapper.GetServicesInstances, options.OperationMiddlewares["GetServicesInstances"]...)
+	router.GET(options.BaseURL+"/services_instances/:svc_id", wrapper.GetServicesInstance, options.OperationMiddlewares["GetServicesInstance"]...)
+	router.GET(options.BaseURL+"/services_instances_status_log", wrapper.GetServicesInstancesStatusLog, options.OperationMiddlewares["GetServicesInstancesStatusLog"]...)
+	router.GET(options.BaseURL+"/tags", wrapper.GetTags, options.OperationMiddlewares["GetTags"]...)
+	router.GET(options.BaseURL+"/tags/nodes", wrapper.GetTagsNodes, options.OperationMiddlewares["GetTagsNodes"]...)
+	router.GET(options.BaseURL+"/tags/services", wrapper.GetTagsServices, options.OperationMiddlewares["GetTagsServices"]...)
+	router.GET(options.BaseURL+"/tags/:tag_id", wrapper.GetTag, options.OperationMiddlewares["GetTag"]...)
+	router.GET(options.BaseURL+"/tags/:tag_id/nodes", wrapper.GetTagNodes, options.OperationMiddlewares["GetTagNodes"]...)
+	router.GET(options.BaseURL+"/tags/:tag_id/services", wrapper.GetTagServices, options.OperationMiddlewares["GetTagServices"]...)
+	router.GET(options.BaseURL+"/version", wrapper.GetVersion, options.OperationMiddlewares["GetVersion"]...)
+
+}
+
+// Base64 encoded, compressed with deflate, json marshaled OpenAPI spec.
+// Stored as a slice of fixed-width chunks rather than one concatenated
+// const string: with thousands of chunks the chained `+` fold is several
+// times slower for the Go compiler than parsing a slice literal.
 var swaggerSpec = []string{
+	"7F17b9w4kv8qhPYWSAC523nsAWtg//A6yazvZiYeO7kBLg4MtlTdzbVEKiTVTp/h737gS1K3KLW6/U4I",
+	"DDBOi48iWb9iVbFYvI4SlheMApUiOriOCsxxDhK4/hehJ1jODxNJGD1O1S8piISTQv0QHUTH7xCbIjkH",
+	"hHUZ9K2EEhBQyZdRHBFVpsByHsURxTlEB5Epd0HSKI44fCsJhzQ6kLyEOBLJHHKsepHLQhUmVMIMeHRz",
+	"EztSMuCyn5IUi/mEYZ4irAp3kKE+baJiyniOpaHjP99GcTdZR1kpJPB+whJTyE+Q/ThwYoTkhM4aBPwm",
+	"YMO05CwtMxDQMSG5ALlr57+zFPo7pywFf7/qy679nm4cNO8bMt9xyH+UwJeHySV4uj6DDBJpEKFYTKAr",
+	"IufohWr3JWJc/5OVEr2Y4kzAS4Sphs5CIeiSsqsM0hnkQOXIEf1NddcEkOrYQ+OEsQwwXSFygUmGJyQj",
+	"cvmBs7xN76c5oCnhQqIUL6tZg4JxGaOMXAJ6vf/67d7+q739VyP0Dqa4zKRAkqE3+6qKQBOYMg6opJJk",
+	"XTRPVd/DprVB8WfVpJ/kDPspJjTJyhTSNdrfrNEuWYqXXcTqkQyj9p8wI7RNYYMJgEpOQCCgKaEzhKcS",
+	"OJJzIlCKJbSm+NP+/oH+73+7qJvoLr1SSrW4J0kOUdxN8jss5u/pok30B5Ip2hitmRcBXRDOqGJHS+rJ",
+	"6bsuypTcvQC6GDZ1io5P+uMQQlQzI3SI/opyLJM5CITpEiVzzHEigYtemjQNg4h6T9NBq6kXgaoFtdzf",
+	"taJvBqwo0PQW6/kLZ2UxWbbJPmJ5jvcEqA1dQooyIqSCS8FZAVyqYUiGZqq6hZAoM4kmS/QCRrOR+TJZ",
+	"/gMXRSwWiSL2ZdcQbNlhk/wryYn0wzrH30le5oiW+USxwLSacckQB1lyOkL7KAdMBaIMZaqpLqL0xxWS",
+	"UiMCooO/7cdRTqjqKzrY79jXNbG/gcSeDcaIGZSDxCmWGBHq5rBgVMAIvad4kkGqptP2OkKfBSAt9dU+",
+	"sK+GxHJiGEs1hKYEsrRrNKrEsPn9jdAzWAAncjl4f8JI2CpoxgFr/HEE30rcKdNzQi9cpRXChk3sx+lU",
+	"gIcNzi6J4UezK7n1r3Q7iVFScsF4F13MNOxd98HL/pGnwHdHlWBcIWmETjhMyXeE3felme49NGUcqZbt",
+	"vsBUfxZ4zPT9D6UZqTHFe7goOqFnSw9jjRPOCtEe1GHHMOxuqrgbcDI3s58SrcFTzDt30EJ3M4iiM4ml",
+	"8E0zlZxlQi+6JkMo66KCmZIEkDZpUdRjdB4J1eB5hC5hGaOEUYmJFtSqntDMD2lzmCkRktBEogXOShAo",
+	"YSWVnfuJbr13ZDdx5KSAHtfb/X31P0UJUM3vuCgykmBF+Pjfgmn9oW7vPzhMo4PoL+PaLBubr2J8wtkk",
+	"g9z0sjph/8QpOoVvJQgZ3cTR2/1XD9HrZ4pLOWec/B+kpts3D9HtB8YnJE2Bmj7fPkSfvzOJPrCS2nH+",
+	"/SH6PGJ0mpFEr+jfHoaPjqkETnGGzoAvgKP3nDNu+n+QpVXdkgTQZ4qNJZCBFhe2qmrZeCM+lrIoNR01",
+	"mNW/SOrzH8QRB+n/oCBdekTQnwfoChNJ6CxGfxwYx0Yao98PtDGLKJNkStQvZwdIaAX59ADxUquF8Tn9",
+	"dIAk8JxQtU3E6OgAJZgmkGWQntO2QqfoSIFzj0jRn1gp/XK0Nl2/RNqWteMxI67qVu1/rbpmk3+D4S3t",
+	"VDlMLtuziZPLi4kzcoYoprGukrA8t1zi/Q403apBSC/MZtz+6Pw43rU1Xy9h6Vc3L2GJSApUkunS7RG6",
+	"CsIJZ0IgIgXimAgQPsLIBofYijnv9x61Vq/hl6qJjxvrUE/g6lQ35qlziZ1aeMJhQeDKs9xF4Z3lSUky",
+	"2dT3vNPpvtZGkXXAeMYeN0wzX49AF97fjfmX+nQY1ZUzD0foeKogGjfW9BKgMEuqh7NHaEXxKIpbXpTY",
+	"uKY6KVTd+edB6QqV103Ro3hLF4/9zVwQmsJ3f2P6k7e1GO29QkQNk9ovkNY9NCXcsFWbkQVQZbD00N1s",
+	"tSwKDkJ0WXKN+siVBVGvh3fKJZ4ZKS4hF95ptz9gzvGyBaGmuW88EYqjmytpu/CwdGOeGoOrOc6u+MqK",
+	"eZFWFE0n1nCQ4aLoFmRrLa5O+AnwBKjEs4rtygJhmqIUZhynkCIlWxFbALdf4+rTOdUF2RXVhUbo9zLL",
+	"DFshUSZzU/UKC2V1i5JDOtL7Vy27WTnR80LLzOzX1olqR2FseY1426XPKk0YTYUie1LKija/3GBXtLsF",
+	"/dVXDb4bz2B3VUKbMjtFJW3OOiqAE5YKJVUQTrSZ0EHh1HpaWyvMSrVGqwzepyc1+eijrttGQBwJozIN",
+	"b9bqWM3Wz8o8x3zpa76kl7R3yrVFS5lyGGCKSpqCUXwgRZUu0p6k0jl3W7NUFn0MsnkftThy2Le+Z+fV",
+	"1S1YLmnwWcUe9YDXUNeY6HolvQKgvWo+vQpSv+Q0TSMiEFBJOGTLW3JmQ7xuqcqlJceGso718CNtG+VO",
+	"LJJVobfyyVjdm9Re20ZdI6685EZPqsZhlSTvsh1TITFdhYVn4YIgfj6C2J0t+rjLede8H+9L5t1SstVn",
+	"pRX1txBoPhD8SoR0Lm8v42dwUTgn4lAlLY70Ivk1G+d/032kKVHTgrOTlb7btVqEu4O/XajL3IFEux9W",
+	"eanb3ySTOOsIVvBO7Kn1C7YnVzlW1f8ZhY/T6OBLm/q6pTXqu2ftFrO59sNXZXhZruhTLCruaevlEnv5",
+	"7QQnl3gG78h02p4VoiRylnksDCU7C1MVLYALwqhAtjik7uhQUYg5pNphI9TxofqjOoG35VHKwAiTOV4A",
+	"ajStncBDFCo7imPToI/FisvZRae0UR87zMy1eayaaVSK63nqmWFHW2uSd5aRigDMk3nnR7swm0flFWvN",
+	"Fhqd9QxR+LnIYWubldQteZZRM5KfHVeZbUvO0aSrKJqNFq5rfROi6hbvasU3L5qXHOvqbVEh4bv0eXHm",
+	"ZY7pHgecqs0Gwfciw1Rrb0gUkJApSXQEhzpwZ0lScg40cerWOS1MfyOfj3VtBJoCH80e+ygogj+mRU6s",
+	"0j/cePaaCR5RcU+2/i7m0jO04T0m3d3Z8fWi9xvyPX6SIA6eszh4Yii6e6zcqU3Y0ONWeb7xAb7jvFCc",
+	"Fe2P9kevNu69rmq7P7UGkJTKD3+mRKPpaoIFSQ5LOa8OmVUd/Wvd11zKwni5MAfuSpt/fXBo+K8/P7n4",
+	"Dd2E/rrehglQmTLNBkTqgbECqFgkKGFZBolUa1yQqDE90avR/uiNlv0FUPXxIHoz2h/tR7EOPNYDGZv4",
+	"d/33zBf/pGwof0A9MQKrAOPMUoHP0S8gD22D8Uro/hf/blMXGa+EBN3EQ8ub6L3h5W2c1/AKxoAcXNwE",
+	"EG1Bj42XGl7DRVjefF0L7Xl9hyEZK/4BT1zEx/9uBIH4GqooG6tCTRhpZmgA6MtXNfYmSL58vflqD8YO",
+	"vkQVg0fK7i+Y8HDpH5orbew6o8rixsa6NrLPoBm5nXZ0Ts/pp5qpF8Anev/Tsy0Q5qDir0iqY+rwDBNq",
+	"UcBLSoEjnGXsai8jQo5MQ+rYWzUA3yEpZW3zaxqIQBxoCsocU2oDkqtdx+fUdBwjifkMpKYFz4BK507Q",
+	"FB9WA7GVrQgTaohubG5HtyUbdIzO6ZnkZSLVpmybEK6NJk26d/3nhZ2QhGVlTlXY4zmtC14YYSDVJm42",
+	"+VVpcMJEQxxwEw72T5Yut2LT9ZMK/wnA2mpGcWMP4CAk5rK9C8Tu+o+sIr9tZGZUlGKuGqEqNvOL+2dR",
+	"Zln01dNOw4ht02WYIFW86IzT5nTzUs2sNwjHTH+398woTr0zgRpiuDEp+kAkqXQvrmhXC/GXV14PXK2i",
+	"tDtz4EpjVFIBEk3tQB2Pbdx+a+Pd1PDtwzfr12Jubin+PB34ZNzbITJOFarDHDeVfdWITdxU9k0jpnBT",
+	"2bfbyeQqiG5T2Td3Jr9v4krjGF9XV+9uDF9lID0RLEc6TK0h2xtX+rRuvQRlAOhfU/TCKLboTwW2P162",
+	"RNI73YsRSjuoKCu3D2++PhQHPj5X2QjTTWX//khagVd1fUdEkeGln3W6Vddbs0W8pa77+Hrc/ciX+5UZ",
+	"Y1ZF3Pauvg6010IhtoH6KGEpxMhEomplx8Si6pD97XjFRv0+tiDpdeQ1Ce3hgMfeje6SW/RNos0m7drN",
+	"bNG8HSwMY9gQG/1zKYAjIs6ppZyoA4Ep4z7VV3GIoWJHO7i6j3gTb1NFXaXcwrZtXM0aXstcNd5azAWT",
+	"/jmY9Nuou48I7rELjh0XdfC4F+1nc3bVuF84chVNJLCaThNrz7Tsr273mjh7bcfjc1q5EpxEiLV4qO+p",
+	"qiaq6O0eebAe8t6SDh5brqLJXuY9d5F/tcc5g/NoyJ3jLRILXLcuHGkjVt9OIOpyoDXa0OfP6pJBbdp2",
+	"Xg2srbwtunUXcJo9G2O06njTbeDaIT2825VIdHeDQi/E+mWCtZv86tC3Fc+/2oK+PH5Ou6jd+g7rvWoP",
+	"Pqa9I1v5WWgR42t3BeZmjO2VJK8L8rA+CkJ4XbUw6Sg0D8D3gvClvpzfcEOu3M0xd0cdyFcOlUxrRCCc",
+	"CYY4JIybMzlhVNdNx1GOEysxlpYKAuhqTpL5OW188vRrHKPK5jbCsdPn5+5v7agX25w6N1/vzmlYRfxV",
+	"Hj598z1uLaIuuH5hqlIEOyaVUOsWbX4zOUDESuhHI/a5cSfN579bZQgOWPg9hJqd4AIPbAhouiqwXr9F",
+	"c1ZyYRzTlF2NonhQrPSaC88N5yE8dxsFluK9n9Chd6/CDxYuJVe/UaVFg9aOkgwwR3MiJHP34du5sPxq",
+	"0nvT2S3lR7BQfkwL5UfCWVFswFSl4SNd1ocY83s4bg/H7Q903H7EAUtz3l4PWbtV/fqgYdA70uV6rqum",
+	"LMeEdn6WgPMLex2jVWBlhJuijRUR4YxyTdQ+4RMiJ2rH1+Y2Zu+JozkjHMTd9jjRK4DXLIuisOaajQTA",
+	"ReHa9OUDdZdGh3tswmnk8ziNLIpGvqeO/fzxuemhDzEHc98TVug6t8s5pjOvQOnjBLtzPhHB8qy37rBP",
+	"PzOTqNqnxzi/IBeNA9dOY+nUnO2r1VS3EczRjOZafWqL1g5tzY2tjRu8EceH+fFpXf2H2errDM1PeK9/",
+	"Cjy4dpmmg/8Kxq3nuFF+zeEuXJBJvT5xFRN8TlNMsiXiLMvKQlRnjITbLBxihD6te6ZXPdLn1HvtRDvu",
+	"3XWLrvPJtURBz0cFaaUV362qye99v8dpa3P8k5ykraCpKCdu9ja5vnwKU13bpITuUqFPmt08O3U6OOSe",
+	"hyv6WSGvoQLtgLxG7X7knTa7CcgLyPspkcc5Xm5AmZCMqzvYtqwPTe5LONwJhzv3waWlnI+pS8vidV6d",
+	"woxoG9peqFRVgEo7FV0RQKV5heneT322SxDTkRamnSV/2+VfTxRlr2qvU1uW3mv+a5TqUvbCt/+QqRW0",
+	"BIVcyXj/dE5sbuKtGFRxl+VN+wLZgJATV9K9HWKidZ2d3YzdX/cC+WTukes3SN0gde9F6jp+HV/Xr+zd",
+	"9AewY8fksWFyIoVmcxFXnqXYvUIGOaP2ypNJxtK6TS56+H7HoKv6xcGgcQeN+8mgajwHzOUEsBy+j6C6",
+	"joYRxIhRd+O4AHPTIUYF2D8Vvs5pVUnn2zeOWvdalNmXKIK80EHDwC/c7Qls7hI06+s+kYA6+73uhUgB",
+	"2dQ0rMqpsG39SJDa1/YRmaprKtLZ7ZMMYqTz8duyMXqNdJYm6X7p8ANbJP+rnrggEYJE+NEDMTdJj7GN",
+	"Y94sRQx81Z7rUOqOZNi0Q8zEKGdCqoulCVBpnppzj4e6h2BMaknpGourJzxNrxl2DZBFld9rKML/Zcf2",
+	"cEA3L5UOL/+eBjESbpz+DCKnyuk7TFcxxbtB/rv9HnbwAL0fRadvvjAzDCXNp1I6gHJWFwlYCVh5flhJ",
+	"ibjcAAlTxIOBd/ZDcHgGh+d9seb4Wv2v19WpmdQ5TaYm34ZLsq8qd7HupigDVaaRQcIfW2CpC8EFQdQ/",
+	"dVE/wEbotAt2NQgCGwdRP5Q1xzY+rD/GLMsMl6KMJTizMWUj9FnY+LLJ8h/6//qJodj8SVIkGZoSm3zJ",
+	"1DH+9ZRMp6BdZDOSilEn7//iYtcCAgIC7g0B8wkezP8fjrS7mJwdnR2jORMSTUqBcIoLG4fgZ+R/TXBg",
+	"48DG98rGpQA+mI+NHNdVVsW4+slIcf3XmhDXFdZleNkrwz+LEKATeP+eef/antHvZq/SjuuENiyy114d",
+	"nmvRb8rWmRaDKRtM2advytZQu1XuZtwLup3zMSv3vmrgON0yGXPI3xwA/7RP05+ZcEgwTfWjTBemRq+Q",
+	"wDOBsJQ4mevsaZK5yPAX7tUQ8xVSbX2pH90N6ZddMuTIEfAJzzyiJOziAdRhF1dAZXmREUwTaGA2Z2mZ",
+	"gYCe7f0XkKiqgOoKVrlW7ftD2jQ4q04rmP5Wd/lA+37A1bPAlfquVEYfszUQ5xHoap+YspKmDbCtFjum",
+	"EjjFmY46AY6Ac8YfHm58O7DxW0LtNAAtAG0I0PiPALOMzTYAqyqLVNktUfUrmz00kG7LJNWr8q23I1cf",
+	"jW+zyJFnqtytl2fNJAMVnrpYbZFItgW3BC0nCN9e4Vux1Q+i5NTDGF/nAuTmjL5q/AjX4zc51zohZvL6",
+	"dqDsAUCmyv8mQPofB/SsWkUcEmWSgBDTMsuWKAWz8P2rzXhjYh576buSqB7K1hr2CUmVheIJrd9uOTB2",
+	"yFv6uo83nBx4KrlIn5qvYpDJxG+3WQc7KWzVQ7bqH8JM4tU2zbfZpvnOm/Tpg4r402226NNbbdD8uWzP",
+	"fKfN+RHX7TG35tOwMXfLkaEXmuxziUMCbzruOYVzu7Cdh3O79GZY3L45RW8G7XfBrSvUPuAt4C3gTeWX",
+	"2XxLwKDNpYodfDvAfzkgAC8ALwBPAW9INieLPZvcdYkS/Y6TsM/wY3QJnEIWoxxy/cItRwI4wRmiZT4B",
+	"fk5NBZPdCTWTO3U7ijpTMQXoBugG6KY3Y0Il8CnemP/FopeCvGL8EjWqdUDvuFkioC+gL6Cvjb4CJ5d4",
+	"NhB7hAqJswxSVFXrwN5J/T0gLyAvIM+HPJnMtwaeRRudIbEUEnLkmukEovsccBhwGHDYxuFWV4+a0QFd",
+	"kAt3iQLeAt468TYkBUXzSMKU78BaR+KIALYAtgA2BTb7QpUXa++IKDK8NGBTJUfoI83sv5uPS+oLtTmm",
+	"eAZc6CcucJaxK0g7M7ooZD1FWH59qIfhf9KHtlkBFBdk5GbO8l2LR86u8GwGPHoCiH4CETduNs1jyHYq",
+	"h3lm5BwqZ0zTTKTVlZu13E3F5cykblJ/2PejzqlkSD8tXtfSL+PYlt07Ux3vTwx19nwgmQTuaHNtm+d1",
+	"DtFfUW5sVYSpPpvhOFENjRz6v6nNoIa/G0i0YRseQoMb345k2Op3QQnmyZxISGTJoa9LVe4u+lPV7AEY",
+	"L3IldVOY9HWs+wmaT8hD0h3F+Cg7jxOCY5VzbguZSZlsyE2dtU59FzivxIIwL4I1oSkUjlSmPF0WFCWQ",
+	"WnHbLyTfKfI2CMojlud4T4AqpNrNbDA5XdWYRI/K1AVhl0d4C8FxpofX2Bn0u0dE1E8YEopwmhJVXrlH",
+	"6pLVKLvIqZ+BiO5Tbevj/5WFCa/atHE1wE2tVrzLM92hlfgx0uGsDhtKSAR5F8w87KWZvtdlbvGsTODR",
+	"wKNb8Oj4WiySndOV2lZ6WHiTEmKLrXhoDEWVtiEWSY9/xhQOXtNgOzx1HacFuVumLd0Mvq7kpc8IgiEj",
+	"apAmISPq3UueBSYZnpBMt94hf06hYNxIoGb5Felj3qCQ1v6iCYjYXMDWz5OnmGRLxFmWlYWIdVnjyjB1",
+	"WSmVTWxeJ8fJJWVXGaQzSM9pSVe6dI8h67fKE+1EBvMMusu+2uEQcZKwOd7nLA+bA/nAWR7tVvUzlSS7",
+	"X5z5Zv5nhdutUxA7xOyYhdiuxVaJiINmHvbSn1Yz3ylcb7NCHnAXcBdw18LdRaU8DnOZ1spmn/P0uFEo",
+	"eFGDF/VBGHigP7Uq3+FRRS+qB9JeDuHxsKkEnIVNpQOTF0JiWYqLjM223V+QqapSTI/Qe5WrDKjkS0QE",
+	"wkiSHBDHdAboag4cGnaaa8DVv8K6qUkGo0Fb1pmu9iubhb0r7F33g5PNJg58J0KHWkhjtrTY1m/OBAYN",
+	"DHpXDDrk0XodIIdne6qotchzRVoXx4a37APb3jfbDgsBcpxbnaVsZt4QGRT49wH491riWa8V6251STyr",
+	"Ul118Owm2/T4nYn1BdWY3/o01AyxPgmVMAO+g/n5YJe2nrj9tLL+G/bfX0DaGGxjANlF1OaRS07sZ4qO",
+	"TfhJcsa9C8vHe0Xp10bMv3D3EojQ892VXPwTnnkTij8mm24Xcrs1t3bvuj8nw4bd/WfwpLkLh12Y4iBL",
+	"ThEuCKrvJrbg8z/Vp3ubb9f73ShS1XTgwsStEBBqRvTM8oVDfsmz6CAajaObrzf/PwA=",
+}
 
-	"H4sIAAAAAAAC/+xdzXLbOBJ+lS7uHpIqRVImnsO4ag4ZZ5L1bn68crJ7SFwuiGxJmJAAA4C2tS69+xYA",
-	"gqItUKTkWJYTnFwWm+gG8H39AwLkdRTzLOcMmZLR4XWUE0EyVCjMf5SdEDV7J1EdJ/r/BGUsaK4oZ9Fh",
-	"dPwK+ATUDCHjSZGiRBX1Iqov5UTNol7ESIbRYZRJVOc0iXqRwG8FFZhEh0oU2ItkPMOM6KbVPNeiUgnK",
-	"ptFi0SuVv+cJrlfOeIJ+vfrKtnpHrZ0W67ostuzyvwsU8zeCF/l4vqr8iGcZeSZRz5LCBFIqlTYnFzxH",
-	"oShKUBym+nZrIsoiVTCewxPsT/v2ynj+O8nznryIta1P+64D37TqZQ9K2aiTxW9pRtWqvR81NsgVzYoM",
-	"WJGNUWhrkSlRmipQFYL1YQgZEiaBcUh1U01GmYs3TEpwQopURYe/DntRRpnWFR0Oe85WyhROUdSNfYeK",
-	"eCaWxWmRIGSoSEIUAcrcGOacSezDn4yMU0z0cJZa+/BJIkxIKhG4gKHuEs+osqRARWBCMU2aeqMluo3v",
-	"h8lEQ23F6NOv1M70hAqpqpEtEWq6ERdCctFkArcNe0e084B+EAmK7fEqudAY7cOJwAm9AuKuz+GSqhk8",
-	"gwkXoFtGllA2Ba71lZDmVvfvmuu6T71nJM8bQV1Kdxv0E8Fzudqplw3doCWAKAMk8cyOfkJjfRsjYt5k",
-	"U27UdLLoVBElfcPMlOCpNJNuzJCUsyWANccwqduirSfwJZK6wS8RfMV5D2LOFKFMj7C+T2KKsZ61WjcT",
-	"KhVlsYILkhYoIeYFU7KpZ6b1tT1baP9o+WX6dTAc6j/aEmQG7yTPUxoTbfjgL6m7e11r7+8CJ9Fh9LfB",
-	"MooN7FU5OBF8nGJmtdwcsD9IAiP8VqBU0aIXHQyf70LrJ0YKNeOC/g8Tq/bFLtS+5mJMkwSZ1XmwC53v",
-	"uYLXvGBlP3/bhc4jziYpjc2M/robHB0zhYKRFE5RXKCAP4Xg1jOWN+u231KpXMhZUsnYdEFoqmPKee5c",
-	"DVWYSQ9TKvdLhCBz/b+hXk2y8su9yLHU6EgSqq0l6ckN3at3lb/w8V9oB7F0aMk21qUuIVjVw6tY5rGB",
-	"K5L6Lvns0wM7Kr3H6uBq96v/coYfJtHh51Xrly3dsr551O4wmrd+OFv0bPxvQV+FHusrXS752fbvzKPI",
-	"4XVlRBReKV84mxUZYc8EkkRjEfAqTwkzTAGZY0wnNNbxTc2oBB7HhRDIYiyTjC8st/r6X1jU84Suus3G",
-	"Ap/NFygktaS8aXPtAl6RLE/1fcP+sP+8VZm7dVWf5ifGhaBqfqqH2aoaE0njl4WaVa5B32N+XeqaKZVr",
-	"g8dIBAonbf97zUVGVHQY/fO/H13UM02Yq7fbsGF9ws3MUGU6xnNk8iKGmKc69nIBJKdRbXii5/1h/4Vh",
-	"UY5MXzyMXvSH/WHUMwWI6ciA5JauU1/KqAEFlecBI2uaE2bKdc0TvUH10v5erwc/+5G6FBncSJw0j7rJ",
-	"2+qhu3yZDXe/wRKos7hNszawp8wqu9/hKrzF2a0E6JfvGLhu+EdP9Prwr1qo9DVUWTbQQnXaGDDUCPP5",
-	"TPe9TorPZ7pvikw1cKIK0JH2ezmXHmAeCSQKgTCodRliW93fxOcJlw6gwqZxf/BkvtHA3YrEee4NayTP",
-	"zxOeEcoaLysk2XkZH1cEbvTwusVhaSM8zmpxew1hcUfMeBT4gHHQBRhaaJlBt8k+r6W9bbIvaqljm+xv",
-	"DwLkRc+62sG1xgFNFhbTKSpcRfcr83sndFtRvwO+FbvzHATGXCRAEzDhIndtehalrJkbrUmd7Qpr94Wf",
-	"gy6yB3uPtZ4/mL+iMk/J3Mx7zaP54/nDo6m3YQaxM/R1R8n+hMsZYVOvQ1mHhDJy7oljedShO8Tp7fzs",
-	"w8fpAcnO6XmpjI5TbCyWRmbtFPRsAi0f+1jUogAqodaGWaQ2NXJrgLfu+GV2PKqZ8KOE+jHnKRK237F+",
-	"DzCYF2M3mG3Fus/FL++2T/Wagv5JXc2jSwDCEsJjWEJ4ZMyrOe0tmFd3+WuZN6qrCcwLzPspmScEmbew",
-	"TCouiC5lrKyPTe5KWI4Oy9H3gdJCzQZmH5kuL73l9gin1GT9xGw4A30LMlUOhXn25am0C7uB7d7Xqd3u",
-	"l/ZF5krSt9J814r1prHV063b1hYFTdotNVLlMzL/svitGBrHmKsbWyv2Z43Z4LE7QDW6SmwmVH5tcaBW",
-	"xOM3X5UXgtsMbvMe3KbB3eBa/3GPP5pB6vYmTnR2u9xYoG9ugm5b1qxlgCbaDU+oecLuyZVL60KyHJLl",
-	"fU+WdWxucfVWxMOX9+WF4OqDq78vaA5mY9K2YpKmFqPw+ggIS4CeHp0ew4xLBeNCAklIbsDZBOF/aBUB",
-	"xgHG9wjj6/Jg0HYZC2t4pFMWemszFi1Ty1jgSWkJfPp0/Aq4AFeePf2OJ5oCfUIy8+BUG8SEJTQhCs/t",
-	"HeuYpyWAKEXimdmkqrhbdnnCuII5qvIqJibI6B/xyu5Wf9rEzSNnwEetPxA1EDUQ1UtUnuUpJSzGGmer",
-	"E77NzH2DCqoblkeCXfzU7XuWSR05K6UVTd8tVW6eD9aODgde/WC8elsev/SBrcY4j0PXcWLijqWVZGs4",
-	"0SXtiS5cnujaJd3EZmQTd6TaKBAtEK0L0cSPQLOUT1uIVcmClt2QVW/5dNdEuitIup6M9B26XR2q6qUc",
-	"jxkkHROeWppTVSRVtdIFLSHLCc53rfOtYPWDJDnLbgyuyxcDtRyc0v0Hsuw/TATPmilmj081sGwHJKu9",
-	"MskDIM+sVcaBLOIYpZwUaTqHBO3Er59tLmoD89BT33RW5aVamcN1TvKES7VH87fdxp0tjof8sg4bzg/s",
-	"y5GPfVur6FQyibsF61AnhVDdJVT/EGWSqMK02CRMi62D9GinLn60SYge3SlAi8cSnsVWwfkB5+0hQ/Mo",
-	"BOZmP9J116p9EV2nZ+sNm1nDc7sQzsNzu2TRZXOWfYbuztt03pTl35MViBeIF4iXLAZUJ20TEmM3+jFU",
-	"l1x8hdptDbw7rksE9gX2Bfatsm+j3WT1BZ8m1oXtYYFvgW+NfHOHN9e+j8wEOi3Zhw8sLf+vv3fBbNvM",
-	"CCNTFBKIQCBpyi/RvCvfS0vNrH2k5U/0hrwHwV/5otu+G7kSdysYOb0k06k5BPjgjN6DdR03mvY9QeVQ",
-	"ShQXtDVNraQ8RDxdXgsndMIJnXugu0Pf4FpexFuf0SlbWQPhtlBSit2IJtaiKpi4L+j4Y4kVDhleyPD2",
-	"PcKuUO7uZ3XKJrc9rlOSb6MTO4GwgbA/LWG3WgRpD5KBd4F3gXcrvDunTCrCOldSsJRfU1Md14RCcRWK",
-	"q50AuGOZVck3FFrwpHrxx9MuGA9BJfAsBJUGTp5LRVQhz1M+3TS+gL0VUj7tw58knpkFkjlQCQQUzRCE",
-	"+WrE5QwF1uo014C7/5KYpsYp9juFrFNz21s+DbErxK774Ul7iYNX5ruXU1Pr+GDrL2cCQANAvxdAu7zC",
-	"T2dKikyf2dcIm4o8M5/fb0BseLNfgO19w7bbk0GHXJc1dABveGAY8LsD/F4rMl1bxbqNKYpM3efR5g2Y",
-	"batNdflpPwWljLCn+rTWdKk+ax+4Dt/Gu/v8t8TfN1i+QbcsgMpJNOWRO8XjB0VDEN5LZNy7s3y41424",
-	"k5blJFI1s59c0+PddArvI5l6T949JEw324mzMVqbo+7PCdgQ3X+GlbTqK/kNnBL2Y44kp+BEPfT5T3Xp",
-	"3sbbaf8+iVQ1HCQnY5pS8/mVs4UdWXHhmF+INDqM+oNocbb4fwAAAP//b3zgBvaNAAA=",
-}
-
-// GetSwagger returns the content of the embedded swagger specification file
-// or error if failed to decode
+// decodeSpec returns the embedded OpenAPI spec as raw JSON bytes,
+// after base64-decoding and flate-decompressing the embedded blob.
 func decodeSpec() ([]byte, error) {
-	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	encoded := strings.Join(swaggerSpec, "")
+	compressed, err := base64.StdEncoding.DecodeString(encoded)
 	if err != nil {
 		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
 	}
-	zr, err := gzip.NewReader(bytes.NewReader(zipped))
-	if err != nil {
-		return nil, fmt.Errorf("error decompressing spec: %w", err)
-	}
+	zr := flate.NewReader(bytes.NewReader(compressed))
 	var buf bytes.Buffer
-	_, err = buf.ReadFrom(zr)
-	if err != nil {
-		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	if _, err := buf.ReadFrom(zr); err != nil {
+		return nil, fmt.Errorf("read flate: %w", err)
+	}
+	if err := zr.Close(); err != nil {
+		return nil, fmt.Errorf("close flate reader: %w", err)
 	}
 
 	return buf.Bytes(), nil
@@ -2661,7 +4600,7 @@ func decodeSpec() ([]byte, error) {
 
 var rawSpec = decodeSpecCached()
 
-// a naive cached of a decoded swagger spec
+// a naive cache of the decoded OpenAPI spec
 func decodeSpecCached() func() ([]byte, error) {
 	data, err := decodeSpec()
 	return func() ([]byte, error) {
@@ -2679,12 +4618,12 @@ func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
 	return res
 }
 
-// GetSwagger returns the Swagger specification corresponding to the generated code
-// in this file. The external references of Swagger specification are resolved.
-// The logic of resolving external references is tightly connected to "import-mapping" feature.
-// Externally referenced files must be embedded in the corresponding golang packages.
-// Urls can be supported but this task was out of the scope.
-func GetSwagger() (swagger *openapi3.T, err error) {
+// GetSpec returns the OpenAPI specification corresponding to the generated
+// code in this file. External references in the spec are resolved through
+// PathToRawSpec; externally-referenced files must be embedded in their
+// corresponding Go packages (via the import-mapping feature). URL-based
+// external refs are not supported.
+func GetSpec() (swagger *openapi3.T, err error) {
 	resolvePath := PathToRawSpec("")
 
 	loader := openapi3.NewLoader()
@@ -2710,3 +4649,22 @@ func GetSwagger() (swagger *openapi3.T, err error) {
 	}
 	return
 }
+
+// GetSpecJSON returns the raw JSON bytes of the embedded OpenAPI
+// specification: decompressed but not unmarshaled. External references
+// are not resolved here; the bytes are the spec exactly as embedded by
+// codegen. The result is cached at package init time, so repeated calls
+// are cheap.
+func GetSpecJSON() ([]byte, error) {
+	return rawSpec()
+}
+
+// GetSwagger returns the OpenAPI specification corresponding to the
+// generated code in this file.
+//
+// Deprecated: GetSwagger predates kin-openapi renaming openapi3.Swagger
+// to openapi3.T. Use [GetSpec] instead. This wrapper is retained for
+// backwards compatibility.
+func GetSwagger() (*openapi3.T, error) {
+	return GetSpec()
+}
@@ -1,19 +1,158 @@
 // Package server provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.7.1 DO NOT EDIT.
 package server
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/oapi-codegen/runtime"
 )
 
 const (
-	BasicAuthScopes  = "basicAuth.Scopes"
-	BearerAuthScopes = "bearerAuth.Scopes"
+	BasicAuthScopes  basicAuthContextKey  = "basicAuth.Scopes"
+	BearerAuthScopes bearerAuthContextKey = "bearerAuth.Scopes"
 )
 
+// Defines values for PostActionsJSONBodyActionType.
+const (
+	Pull PostActionsJSONBodyActionType = "pull"
+	Push PostActionsJSONBodyActionType = "push"
+)
+
+// Valid indicates whether the value is a known member of the PostActionsJSONBodyActionType enum.
+func (e PostActionsJSONBodyActionType) Valid() bool {
+	switch e {
+	case Pull:
+		return true
+	case Push:
+		return true
+	default:
+		return false
+	}
+}
+
+// ActionOutput defines model for ActionOutput.
+type ActionOutput struct {
+	Id  int `json:"id"`
+	Ret int `json:"ret"`
+
+	// Status W: waiting, Q: queued, N: node notified, S: sent, R: running,
+	// T: terminated, C: cancelled
+	Status string `json:"status"`
+	Stderr string `json:"stderr"`
+	Stdout string `json:"stdout"`
+}
+
+// AlertAck defines model for AlertAck.
+type AlertAck struct {
+	AckBegin   time.Time `json:"ack_begin"`
+	AckComment string    `json:"ack_comment"`
+	AckEnd     time.Time `json:"ack_end"`
+	AckedBy    string    `json:"acked_by"`
+	AlertId    int       `json:"alert_id"`
+
+	// AlertKey The key identifying the alert across its raises
+	AlertKey string `json:"alert_key"`
+
+	// Id ID of the acknowledgement
+	Id int `json:"id"`
+}
+
+// AlertSeverityPreview defines model for AlertSeverityPreview.
+type AlertSeverityPreview struct {
+	App string `json:"app"`
+
+	// BuiltinSeverity The severity before the rules
+	BuiltinSeverity int    `json:"builtin_severity"`
+	DashType        string `json:"dash_type"`
+	Env             string `json:"env"`
+
+	// Matched A rule matches. If not, the alert keeps its built-in severity.
+	Matched  bool   `json:"matched"`
+	NodeType string `json:"node_type"`
+
+	// Rule The name of the matching rule
+	Rule string `json:"rule"`
+
+	// RuleIndex The index of the matching rule, -1 if none matched
+	RuleIndex int `json:"rule_index"`
+
+	// Severity The severity given by the matching rule
+	Severity int `json:"severity"`
+
+	// Suppress The matching rule suppresses the alert
+	Suppress bool     `json:"suppress"`
+	Tags     []string `json:"tags"`
+}
+
+// AppAvailability defines model for AppAvailability.
+type AppAvailability struct {
+	App   string `json:"app"`
+	AppId int    `json:"app_id"`
+
+	// Availability Percentage of the up and degraded time over the up, degraded
+	// and down time. Null if no such time was measured.
+	Availability *float64 `json:"availability"`
+
+	// Degraded Seconds up but degraded
+	Degraded int `json:"degraded"`
+
+	// Down Seconds down
+	Down int `json:"down"`
+
+	// Excluded Seconds in acknowledged unavailability periods not accounted
+	Excluded int                          `json:"excluded"`
+	From     string                       `json:"from"`
+	Outages  []AvailabilityOutage         `json:"outages"`
+	Services []ServiceAvailabilitySummary `json:"services"`
+
+	// Unknown Seconds with no or an undetermined status
+	Unknown int    `json:"unknown"`
+	Until   string `json:"until"`
+
+	// Up Seconds up
+	Up int `json:"up"`
+}
+
+// AvailabilityOutage defines model for AvailabilityOutage.
+type AvailabilityOutage struct {
+	// Acked The outage is entirely in acknowledged unavailability periods not accounted
+	Acked bool      `json:"acked"`
+	Begin time.Time `json:"begin"`
+
+	// Duration Seconds
+	Duration int       `json:"duration"`
+	End      time.Time `json:"end"`
+	SvcId    string    `json:"svc_id"`
+	Svcname  string    `json:"svcname"`
+}
+
+// InstanceAvailability defines model for InstanceAvailability.
+type InstanceAvailability struct {
+	// Availability Percentage of the up and degraded time over the up, degraded
+	// and down time. Null if no such time was measured.
+	Availability *float64 `json:"availability"`
+
+	// Degraded Seconds up but degraded
+	Degraded int `json:"degraded"`
+
+	// Down Seconds down
+	Down int `json:"down"`
+
+	// Excluded Seconds in acknowledged unavailability periods not accounted
+	Excluded int    `json:"excluded"`
+	NodeId   string `json:"node_id"`
+	Nodename string `json:"nodename"`
+
+	// Unknown Seconds with no or an undetermined status
+	Unknown int `json:"unknown"`
+
+	// Up Seconds up
+	Up int `json:"up"`
+}
+
 // ListMeta defines model for ListMeta.
 type ListMeta struct {
 	AvailableProps *[]string       `json:"available_props,omitempty"`
@@ -42,6 +181,36 @@ type ListResponse_Data struct {
 	union json.RawMessage
 }
 
+// PackageDiff defines model for PackageDiff.
+type PackageDiff struct {
+	// Installs the package versions installed on the compared nodes. A node without install does not have the package.
+	Installs []PackageInstall `json:"installs"`
+	PkgName  string           `json:"pkg_name"`
+	PkgType  string           `json:"pkg_type"`
+}
+
+// PackageInstall defines model for PackageInstall.
+type PackageInstall struct {
+	NodeId     string `json:"node_id"`
+	Nodename   string `json:"nodename"`
+	PkgArch    string `json:"pkg_arch"`
+	PkgVersion string `json:"pkg_version"`
+}
+
+// PackagesDiff defines model for PackagesDiff.
+type PackagesDiff struct {
+	Data []PackageDiff `json:"data"`
+
+	// Nodes the compared nodes
+	Nodes []PackagesDiffNode `json:"nodes"`
+}
+
+// PackagesDiffNode defines model for PackagesDiffNode.
+type PackagesDiffNode struct {
+	NodeId   string `json:"node_id"`
+	Nodename string `json:"nodename"`
+}
+
 // Problem defines model for Problem.
 type Problem struct {
 	// Text A human-readable explanation specific to this occurrence of the
@@ -49,11 +218,72 @@ type Problem struct {
 	Text string `json:"text"`
 }
 
+// ServiceAvailability defines model for ServiceAvailability.
+type ServiceAvailability struct {
+	// Availability Percentage of the up and degraded time over the up, degraded
+	// and down time. Null if no such time was measured.
+	Availability *float64 `json:"availability"`
+
+	// Degraded Seconds up but degraded
+	Degraded int `json:"degraded"`
+
+	// Down Seconds down
+	Down int `json:"down"`
+
+	// Excluded Seconds in acknowledged unavailability periods not accounted
+	Excluded  int                    `json:"excluded"`
+	From      string                 `json:"from"`
+	Instances []InstanceAvailability `json:"instances"`
+	Outages   []AvailabilityOutage   `json:"outages"`
+	SvcId     string                 `json:"svc_id"`
+	Svcname   string                 `json:"svcname"`
+
+	// Unknown Seconds with no or an undetermined status
+	Unknown int    `json:"unknown"`
+	Until   string `json:"until"`
+
+	// Up Seconds up
+	Up int `json:"up"`
+}
+
+// ServiceAvailabilitySummary defines model for ServiceAvailabilitySummary.
+type ServiceAvailabilitySummary struct {
+	// Availability Percentage of the up and degraded time over the up, degraded
+	// and down time. Null if no such time was measured.
+	Availability *float64 `json:"availability"`
+
+	// Degraded Seconds up but degraded
+	Degraded int `json:"degraded"`
+
+	// Down Seconds down
+	Down int `json:"down"`
+
+	// Excluded Seconds in acknowledged unavailability periods not accounted
+	Excluded int    `json:"excluded"`
+	SvcId    string `json:"svc_id"`
+	Svcname  string `json:"svcname"`
+
+	// Unknown Seconds with no or an undetermined status
+	Unknown int `json:"unknown"`
+
+	// Up Seconds up
+	Up int `json:"up"`
+}
+
 // Version defines model for version.
 type Version struct {
 	Version string `json:"version"`
 }
 
+// InPathActionId defines model for inPathActionId.
+type InPathActionId = int
+
+// InPathAlertId defines model for inPathAlertId.
+type InPathAlertId = int64
+
+// InPathClusterId defines model for inPathClusterId.
+type InPathClusterId = string
+
 // InPathMsetId defines model for inPathMsetId.
 type InPathMsetId = string
 
@@ -63,6 +293,27 @@ type InPathNodeId = string
 // InPathRsetId defines model for inPathRsetId.
 type InPathRsetId = string
 
+// InQueryAcked defines model for inQueryAcked.
+type InQueryAcked = bool
+
+// InQueryAvailabilityFrom defines model for inQueryAvailabilityFrom.
+type InQueryAvailabilityFrom = string
+
+// InQueryAvailabilityUntil defines model for inQueryAvailabilityUntil.
+type InQueryAvailabilityUntil = string
+
+// InQueryBegin defines model for inQueryBegin.
+type InQueryBegin = time.Time
+
+// InQueryDashEnv defines model for inQueryDashEnv.
+type InQueryDashEnv = string
+
+// InQueryDashType defines model for inQueryDashType.
+type InQueryDashType = string
+
+// InQueryEnd defines model for inQueryEnd.
+type InQueryEnd = time.Time
+
 // InQueryGroupby defines model for inQueryGroupby.
 type InQueryGroupby = string
 
@@ -72,6 +323,9 @@ type InQueryLimit = int
 // InQueryMeta defines model for inQueryMeta.
 type InQueryMeta = string
 
+// InQueryMinSeverity defines model for inQueryMinSeverity.
+type InQueryMinSeverity = int
+
 // InQueryOffset defines model for inQueryOffset.
 type InQueryOffset = int
 
@@ -102,8 +356,17 @@ type N409 = Problem
 // N500 defines model for 500.
 type N500 = Problem
 
-// GetAppsParams defines parameters for GetApps.
-type GetAppsParams struct {
+// N503 defines model for 503.
+type N503 = Problem
+
+// basicAuthContextKey is the context key for basicAuth security scheme
+type basicAuthContextKey string
+
+// bearerAuthContextKey is the context key for bearerAuth security scheme
+type bearerAuthContextKey string
+
+// GetActionsParams defines parameters for GetActions.
+type GetActionsParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -126,30 +389,45 @@ type GetAppsParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// PostAppsJSONBody defines parameters for PostApps.
-type PostAppsJSONBody struct {
-	App         string  `json:"app"`
-	AppDomain   *string `json:"app_domain,omitempty"`
-	AppTeamOps  *string `json:"app_team_ops,omitempty"`
-	Description *string `json:"description,omitempty"`
+// PostActionsJSONBody defines parameters for PostActions.
+type PostActionsJSONBody struct {
+	// Action The action verb
+	Action     string                         `json:"action"`
+	ActionType *PostActionsJSONBodyActionType `json:"action_type,omitempty"`
+
+	// NodeId The node id or nodename the action runs on
+	NodeId string `json:"node_id"`
+
+	// Params The action verb parameters
+	Params *map[string]interface{} `json:"params,omitempty"`
+
+	// SvcId The object id, unset for node actions
+	SvcId *string `json:"svc_id,omitempty"`
 }
 
-// GetAppParams defines parameters for GetApp.
-type GetAppParams struct {
+// PostActionsJSONBodyActionType defines parameters for PostActions.
+type PostActionsJSONBodyActionType string
+
+// GetActionParams defines parameters for GetAction.
+type GetActionParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 }
 
-// PostAppJSONBody defines parameters for PostApp.
-type PostAppJSONBody struct {
-	App         *string `json:"app,omitempty"`
-	AppDomain   *string `json:"app_domain,omitempty"`
-	AppTeamOps  *string `json:"app_team_ops,omitempty"`
-	Description *string `json:"description,omitempty"`
-}
+// GetAlertsParams defines parameters for GetAlerts.
+type GetAlertsParams struct {
+	// DashType Filter on the alert type. A % matches any characters.
+	DashType *InQueryDashType `form:"dash_type,omitempty" json:"dash_type,omitempty"`
+
+	// DashEnv Filter on the alert environment, like PRD.
+	DashEnv *InQueryDashEnv `form:"dash_env,omitempty" json:"dash_env,omitempty"`
+
+	// MinSeverity Select the alerts with a severity greater or equal.
+	MinSeverity *InQueryMinSeverity `form:"min_severity,omitempty" json:"min_severity,omitempty"`
+
+	// Acked Select the alerts with (true) or without (false) an active acknowledgement.
+	Acked *InQueryAcked `form:"acked,omitempty" json:"acked,omitempty"`
 
-// GetAppPublicationsParams defines parameters for GetAppPublications.
-type GetAppPublicationsParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -172,8 +450,37 @@ type GetAppPublicationsParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// GetAppResponsiblesParams defines parameters for GetAppResponsibles.
-type GetAppResponsiblesParams struct {
+// GetAlertSeverityPreviewParams defines parameters for GetAlertSeverityPreview.
+type GetAlertSeverityPreviewParams struct {
+	// DashType The alert type, like "service unavailable".
+	DashType string `form:"dash_type" json:"dash_type"`
+
+	// NodeId Node identifier (node_id UUID or nodename).
+	NodeId *string `form:"node_id,omitempty" json:"node_id,omitempty"`
+
+	// SvcId Service identifier (svc_id UUID or svcname).
+	SvcId *string `form:"svc_id,omitempty" json:"svc_id,omitempty"`
+
+	// Severity The severity of the alert before the rules. Defaults to the
+	// built-in severity of the alert type.
+	Severity *int `form:"severity,omitempty" json:"severity,omitempty"`
+}
+
+// PostAlertAckJSONBody defines parameters for PostAlertAck.
+type PostAlertAckJSONBody struct {
+	// Account Account the acknowledged service unavailability period in
+	// the availability reports.
+	Account *bool `json:"account,omitempty"`
+
+	// Comment The acknowledgement reason
+	Comment string `json:"comment"`
+
+	// ExpireAt The acknowledgement end. Defaults to 24 hours from now.
+	ExpireAt *time.Time `json:"expire_at,omitempty"`
+}
+
+// GetAlertEventsParams defines parameters for GetAlertEvents.
+type GetAlertEventsParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -196,8 +503,8 @@ type GetAppResponsiblesParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// GetArraysParams defines parameters for GetArrays.
-type GetArraysParams struct {
+// GetAppsParams defines parameters for GetApps.
+type GetAppsParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -220,14 +527,39 @@ type GetArraysParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// PostAuthNodeJSONBody defines parameters for PostAuthNode.
-type PostAuthNodeJSONBody struct {
-	App      *string `json:"app,omitempty"`
-	Nodename string  `json:"nodename"`
+// PostAppsJSONBody defines parameters for PostApps.
+type PostAppsJSONBody struct {
+	App         string  `json:"app"`
+	AppDomain   *string `json:"app_domain,omitempty"`
+	AppTeamOps  *string `json:"app_team_ops,omitempty"`
+	Description *string `json:"description,omitempty"`
 }
 
-// GetDisksParams defines parameters for GetDisks.
-type GetDisksParams struct {
+// GetAppParams defines parameters for GetApp.
+type GetAppParams struct {
+	// Props A list of properties to include in each data dictionnary.
+	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
+}
+
+// PostAppJSONBody defines parameters for PostApp.
+type PostAppJSONBody struct {
+	App         *string `json:"app,omitempty"`
+	AppDomain   *string `json:"app_domain,omitempty"`
+	AppTeamOps  *string `json:"app_team_ops,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// GetAppAvailabilityParams defines parameters for GetAppAvailability.
+type GetAppAvailabilityParams struct {
+	// From The first day of the report, like 2024-01-01. Defaults to 30 days before until.
+	From *InQueryAvailabilityFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// Until The last day of the report, included, like 2024-01-31. Defaults to today.
+	Until *InQueryAvailabilityUntil `form:"until,omitempty" json:"until,omitempty"`
+}
+
+// GetAppPublicationsParams defines parameters for GetAppPublications.
+type GetAppPublicationsParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -250,8 +582,8 @@ type GetDisksParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// GetDiskParams defines parameters for GetDisk.
-type GetDiskParams struct {
+// GetAppResponsiblesParams defines parameters for GetAppResponsibles.
+type GetAppResponsiblesParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -274,8 +606,8 @@ type GetDiskParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// GetNodesParams defines parameters for GetNodes.
-type GetNodesParams struct {
+// GetArraysParams defines parameters for GetArrays.
+type GetArraysParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -298,8 +630,14 @@ type GetNodesParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// GetNodesHbasParams defines parameters for GetNodesHbas.
-type GetNodesHbasParams struct {
+// PostAuthNodeJSONBody defines parameters for PostAuthNode.
+type PostAuthNodeJSONBody struct {
+	App      *string `json:"app,omitempty"`
+	Nodename string  `json:"nodename"`
+}
+
+// GetClustersParams defines parameters for GetClusters.
+type GetClustersParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -322,8 +660,8 @@ type GetNodesHbasParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// GetNodeParams defines parameters for GetNode.
-type GetNodeParams struct {
+// GetClusterParams defines parameters for GetCluster.
+type GetClusterParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -346,8 +684,8 @@ type GetNodeParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// GetNodeCandidateTagsParams defines parameters for GetNodeCandidateTags.
-type GetNodeCandidateTagsParams struct {
+// GetClusterHeartbeatsParams defines parameters for GetClusterHeartbeats.
+type GetClusterHeartbeatsParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -370,8 +708,14 @@ type GetNodeCandidateTagsParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// GetNodeComplianceCandidateModulesetsParams defines parameters for GetNodeComplianceCandidateModulesets.
-type GetNodeComplianceCandidateModulesetsParams struct {
+// GetClusterHeartbeatsHistoryParams defines parameters for GetClusterHeartbeatsHistory.
+type GetClusterHeartbeatsHistoryParams struct {
+	// Begin Select the entries ending after this date, like 2024-01-01T00:00:00Z.
+	Begin *InQueryBegin `form:"begin,omitempty" json:"begin,omitempty"`
+
+	// End Select the entries beginning before this date, like 2024-01-31T00:00:00Z.
+	End *InQueryEnd `form:"end,omitempty" json:"end,omitempty"`
+
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -394,8 +738,8 @@ type GetNodeComplianceCandidateModulesetsParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// GetNodeComplianceCandidateRulesetsParams defines parameters for GetNodeComplianceCandidateRulesets.
-type GetNodeComplianceCandidateRulesetsParams struct {
+// GetClusterNodesParams defines parameters for GetClusterNodes.
+type GetClusterNodesParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -418,14 +762,8 @@ type GetNodeComplianceCandidateRulesetsParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// GetNodeComplianceLogsParams defines parameters for GetNodeComplianceLogs.
-type GetNodeComplianceLogsParams struct {
-	// Props A list of properties to include in each data dictionnary.
-	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
-}
-
-// GetNodeComplianceModulesetsParams defines parameters for GetNodeComplianceModulesets.
-type GetNodeComplianceModulesetsParams struct {
+// GetClusterServicesParams defines parameters for GetClusterServices.
+type GetClusterServicesParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -448,11 +786,8 @@ type GetNodeComplianceModulesetsParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// PostNodeComplianceModulesetJSONBody defines parameters for PostNodeComplianceModuleset.
-type PostNodeComplianceModulesetJSONBody = map[string]interface{}
-
-// GetNodeComplianceRulesetsParams defines parameters for GetNodeComplianceRulesets.
-type GetNodeComplianceRulesetsParams struct {
+// GetDisksParams defines parameters for GetDisks.
+type GetDisksParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -475,11 +810,8 @@ type GetNodeComplianceRulesetsParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// PostNodeComplianceRulesetJSONBody defines parameters for PostNodeComplianceRuleset.
-type PostNodeComplianceRulesetJSONBody = map[string]interface{}
-
-// GetNodeDisksParams defines parameters for GetNodeDisks.
-type GetNodeDisksParams struct {
+// GetDiskParams defines parameters for GetDisk.
+type GetDiskParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -502,8 +834,8 @@ type GetNodeDisksParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// GetNodeHbasParams defines parameters for GetNodeHbas.
-type GetNodeHbasParams struct {
+// GetNodesParams defines parameters for GetNodes.
+type GetNodesParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -526,8 +858,8 @@ type GetNodeHbasParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// GetNodeInterfacesParams defines parameters for GetNodeInterfaces.
-type GetNodeInterfacesParams struct {
+// GetNodesGroupsParams defines parameters for GetNodesGroups.
+type GetNodesGroupsParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -550,8 +882,8 @@ type GetNodeInterfacesParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// GetNodeTagsParams defines parameters for GetNodeTags.
-type GetNodeTagsParams struct {
+// GetNodesHbasParams defines parameters for GetNodesHbas.
+type GetNodesHbasParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -574,8 +906,8 @@ type GetNodeTagsParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// GetServicesParams defines parameters for GetServices.
-type GetServicesParams struct {
+// GetNodesUsersParams defines parameters for GetNodesUsers.
+type GetNodesUsersParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -598,8 +930,8 @@ type GetServicesParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// GetServiceParams defines parameters for GetService.
-type GetServiceParams struct {
+// GetNodeParams defines parameters for GetNode.
+type GetNodeParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -622,8 +954,20 @@ type GetServiceParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// GetServiceCandidateTagsParams defines parameters for GetServiceCandidateTags.
-type GetServiceCandidateTagsParams struct {
+// GetNodeAlertsParams defines parameters for GetNodeAlerts.
+type GetNodeAlertsParams struct {
+	// DashType Filter on the alert type. A % matches any characters.
+	DashType *InQueryDashType `form:"dash_type,omitempty" json:"dash_type,omitempty"`
+
+	// DashEnv Filter on the alert environment, like PRD.
+	DashEnv *InQueryDashEnv `form:"dash_env,omitempty" json:"dash_env,omitempty"`
+
+	// MinSeverity Select the alerts with a severity greater or equal.
+	MinSeverity *InQueryMinSeverity `form:"min_severity,omitempty" json:"min_severity,omitempty"`
+
+	// Acked Select the alerts with (true) or without (false) an active acknowledgement.
+	Acked *InQueryAcked `form:"acked,omitempty" json:"acked,omitempty"`
+
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -646,8 +990,8 @@ type GetServiceCandidateTagsParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// GetServiceTagsParams defines parameters for GetServiceTags.
-type GetServiceTagsParams struct {
+// GetNodeCandidateTagsParams defines parameters for GetNodeCandidateTags.
+type GetNodeCandidateTagsParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -670,8 +1014,8 @@ type GetServiceTagsParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// GetServicesInstancesParams defines parameters for GetServicesInstances.
-type GetServicesInstancesParams struct {
+// GetNodeComplianceCandidateModulesetsParams defines parameters for GetNodeComplianceCandidateModulesets.
+type GetNodeComplianceCandidateModulesetsParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -694,8 +1038,8 @@ type GetServicesInstancesParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// GetServicesInstanceParams defines parameters for GetServicesInstance.
-type GetServicesInstanceParams struct {
+// GetNodeComplianceCandidateRulesetsParams defines parameters for GetNodeComplianceCandidateRulesets.
+type GetNodeComplianceCandidateRulesetsParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -718,8 +1062,14 @@ type GetServicesInstanceParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// GetServicesInstancesStatusLogParams defines parameters for GetServicesInstancesStatusLog.
-type GetServicesInstancesStatusLogParams struct {
+// GetNodeComplianceLogsParams defines parameters for GetNodeComplianceLogs.
+type GetNodeComplianceLogsParams struct {
+	// Props A list of properties to include in each data dictionnary.
+	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
+}
+
+// GetNodeComplianceModulesetsParams defines parameters for GetNodeComplianceModulesets.
+type GetNodeComplianceModulesetsParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -742,8 +1092,11 @@ type GetServicesInstancesStatusLogParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// GetTagsParams defines parameters for GetTags.
-type GetTagsParams struct {
+// PostNodeComplianceModulesetJSONBody defines parameters for PostNodeComplianceModuleset.
+type PostNodeComplianceModulesetJSONBody = map[string]interface{}
+
+// GetNodeComplianceRulesetsParams defines parameters for GetNodeComplianceRulesets.
+type GetNodeComplianceRulesetsParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -766,8 +1119,11 @@ type GetTagsParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// GetTagsNodesParams defines parameters for GetTagsNodes.
-type GetTagsNodesParams struct {
+// PostNodeComplianceRulesetJSONBody defines parameters for PostNodeComplianceRuleset.
+type PostNodeComplianceRulesetJSONBody = map[string]interface{}
+
+// GetNodeDisksParams defines parameters for GetNodeDisks.
+type GetNodeDisksParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -790,8 +1146,8 @@ type GetTagsNodesParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// GetTagsServicesParams defines parameters for GetTagsServices.
-type GetTagsServicesParams struct {
+// GetNodeGroupsParams defines parameters for GetNodeGroups.
+type GetNodeGroupsParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
 
@@ -814,7 +1170,529 @@ type GetTagsServicesParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
-// GetTagParams defines parameters for GetTag.
+// GetNodeHbasParams defines parameters for GetNodeHbas.
+type GetNodeHbasParams struct {
+	// Props A list of properties to include in each data dictionnary.
+	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
+
+	// Limit The maximum number of entries to return. 0 means no limit.
+	Limit *InQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Skip the first entries of the data cursor.
+	Offset *InQueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Meta Include metadata in the response. Enabled by default. Use false or 0 to omit the meta field.
+	Meta *InQueryMeta `form:"meta,omitempty" json:"meta,omitempty"`
+
+	// Stats Controls the inclusion in the returned dictionnary of a "stats" key, containing the selected properties distinct values counts.
+	Stats *InQueryStats `form:"stats,omitempty" json:"stats,omitempty"`
+
+	// Orderby Comma-separated list of properties to sort by. Prefix a property with - for descending order (e.g. orderby=nodename,-app).
+	Orderby *InQueryOrderby `form:"orderby,omitempty" json:"orderby,omitempty"`
+
+	// Groupby Comma-separated list of properties to group the result by (e.g. groupby=app,svcname).
+	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
+}
+
+// GetNodeHistoryParams defines parameters for GetNodeHistory.
+type GetNodeHistoryParams struct {
+	// Props A list of properties to include in each data dictionnary.
+	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
+
+	// Limit The maximum number of entries to return. 0 means no limit.
+	Limit *InQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Skip the first entries of the data cursor.
+	Offset *InQueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Meta Include metadata in the response. Enabled by default. Use false or 0 to omit the meta field.
+	Meta *InQueryMeta `form:"meta,omitempty" json:"meta,omitempty"`
+
+	// Stats Controls the inclusion in the returned dictionnary of a "stats" key, containing the selected properties distinct values counts.
+	Stats *InQueryStats `form:"stats,omitempty" json:"stats,omitempty"`
+
+	// Orderby Comma-separated list of properties to sort by. Prefix a property with - for descending order (e.g. orderby=nodename,-app).
+	Orderby *InQueryOrderby `form:"orderby,omitempty" json:"orderby,omitempty"`
+
+	// Groupby Comma-separated list of properties to group the result by (e.g. groupby=app,svcname).
+	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
+}
+
+// GetNodeInterfacesParams defines parameters for GetNodeInterfaces.
+type GetNodeInterfacesParams struct {
+	// Props A list of properties to include in each data dictionnary.
+	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
+
+	// Limit The maximum number of entries to return. 0 means no limit.
+	Limit *InQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Skip the first entries of the data cursor.
+	Offset *InQueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Meta Include metadata in the response. Enabled by default. Use false or 0 to omit the meta field.
+	Meta *InQueryMeta `form:"meta,omitempty" json:"meta,omitempty"`
+
+	// Stats Controls the inclusion in the returned dictionnary of a "stats" key, containing the selected properties distinct values counts.
+	Stats *InQueryStats `form:"stats,omitempty" json:"stats,omitempty"`
+
+	// Orderby Comma-separated list of properties to sort by. Prefix a property with - for descending order (e.g. orderby=nodename,-app).
+	Orderby *InQueryOrderby `form:"orderby,omitempty" json:"orderby,omitempty"`
+
+	// Groupby Comma-separated list of properties to group the result by (e.g. groupby=app,svcname).
+	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
+}
+
+// GetNodePackagesParams defines parameters for GetNodePackages.
+type GetNodePackagesParams struct {
+	// Props A list of properties to include in each data dictionnary.
+	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
+
+	// Limit The maximum number of entries to return. 0 means no limit.
+	Limit *InQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Skip the first entries of the data cursor.
+	Offset *InQueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Meta Include metadata in the response. Enabled by default. Use false or 0 to omit the meta field.
+	Meta *InQueryMeta `form:"meta,omitempty" json:"meta,omitempty"`
+
+	// Stats Controls the inclusion in the returned dictionnary of a "stats" key, containing the selected properties distinct values counts.
+	Stats *InQueryStats `form:"stats,omitempty" json:"stats,omitempty"`
+
+	// Orderby Comma-separated list of properties to sort by. Prefix a property with - for descending order (e.g. orderby=nodename,-app).
+	Orderby *InQueryOrderby `form:"orderby,omitempty" json:"orderby,omitempty"`
+
+	// Groupby Comma-separated list of properties to group the result by (e.g. groupby=app,svcname).
+	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
+}
+
+// GetNodePatchesParams defines parameters for GetNodePatches.
+type GetNodePatchesParams struct {
+	// Props A list of properties to include in each data dictionnary.
+	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
+
+	// Limit The maximum number of entries to return. 0 means no limit.
+	Limit *InQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Skip the first entries of the data cursor.
+	Offset *InQueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Meta Include metadata in the response. Enabled by default. Use false or 0 to omit the meta field.
+	Meta *InQueryMeta `form:"meta,omitempty" json:"meta,omitempty"`
+
+	// Stats Controls the inclusion in the returned dictionnary of a "stats" key, containing the selected properties distinct values counts.
+	Stats *InQueryStats `form:"stats,omitempty" json:"stats,omitempty"`
+
+	// Orderby Comma-separated list of properties to sort by. Prefix a property with - for descending order (e.g. orderby=nodename,-app).
+	Orderby *InQueryOrderby `form:"orderby,omitempty" json:"orderby,omitempty"`
+
+	// Groupby Comma-separated list of properties to group the result by (e.g. groupby=app,svcname).
+	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
+}
+
+// GetNodeTagsParams defines parameters for GetNodeTags.
+type GetNodeTagsParams struct {
+	// Props A list of properties to include in each data dictionnary.
+	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
+
+	// Limit The maximum number of entries to return. 0 means no limit.
+	Limit *InQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Skip the first entries of the data cursor.
+	Offset *InQueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Meta Include metadata in the response. Enabled by default. Use false or 0 to omit the meta field.
+	Meta *InQueryMeta `form:"meta,omitempty" json:"meta,omitempty"`
+
+	// Stats Controls the inclusion in the returned dictionnary of a "stats" key, containing the selected properties distinct values counts.
+	Stats *InQueryStats `form:"stats,omitempty" json:"stats,omitempty"`
+
+	// Orderby Comma-separated list of properties to sort by. Prefix a property with - for descending order (e.g. orderby=nodename,-app).
+	Orderby *InQueryOrderby `form:"orderby,omitempty" json:"orderby,omitempty"`
+
+	// Groupby Comma-separated list of properties to group the result by (e.g. groupby=app,svcname).
+	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
+}
+
+// GetNodeUsersParams defines parameters for GetNodeUsers.
+type GetNodeUsersParams struct {
+	// Props A list of properties to include in each data dictionnary.
+	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
+
+	// Limit The maximum number of entries to return. 0 means no limit.
+	Limit *InQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Skip the first entries of the data cursor.
+	Offset *InQueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Meta Include metadata in the response. Enabled by default. Use false or 0 to omit the meta field.
+	Meta *InQueryMeta `form:"meta,omitempty" json:"meta,omitempty"`
+
+	// Stats Controls the inclusion in the returned dictionnary of a "stats" key, containing the selected properties distinct values counts.
+	Stats *InQueryStats `form:"stats,omitempty" json:"stats,omitempty"`
+
+	// Orderby Comma-separated list of properties to sort by. Prefix a property with - for descending order (e.g. orderby=nodename,-app).
+	Orderby *InQueryOrderby `form:"orderby,omitempty" json:"orderby,omitempty"`
+
+	// Groupby Comma-separated list of properties to group the result by (e.g. groupby=app,svcname).
+	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
+}
+
+// GetPackagesParams defines parameters for GetPackages.
+type GetPackagesParams struct {
+	// PkgName Filter on the package name. A % matches any characters.
+	PkgName *string `form:"pkg_name,omitempty" json:"pkg_name,omitempty"`
+
+	// PkgVersion Filter on the package version. A % matches any characters.
+	PkgVersion *string `form:"pkg_version,omitempty" json:"pkg_version,omitempty"`
+
+	// PkgArch Filter on the package architecture.
+	PkgArch *string `form:"pkg_arch,omitempty" json:"pkg_arch,omitempty"`
+
+	// PkgType Filter on the package type, like rpm or deb.
+	PkgType *string `form:"pkg_type,omitempty" json:"pkg_type,omitempty"`
+
+	// Props A list of properties to include in each data dictionnary.
+	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
+
+	// Limit The maximum number of entries to return. 0 means no limit.
+	Limit *InQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Skip the first entries of the data cursor.
+	Offset *InQueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Meta Include metadata in the response. Enabled by default. Use false or 0 to omit the meta field.
+	Meta *InQueryMeta `form:"meta,omitempty" json:"meta,omitempty"`
+
+	// Stats Controls the inclusion in the returned dictionnary of a "stats" key, containing the selected properties distinct values counts.
+	Stats *InQueryStats `form:"stats,omitempty" json:"stats,omitempty"`
+
+	// Orderby Comma-separated list of properties to sort by. Prefix a property with - for descending order (e.g. orderby=nodename,-app).
+	Orderby *InQueryOrderby `form:"orderby,omitempty" json:"orderby,omitempty"`
+
+	// Groupby Comma-separated list of properties to group the result by (e.g. groupby=app,svcname).
+	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
+}
+
+// GetPackagesDiffParams defines parameters for GetPackagesDiff.
+type GetPackagesDiffParams struct {
+	// Nodes Comma-separated list of node identifiers (node_id UUID or nodename).
+	Nodes *string `form:"nodes,omitempty" json:"nodes,omitempty"`
+
+	// ClusterId Select the nodes of this cluster, in addition to the nodes parameter.
+	ClusterId *string `form:"cluster_id,omitempty" json:"cluster_id,omitempty"`
+}
+
+// GetPatchesParams defines parameters for GetPatches.
+type GetPatchesParams struct {
+	// Props A list of properties to include in each data dictionnary.
+	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
+
+	// Limit The maximum number of entries to return. 0 means no limit.
+	Limit *InQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Skip the first entries of the data cursor.
+	Offset *InQueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Meta Include metadata in the response. Enabled by default. Use false or 0 to omit the meta field.
+	Meta *InQueryMeta `form:"meta,omitempty" json:"meta,omitempty"`
+
+	// Stats Controls the inclusion in the returned dictionnary of a "stats" key, containing the selected properties distinct values counts.
+	Stats *InQueryStats `form:"stats,omitempty" json:"stats,omitempty"`
+
+	// Orderby Comma-separated list of properties to sort by. Prefix a property with - for descending order (e.g. orderby=nodename,-app).
+	Orderby *InQueryOrderby `form:"orderby,omitempty" json:"orderby,omitempty"`
+
+	// Groupby Comma-separated list of properties to group the result by (e.g. groupby=app,svcname).
+	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
+}
+
+// GetServicesParams defines parameters for GetServices.
+type GetServicesParams struct {
+	// Props A list of properties to include in each data dictionnary.
+	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
+
+	// Limit The maximum number of entries to return. 0 means no limit.
+	Limit *InQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Skip the first entries of the data cursor.
+	Offset *InQueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Meta Include metadata in the response. Enabled by default. Use false or 0 to omit the meta field.
+	Meta *InQueryMeta `form:"meta,omitempty" json:"meta,omitempty"`
+
+	// Stats Controls the inclusion in the returned dictionnary of a "stats" key, containing the selected properties distinct values counts.
+	Stats *InQueryStats `form:"stats,omitempty" json:"stats,omitempty"`
+
+	// Orderby Comma-separated list of properties to sort by. Prefix a property with - for descending order (e.g. orderby=nodename,-app).
+	Orderby *InQueryOrderby `form:"orderby,omitempty" json:"orderby,omitempty"`
+
+	// Groupby Comma-separated list of properties to group the result by (e.g. groupby=app,svcname).
+	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
+}
+
+// GetServiceParams defines parameters for GetService.
+type GetServiceParams struct {
+	// Props A list of properties to include in each data dictionnary.
+	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
+
+	// Limit The maximum number of entries to return. 0 means no limit.
+	Limit *InQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Skip the first entries of the data cursor.
+	Offset *InQueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Meta Include metadata in the response. Enabled by default. Use false or 0 to omit the meta field.
+	Meta *InQueryMeta `form:"meta,omitempty" json:"meta,omitempty"`
+
+	// Stats Controls the inclusion in the returned dictionnary of a "stats" key, containing the selected properties distinct values counts.
+	Stats *InQueryStats `form:"stats,omitempty" json:"stats,omitempty"`
+
+	// Orderby Comma-separated list of properties to sort by. Prefix a property with - for descending order (e.g. orderby=nodename,-app).
+	Orderby *InQueryOrderby `form:"orderby,omitempty" json:"orderby,omitempty"`
+
+	// Groupby Comma-separated list of properties to group the result by (e.g. groupby=app,svcname).
+	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
+}
+
+// GetServiceAlertsParams defines parameters for GetServiceAlerts.
+type GetServiceAlertsParams struct {
+	// DashType Filter on the alert type. A % matches any characters.
+	DashType *InQueryDashType `form:"dash_type,omitempty" json:"dash_type,omitempty"`
+
+	// DashEnv Filter on the alert environment, like PRD.
+	DashEnv *InQueryDashEnv `form:"dash_env,omitempty" json:"dash_env,omitempty"`
+
+	// MinSeverity Select the alerts with a severity greater or equal.
+	MinSeverity *InQueryMinSeverity `form:"min_severity,omitempty" json:"min_severity,omitempty"`
+
+	// Acked Select the alerts with (true) or without (false) an active acknowledgement.
+	Acked *InQueryAcked `form:"acked,omitempty" json:"acked,omitempty"`
+
+	// Props A list of properties to include in each data dictionnary.
+	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
+
+	// Limit The maximum number of entries to return. 0 means no limit.
+	Limit *InQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Skip the first entries of the data cursor.
+	Offset *InQueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Meta Include metadata in the response. Enabled by default. Use false or 0 to omit the meta field.
+	Meta *InQueryMeta `form:"meta,omitempty" json:"meta,omitempty"`
+
+	// Stats Controls the inclusion in the returned dictionnary of a "stats" key, containing the selected properties distinct values counts.
+	Stats *InQueryStats `form:"stats,omitempty" json:"stats,omitempty"`
+
+	// Orderby Comma-separated list of properties to sort by. Prefix a property with - for descending order (e.g. orderby=nodename,-app).
+	Orderby *InQueryOrderby `form:"orderby,omitempty" json:"orderby,omitempty"`
+
+	// Groupby Comma-separated list of properties to group the result by (e.g. groupby=app,svcname).
+	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
+}
+
+// GetServiceAvailabilityParams defines parameters for GetServiceAvailability.
+type GetServiceAvailabilityParams struct {
+	// From The first day of the report, like 2024-01-01. Defaults to 30 days before until.
+	From *InQueryAvailabilityFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// Until The last day of the report, included, like 2024-01-31. Defaults to today.
+	Until *InQueryAvailabilityUntil `form:"until,omitempty" json:"until,omitempty"`
+}
+
+// GetServiceCandidateTagsParams defines parameters for GetServiceCandidateTags.
+type GetServiceCandidateTagsParams struct {
+	// Props A list of properties to include in each data dictionnary.
+	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
+
+	// Limit The maximum number of entries to return. 0 means no limit.
+	Limit *InQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Skip the first entries of the data cursor.
+	Offset *InQueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Meta Include metadata in the response. Enabled by default. Use false or 0 to omit the meta field.
+	Meta *InQueryMeta `form:"meta,omitempty" json:"meta,omitempty"`
+
+	// Stats Controls the inclusion in the returned dictionnary of a "stats" key, containing the selected properties distinct values counts.
+	Stats *InQueryStats `form:"stats,omitempty" json:"stats,omitempty"`
+
+	// Orderby Comma-separated list of properties to sort by. Prefix a property with - for descending order (e.g. orderby=nodename,-app).
+	Orderby *InQueryOrderby `form:"orderby,omitempty" json:"orderby,omitempty"`
+
+	// Groupby Comma-separated list of properties to group the result by (e.g. groupby=app,svcname).
+	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
+}
+
+// GetServiceTagsParams defines parameters for GetServiceTags.
+type GetServiceTagsParams struct {
+	// Props A list of properties to include in each data dictionnary.
+	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
+
+	// Limit The maximum number of entries to return. 0 means no limit.
+	Limit *InQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Skip the first entries of the data cursor.
+	Offset *InQueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Meta Include metadata in the response. Enabled by default. Use false or 0 to omit the meta field.
+	Meta *InQueryMeta `form:"meta,omitempty" json:"meta,omitempty"`
+
+	// Stats Controls the inclusion in the returned dictionnary of a "stats" key, containing the selected properties distinct values counts.
+	Stats *InQueryStats `form:"stats,omitempty" json:"stats,omitempty"`
+
+	// Orderby Comma-separated list of properties to sort by. Prefix a property with - for descending order (e.g. orderby=nodename,-app).
+	Orderby *InQueryOrderby `form:"orderby,omitempty" json:"orderby,omitempty"`
+
+	// Groupby Comma-separated list of properties to group the result by (e.g. groupby=app,svcname).
+	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
+}
+
+// GetServicesInstancesParams defines parameters for GetServicesInstances.
+type GetServicesInstancesParams struct {
+	// Props A list of properties to include in each data dictionnary.
+	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
+
+	// Limit The maximum number of entries to return. 0 means no limit.
+	Limit *InQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Skip the first entries of the data cursor.
+	Offset *InQueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Meta Include metadata in the response. Enabled by default. Use false or 0 to omit the meta field.
+	Meta *InQueryMeta `form:"meta,omitempty" json:"meta,omitempty"`
+
+	// Stats Controls the inclusion in the returned dictionnary of a "stats" key, containing the selected properties distinct values counts.
+	Stats *InQueryStats `form:"stats,omitempty" json:"stats,omitempty"`
+
+	// Orderby Comma-separated list of properties to sort by. Prefix a property with - for descending order (e.g. orderby=nodename,-app).
+	Orderby *InQueryOrderby `form:"orderby,omitempty" json:"orderby,omitempty"`
+
+	// Groupby Comma-separated list of properties to group the result by (e.g. groupby=app,svcname).
+	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
+}
+
+// GetServicesInstanceParams defines parameters for GetServicesInstance.
+type GetServicesInstanceParams struct {
+	// Props A list of properties to include in each data dictionnary.
+	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
+
+	// Limit The maximum number of entries to return. 0 means no limit.
+	Limit *InQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Skip the first entries of the data cursor.
+	Offset *InQueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Meta Include metadata in the response. Enabled by default. Use false or 0 to omit the meta field.
+	Meta *InQueryMeta `form:"meta,omitempty" json:"meta,omitempty"`
+
+	// Stats Controls the inclusion in the returned dictionnary of a "stats" key, containing the selected properties distinct values counts.
+	Stats *InQueryStats `form:"stats,omitempty" json:"stats,omitempty"`
+
+	// Orderby Comma-separated list of properties to sort by. Prefix a property with - for descending order (e.g. orderby=nodename,-app).
+	Orderby *InQueryOrderby `form:"orderby,omitempty" json:"orderby,omitempty"`
+
+	// Groupby Comma-separated list of properties to group the result by (e.g. groupby=app,svcname).
+	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
+}
+
+// GetServicesInstancesStatusLogParams defines parameters for GetServicesInstancesStatusLog.
+type GetServicesInstancesStatusLogParams struct {
+	// Props A list of properties to include in each data dictionnary.
+	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
+
+	// Limit The maximum number of entries to return. 0 means no limit.
+	Limit *InQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Skip the first entries of the data cursor.
+	Offset *InQueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Meta Include metadata in the response. Enabled by default. Use false or 0 to omit the meta field.
+	Meta *InQueryMeta `form:"meta,omitempty" json:"meta,omitempty"`
+
+	// Stats Controls the inclusion in the returned dictionnary of a "stats" key, containing the selected properties distinct values counts.
+	Stats *InQueryStats `form:"stats,omitempty" json:"stats,omitempty"`
+
+	// Orderby Comma-separated list of properties to sort by. Prefix a property with - for descending order (e.g. orderby=nodename,-app).
+	Orderby *InQueryOrderby `form:"orderby,omitempty" json:"orderby,omitempty"`
+
+	// Groupby Comma-separated list of properties to group the result by (e.g. groupby=app,svcname).
+	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
+}
+
+// GetTagsParams defines parameters for GetTags.
+type GetTagsParams struct {
+	// Props A list of properties to include in each data dictionnary.
+	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
+
+	// Limit The maximum number of entries to return. 0 means no limit.
+	Limit *InQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Skip the first entries of the data cursor.
+	Offset *InQueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Meta Include metadata in the response. Enabled by default. Use false or 0 to omit the meta field.
+	Meta *InQueryMeta `form:"meta,omitempty" json:"meta,omitempty"`
+
+	// Stats Controls the inclusion in the returned dictionnary of a "stats" key, containing the selected properties distinct values counts.
+	Stats *InQueryStats `form:"stats,omitempty" json:"stats,omitempty"`
+
+	// Orderby Comma-separated list of properties to sort by. Prefix a property with - for descending order (e.g. orderby=nodename,-app).
+	Orderby *InQueryOrderby `form:"orderby,omitempty" json:"orderby,omitempty"`
+
+	// Groupby Comma-separated list of properties to group the result by (e.g. groupby=app,svcname).
+	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
+}
+
+// GetTagsNodesParams defines parameters for GetTagsNodes.
+type GetTagsNodesParams struct {
+	// Props A list of properties to include in each data dictionnary.
+	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
+
+	// Limit The maximum number of entries to return. 0 means no limit.
+	Limit *InQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Skip the first entries of the data cursor.
+	Offset *InQueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Meta Include metadata in the response. Enabled by default. Use false or 0 to omit the meta field.
+	Meta *InQueryMeta `form:"meta,omitempty" json:"meta,omitempty"`
+
+	// Stats Controls the inclusion in the returned dictionnary of a "stats" key, containing the selected properties distinct values counts.
+	Stats *InQueryStats `form:"stats,omitempty" json:"stats,omitempty"`
+
+	// Orderby Comma-separated list of properties to sort by. Prefix a property with - for descending order (e.g. orderby=nodename,-app).
+	Orderby *InQueryOrderby `form:"orderby,omitempty" json:"orderby,omitempty"`
+
+	// Groupby Comma-separated list of properties to group the result by (e.g. groupby=app,svcname).
+	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
+}
+
+// GetTagsServicesParams defines parameters for GetTagsServices.
+type GetTagsServicesParams struct {
+	// Props A list of properties to include in each data dictionnary.
+	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
+
+	// Limit The maximum number of entries to return. 0 means no limit.
+	Limit *InQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Skip the first entries of the data cursor.
+	Offset *InQueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Meta Include metadata in the response. Enabled by default. Use false or 0 to omit the meta field.
+	Meta *InQueryMeta `form:"meta,omitempty" json:"meta,omitempty"`
+
+	// Stats Controls the inclusion in the returned dictionnary of a "stats" key, containing the selected properties distinct values counts.
+	Stats *InQueryStats `form:"stats,omitempty" json:"stats,omitempty"`
+
+	// Orderby Comma-separated list of properties to sort by. Prefix a property with - for descending order (e.g. orderby=nodename,-app).
+	Orderby *InQueryOrderby `form:"orderby,omitempty" json:"orderby,omitempty"`
+
+	// Groupby Comma-separated list of properties to group the result by (e.g. groupby=app,svcname).
+	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
+}
+
+// GetTagParams defines parameters for GetTag.
 type GetTagParams struct {
 	// Props A list of properties to include in each data dictionnary.
 	Props *InQueryProps `form:"props,omitempty" json:"props,omitempty"`
@@ -856,6 +1734,12 @@ type GetTagServicesParams struct {
 	Groupby *InQueryGroupby `form:"groupby,omitempty" json:"groupby,omitempty"`
 }
 
+// PostActionsJSONRequestBody defines body for PostActions for application/json ContentType.
+type PostActionsJSONRequestBody PostActionsJSONBody
+
+// PostAlertAckJSONRequestBody defines body for PostAlertAck for application/json ContentType.
+type PostAlertAckJSONRequestBody PostAlertAckJSONBody
+
 // PostAppsJSONRequestBody defines body for PostApps for application/json ContentType.
 type PostAppsJSONRequestBody PostAppsJSONBody
 
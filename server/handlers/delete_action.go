@@ -0,0 +1,75 @@
+package serverhandlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/util/echolog"
+	"github.com/opensvc/oc3/util/logkey"
+)
+
+// DeleteAction handles DELETE /actions/{action_id}
+func (a *Api) DeleteAction(c echo.Context, actionId int) error {
+	log := echolog.GetLogHandler(c, "DeleteAction")
+	ctx, cancel := context.WithTimeout(c.Request().Context(), a.SyncTimeout)
+	defer cancel()
+
+	if !IsAuthByUser(c) {
+		return JSONProblemf(c, http.StatusUnauthorized, "user authentication required")
+	}
+
+	log.Info("called", "action_id", actionId)
+
+	odb := cdb.New(a.DB)
+	odb.CreateSession(a.Ev)
+
+	action, err := odb.ActionQByID(ctx, actionId)
+	if err != nil {
+		log.Error("cannot get action", "action_id", actionId, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot get action")
+	}
+	if action == nil {
+		return JSONProblemf(c, http.StatusNotFound, "action %d not found", actionId)
+	}
+
+	responsible, err := actionResponsible(ctx, c, odb, action.NodeId, action.SvcId)
+	if err != nil {
+		log.Error("cannot check action responsibility", "action_id", actionId, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot check action responsibility")
+	}
+	if !responsible {
+		return JSONProblemf(c, http.StatusForbidden, "you are not responsible for this action")
+	}
+
+	cancelled, err := odb.ActionQCancel(ctx, actionId)
+	if err != nil {
+		log.Error("cannot cancel action", "action_id", actionId, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot cancel action")
+	}
+	if !cancelled {
+		return JSONProblemf(c, http.StatusConflict, "action %d is already dequeued", actionId)
+	}
+
+	userEmail, _ := c.Get(XUserEmail).(string)
+	if err := odb.Log(ctx, cdb.LogEntry{
+		Action: "action_queue.cancel",
+		User:   userEmail,
+		Fmt:    "action %(id)s cancelled: %(command)s",
+		Dict: map[string]any{
+			"id":      actionId,
+			"command": action.Command,
+		},
+		Level: "info",
+	}); err != nil {
+		log.Error("cannot write audit log", logkey.Error, err)
+	}
+
+	if err := notifyActionQueueChange(ctx, odb); err != nil {
+		log.Error("cannot notify action_queue change", logkey.Error, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{"id": actionId, "status": "C"})
+}
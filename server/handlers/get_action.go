@@ -0,0 +1,52 @@
+package serverhandlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/server"
+	"github.com/opensvc/oc3/util/echolog"
+	"github.com/opensvc/oc3/util/logkey"
+)
+
+// GetAction handles GET /actions/{action_id}
+func (a *Api) GetAction(c echo.Context, actionId int, params server.GetActionParams) error {
+	query, err := buildListQueryParameters(params.Props, nil, nil, nil, nil, nil, nil, propsMapping["action"])
+	if err != nil {
+		return JSONProblem(c, http.StatusBadRequest, err.Error())
+	}
+
+	log := echolog.GetLogHandler(c, "GetAction")
+	odb := a.getODB()
+	ctx := c.Request().Context()
+	groups := UserGroupsFromContext(c)
+	isManager := IsManager(c)
+
+	log.Info("called", "action_id", actionId, "props", query.Props, "is_manager", isManager)
+
+	selectExprs, err := buildSelectClause(query.Props, propsMapping["action"])
+	if err != nil {
+		log.Error("cannot build select clause", logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot build select clause")
+	}
+
+	actions, err := odb.GetAction(ctx, actionId, cdb.ListParams{
+		Groups:      groups,
+		IsManager:   isManager,
+		Limit:       query.Page.Limit,
+		Offset:      query.Page.Offset,
+		Props:       query.Props,
+		SelectExprs: selectExprs,
+	})
+	if err != nil {
+		log.Error("cannot get action", "action_id", actionId, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot get action")
+	}
+	if len(actions) == 0 {
+		return JSONProblemf(c, http.StatusNotFound, "action %d not found", actionId)
+	}
+
+	return c.JSON(http.StatusOK, newListResponse(actions, propsMapping["action"], query))
+}
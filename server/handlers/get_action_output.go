@@ -0,0 +1,50 @@
+package serverhandlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/server"
+	"github.com/opensvc/oc3/util/echolog"
+	"github.com/opensvc/oc3/util/logkey"
+)
+
+// GetActionOutput handles GET /actions/{action_id}/output
+func (a *Api) GetActionOutput(c echo.Context, actionId int) error {
+	log := echolog.GetLogHandler(c, "GetActionOutput")
+	odb := a.getODB()
+	ctx := c.Request().Context()
+
+	if !IsAuthByUser(c) {
+		return JSONProblemf(c, http.StatusUnauthorized, "user authentication required")
+	}
+
+	log.Info("called", "action_id", actionId)
+
+	action, err := odb.ActionQByID(ctx, actionId)
+	if err != nil {
+		log.Error("cannot get action", "action_id", actionId, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot get action")
+	}
+	if action == nil {
+		return JSONProblemf(c, http.StatusNotFound, "action %d not found", actionId)
+	}
+
+	responsible, err := actionResponsible(ctx, c, odb, action.NodeId, action.SvcId)
+	if err != nil {
+		log.Error("cannot check action responsibility", "action_id", actionId, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot check action responsibility")
+	}
+	if !responsible {
+		return JSONProblemf(c, http.StatusForbidden, "you are not responsible for this action")
+	}
+
+	return c.JSON(http.StatusOK, server.ActionOutput{
+		Id:     action.ID,
+		Status: action.Status,
+		Ret:    action.Ret,
+		Stdout: action.Stdout,
+		Stderr: action.Stderr,
+	})
+}
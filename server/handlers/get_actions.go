@@ -0,0 +1,21 @@
+package serverhandlers
+
+import (
+	"context"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/server"
+)
+
+// GetActions handles GET /actions
+func (a *Api) GetActions(c echo.Context, params server.GetActionsParams) error {
+	odb := a.getODB()
+	return a.handleList(c, "GetActions", "action", listEndpointParams{
+		props: params.Props, limit: params.Limit, offset: params.Offset,
+		meta: params.Meta, stats: params.Stats, orderby: params.Orderby, groupby: params.Groupby,
+	}, func(ctx context.Context, p cdb.ListParams) ([]map[string]any, error) {
+		return odb.GetActions(ctx, p)
+	})
+}
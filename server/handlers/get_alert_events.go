@@ -0,0 +1,45 @@
+package serverhandlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/server"
+	"github.com/opensvc/oc3/util/echolog"
+	"github.com/opensvc/oc3/util/logkey"
+)
+
+// GetAlertEvents handles GET /alerts/{alert_id}/events
+func (a *Api) GetAlertEvents(c echo.Context, alertId server.InPathAlertId, params server.GetAlertEventsParams) error {
+	log := echolog.GetLogHandler(c, "GetAlertEvents")
+	odb := a.getODB()
+	ctx := c.Request().Context()
+
+	alert, err := odb.AlertByID(ctx, alertId)
+	if err != nil {
+		log.Error("cannot get alert", "alert_id", alertId, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot get alert")
+	}
+	if alert == nil {
+		return JSONProblemf(c, http.StatusNotFound, "alert %d not found", alertId)
+	}
+
+	responsible, err := alertResponsible(ctx, c, odb, alertId)
+	if err != nil {
+		log.Error("cannot check alert responsibility", "alert_id", alertId, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot check alert responsibility")
+	}
+	if !responsible {
+		return JSONProblemf(c, http.StatusForbidden, "you are not responsible for this alert")
+	}
+
+	return a.handleList(c, "GetAlertEvents", "alert_event", listEndpointParams{
+		props: params.Props, limit: params.Limit, offset: params.Offset,
+		meta: params.Meta, stats: params.Stats, orderby: params.Orderby, groupby: params.Groupby,
+	}, func(ctx context.Context, p cdb.ListParams) ([]map[string]any, error) {
+		return odb.GetAlertEvents(ctx, alertId, p)
+	})
+}
@@ -0,0 +1,93 @@
+package serverhandlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/server"
+	"github.com/opensvc/oc3/severity"
+	"github.com/opensvc/oc3/util/echolog"
+	"github.com/opensvc/oc3/util/logkey"
+)
+
+// GetAlertSeverityPreview handles GET /alerts/severity/preview
+func (a *Api) GetAlertSeverityPreview(c echo.Context, params server.GetAlertSeverityPreviewParams) error {
+	log := echolog.GetLogHandler(c, "GetAlertSeverityPreview")
+	odb := a.getODB()
+	ctx := c.Request().Context()
+
+	if params.DashType == "" {
+		return JSONProblemf(c, http.StatusBadRequest, "dash_type is required")
+	}
+
+	var nodeID, svcID string
+	if params.NodeId != nil && *params.NodeId != "" {
+		node, err := odb.NodeByNodeIDOrNodename(ctx, *params.NodeId)
+		if err != nil {
+			log.Error("cannot resolve node", "node_id", *params.NodeId, logkey.Error, err)
+			return JSONProblemf(c, http.StatusInternalServerError, "cannot resolve node")
+		}
+		if node == nil {
+			return JSONProblemf(c, http.StatusNotFound, "node %s not found", *params.NodeId)
+		}
+		nodeID = node.NodeID
+	}
+	if params.SvcId != nil && *params.SvcId != "" {
+		svcs, err := odb.GetService(ctx, *params.SvcId, cdb.ListParams{
+			Limit: 1, Groups: UserGroupsFromContext(c), IsManager: IsManager(c),
+			Props: []string{"svc_id"}, SelectExprs: []string{"services.svc_id"},
+		})
+		if err != nil {
+			log.Error("cannot resolve service", "svc_id", *params.SvcId, logkey.Error, err)
+			return JSONProblemf(c, http.StatusInternalServerError, "cannot resolve service")
+		}
+		if len(svcs) == 0 {
+			return JSONProblemf(c, http.StatusNotFound, "service %s not found", *params.SvcId)
+		}
+		svcID, _ = svcs[0]["svc_id"].(string)
+	}
+
+	r, err := severity.LoadResolver()
+	if err != nil {
+		log.Error("cannot load the severity rules", logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot load the severity rules: %s", err)
+	}
+
+	subject, err := odb.AlertSubjectOf(ctx, nodeID, svcID)
+	if err != nil {
+		log.Error("cannot get alert subject", "node_id", nodeID, "svc_id", svcID, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot get alert subject")
+	}
+	builtin := severity.Builtin(params.DashType, subject.Env)
+	if params.Severity != nil {
+		builtin = *params.Severity
+	}
+	decision := r.Resolve(severity.Subject{
+		Type:     params.DashType,
+		Env:      subject.Env,
+		App:      subject.App,
+		Tags:     subject.Tags,
+		NodeType: subject.NodeType,
+		Severity: builtin,
+	})
+
+	tags := subject.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	return c.JSON(http.StatusOK, server.AlertSeverityPreview{
+		DashType:        params.DashType,
+		Env:             subject.Env,
+		App:             subject.App,
+		NodeType:        subject.NodeType,
+		Tags:            tags,
+		BuiltinSeverity: builtin,
+		Severity:        decision.Severity,
+		Suppress:        decision.Suppress,
+		Matched:         decision.Index >= 0,
+		Rule:            decision.Rule,
+		RuleIndex:       decision.Index,
+	})
+}
@@ -0,0 +1,42 @@
+package serverhandlers
+
+import (
+	"context"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/server"
+)
+
+// GetAlerts handles GET /alerts
+func (a *Api) GetAlerts(c echo.Context, params server.GetAlertsParams) error {
+	odb := a.getODB()
+	filter := alertsFilter(params.DashType, params.DashEnv, params.MinSeverity, params.Acked)
+	return a.handleList(c, "GetAlerts", "alert", listEndpointParams{
+		props: params.Props, limit: params.Limit, offset: params.Offset,
+		meta: params.Meta, stats: params.Stats, orderby: params.Orderby, groupby: params.Groupby,
+	}, func(ctx context.Context, p cdb.ListParams) ([]map[string]any, error) {
+		return odb.GetAlerts(ctx, filter, p)
+	})
+}
+
+// alertsFilter returns the dashboard alerts filter of the alerts list
+// endpoints query parameters.
+func alertsFilter(dashType *server.InQueryDashType, dashEnv *server.InQueryDashEnv, minSeverity *server.InQueryMinSeverity, acked *server.InQueryAcked) cdb.AlertsFilter {
+	var filter cdb.AlertsFilter
+	if dashType != nil {
+		filter.Type = *dashType
+	}
+	if dashEnv != nil {
+		filter.Env = *dashEnv
+	}
+	if minSeverity != nil {
+		filter.MinSeverity = *minSeverity
+	}
+	if acked != nil {
+		v := bool(*acked)
+		filter.Acked = &v
+	}
+	return filter
+}
@@ -0,0 +1,96 @@
+package serverhandlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/availability"
+	"github.com/opensvc/oc3/server"
+	"github.com/opensvc/oc3/util/echolog"
+	"github.com/opensvc/oc3/util/logkey"
+)
+
+// GetAppAvailability handles GET /apps/{app_id}/availability
+func (a *Api) GetAppAvailability(c echo.Context, appId string, params server.GetAppAvailabilityParams) error {
+	log := echolog.GetLogHandler(c, "GetAppAvailability")
+	odb := a.getODB()
+	ctx := c.Request().Context()
+
+	first, last, err := availabilityPeriod(params.From, params.Until)
+	if err != nil {
+		return JSONProblem(c, http.StatusBadRequest, err.Error())
+	}
+
+	app, err := odb.GetApp(ctx, appId, UserGroupsFromContext(c), IsManager(c))
+	if err != nil {
+		log.Error("cannot get app", "app_id", appId, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot get app")
+	}
+	if app == nil {
+		return JSONProblemf(c, http.StatusNotFound, "app %s not found", appId)
+	}
+
+	svcs, err := odb.AppServiceNames(ctx, app.App)
+	if err != nil {
+		log.Error("cannot get app services", "app_id", appId, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot get app services")
+	}
+	svcIDs := make([]string, len(svcs))
+	svcnames := make(map[string]string, len(svcs))
+	for i, s := range svcs {
+		svcIDs[i] = s.SvcID
+		svcnames[s.SvcID] = s.Svcname
+	}
+
+	sums, err := odb.AvailabilityDailySums(ctx, first, last, svcIDs...)
+	if err != nil {
+		log.Error("cannot get availability", "app_id", appId, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot get availability")
+	}
+	bySvcID := make(map[string]availability.Durations, len(svcs))
+	for _, sum := range sums {
+		if sum.NodeID == "" {
+			bySvcID[sum.SvcID] = availabilityDurations(sum)
+		}
+	}
+
+	var total availability.Durations
+	services := make([]server.ServiceAvailabilitySummary, len(svcs))
+	for i, s := range svcs {
+		d := bySvcID[s.SvcID]
+		total = total.Add(d)
+		services[i] = server.ServiceAvailabilitySummary{
+			SvcId:        s.SvcID,
+			Svcname:      s.Svcname,
+			Up:           int(d.Up.Seconds()),
+			Down:         int(d.Down.Seconds()),
+			Degraded:     int(d.Degraded.Seconds()),
+			Excluded:     int(d.Excluded.Seconds()),
+			Unknown:      int(d.Unknown.Seconds()),
+			Availability: availabilityPercent(d),
+		}
+	}
+
+	outages, err := availabilityOutages(ctx, odb, first, last, svcnames)
+	if err != nil {
+		log.Error("cannot get outages", "app_id", appId, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot get outages")
+	}
+
+	return c.JSON(http.StatusOK, server.AppAvailability{
+		AppId:        int(app.ID),
+		App:          app.App,
+		From:         first.Format(time.DateOnly),
+		Until:        last.Format(time.DateOnly),
+		Up:           int(total.Up.Seconds()),
+		Down:         int(total.Down.Seconds()),
+		Degraded:     int(total.Degraded.Seconds()),
+		Excluded:     int(total.Excluded.Seconds()),
+		Unknown:      int(total.Unknown.Seconds()),
+		Availability: availabilityPercent(total),
+		Services:     services,
+		Outages:      outages,
+	})
+}
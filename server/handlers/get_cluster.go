@@ -0,0 +1,34 @@
+package serverhandlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/server"
+	"github.com/opensvc/oc3/util/echolog"
+	"github.com/opensvc/oc3/util/logkey"
+)
+
+// GetCluster handles GET /clusters/{cluster_id}
+func (a *Api) GetCluster(c echo.Context, clusterId server.InPathClusterId, params server.GetClusterParams) error {
+	log := echolog.GetLogHandler(c, "GetCluster")
+	odb := a.getODB()
+	ctx := c.Request().Context()
+
+	if ok, err := clusterVisible(ctx, c, odb, clusterId); err != nil {
+		log.Error("cannot get cluster", "cluster_id", clusterId, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot get cluster")
+	} else if !ok {
+		return JSONProblemf(c, http.StatusNotFound, "cluster %s not found", clusterId)
+	}
+
+	return a.handleList(c, "GetCluster", "cluster", listEndpointParams{
+		props: params.Props, limit: params.Limit, offset: params.Offset,
+		meta: params.Meta, stats: params.Stats, orderby: params.Orderby, groupby: params.Groupby,
+	}, func(ctx context.Context, p cdb.ListParams) ([]map[string]any, error) {
+		return odb.GetCluster(ctx, clusterId, p)
+	})
+}
@@ -0,0 +1,34 @@
+package serverhandlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/server"
+	"github.com/opensvc/oc3/util/echolog"
+	"github.com/opensvc/oc3/util/logkey"
+)
+
+// GetClusterHeartbeats handles GET /clusters/{cluster_id}/heartbeats
+func (a *Api) GetClusterHeartbeats(c echo.Context, clusterId server.InPathClusterId, params server.GetClusterHeartbeatsParams) error {
+	log := echolog.GetLogHandler(c, "GetClusterHeartbeats")
+	odb := a.getODB()
+	ctx := c.Request().Context()
+
+	if ok, err := clusterVisible(ctx, c, odb, clusterId); err != nil {
+		log.Error("cannot get cluster", "cluster_id", clusterId, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot get cluster")
+	} else if !ok {
+		return JSONProblemf(c, http.StatusNotFound, "cluster %s not found", clusterId)
+	}
+
+	return a.handleList(c, "GetClusterHeartbeats", "heartbeat", listEndpointParams{
+		props: params.Props, limit: params.Limit, offset: params.Offset,
+		meta: params.Meta, stats: params.Stats, orderby: params.Orderby, groupby: params.Groupby,
+	}, func(ctx context.Context, p cdb.ListParams) ([]map[string]any, error) {
+		return odb.GetClusterHeartbeats(ctx, clusterId, p)
+	})
+}
@@ -0,0 +1,46 @@
+package serverhandlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/server"
+	"github.com/opensvc/oc3/util/echolog"
+	"github.com/opensvc/oc3/util/logkey"
+)
+
+// GetClusterHeartbeatsHistory handles GET /clusters/{cluster_id}/heartbeats/history
+func (a *Api) GetClusterHeartbeatsHistory(c echo.Context, clusterId server.InPathClusterId, params server.GetClusterHeartbeatsHistoryParams) error {
+	log := echolog.GetLogHandler(c, "GetClusterHeartbeatsHistory")
+	odb := a.getODB()
+	ctx := c.Request().Context()
+
+	var begin, end time.Time
+	if params.Begin != nil {
+		begin = *params.Begin
+	}
+	if params.End != nil {
+		end = *params.End
+	}
+	if !begin.IsZero() && !end.IsZero() && !end.After(begin) {
+		return JSONProblemf(c, http.StatusBadRequest, "end must be after begin")
+	}
+
+	if ok, err := clusterVisible(ctx, c, odb, clusterId); err != nil {
+		log.Error("cannot get cluster", "cluster_id", clusterId, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot get cluster")
+	} else if !ok {
+		return JSONProblemf(c, http.StatusNotFound, "cluster %s not found", clusterId)
+	}
+
+	return a.handleList(c, "GetClusterHeartbeatsHistory", "heartbeat_log", listEndpointParams{
+		props: params.Props, limit: params.Limit, offset: params.Offset,
+		meta: params.Meta, stats: params.Stats, orderby: params.Orderby, groupby: params.Groupby,
+	}, func(ctx context.Context, p cdb.ListParams) ([]map[string]any, error) {
+		return odb.GetClusterHeartbeatsLog(ctx, clusterId, begin, end, p)
+	})
+}
@@ -0,0 +1,21 @@
+package serverhandlers
+
+import (
+	"context"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/server"
+)
+
+// GetNodesGroups handles GET /nodes/groups
+func (a *Api) GetNodesGroups(c echo.Context, params server.GetNodesGroupsParams) error {
+	odb := a.getODB()
+	return a.handleList(c, "GetNodesGroups", "node_group", listEndpointParams{
+		props: params.Props, limit: params.Limit, offset: params.Offset,
+		meta: params.Meta, stats: params.Stats, orderby: params.Orderby, groupby: params.Groupby,
+	}, func(ctx context.Context, p cdb.ListParams) ([]map[string]any, error) {
+		return odb.GetNodeAccounts(ctx, cdb.NodeGroupsAccounts, "", p)
+	})
+}
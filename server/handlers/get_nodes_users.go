@@ -0,0 +1,21 @@
+package serverhandlers
+
+import (
+	"context"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/server"
+)
+
+// GetNodesUsers handles GET /nodes/users
+func (a *Api) GetNodesUsers(c echo.Context, params server.GetNodesUsersParams) error {
+	odb := a.getODB()
+	return a.handleList(c, "GetNodesUsers", "node_user", listEndpointParams{
+		props: params.Props, limit: params.Limit, offset: params.Offset,
+		meta: params.Meta, stats: params.Stats, orderby: params.Orderby, groupby: params.Groupby,
+	}, func(ctx context.Context, p cdb.ListParams) ([]map[string]any, error) {
+		return odb.GetNodeAccounts(ctx, cdb.NodeUsersAccounts, "", p)
+	})
+}
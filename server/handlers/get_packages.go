@@ -0,0 +1,34 @@
+package serverhandlers
+
+import (
+	"context"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/server"
+)
+
+// GetPackages handles GET /packages
+func (a *Api) GetPackages(c echo.Context, params server.GetPackagesParams) error {
+	odb := a.getODB()
+	var filter cdb.PackagesFilter
+	if params.PkgName != nil {
+		filter.Name = *params.PkgName
+	}
+	if params.PkgVersion != nil {
+		filter.Version = *params.PkgVersion
+	}
+	if params.PkgArch != nil {
+		filter.Arch = *params.PkgArch
+	}
+	if params.PkgType != nil {
+		filter.Type = *params.PkgType
+	}
+	return a.handleList(c, "GetPackages", "package", listEndpointParams{
+		props: params.Props, limit: params.Limit, offset: params.Offset,
+		meta: params.Meta, stats: params.Stats, orderby: params.Orderby, groupby: params.Groupby,
+	}, func(ctx context.Context, p cdb.ListParams) ([]map[string]any, error) {
+		return odb.GetPackages(ctx, filter, p)
+	})
+}
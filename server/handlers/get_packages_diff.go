@@ -0,0 +1,118 @@
+package serverhandlers
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/server"
+	"github.com/opensvc/oc3/util/echolog"
+	"github.com/opensvc/oc3/util/logkey"
+)
+
+// maxPackagesDiffNodes is the maximum number of nodes compared by
+// GET /packages/diff
+const maxPackagesDiffNodes = 100
+
+// GetPackagesDiff handles GET /packages/diff
+func (a *Api) GetPackagesDiff(c echo.Context, params server.GetPackagesDiffParams) error {
+	log := echolog.GetLogHandler(c, "GetPackagesDiff")
+	odb := a.getODB()
+	ctx := c.Request().Context()
+	groups := UserGroupsFromContext(c)
+	isManager := IsManager(c)
+
+	log.Info("called", "nodes", params.Nodes, "cluster_id", params.ClusterId)
+
+	var nodeIDs []string
+	if params.ClusterId != nil && *params.ClusterId != "" {
+		l, err := odb.ClusterNodeIDs(ctx, *params.ClusterId)
+		if err != nil {
+			log.Error("cannot get cluster nodes", "cluster_id", *params.ClusterId, logkey.Error, err)
+			return JSONProblemf(c, http.StatusInternalServerError, "cannot get cluster nodes")
+		}
+		if len(l) == 0 {
+			return JSONProblemf(c, http.StatusNotFound, "cluster %s not found", *params.ClusterId)
+		}
+		nodeIDs = append(nodeIDs, l...)
+	}
+	if params.Nodes != nil {
+		for _, s := range strings.Split(*params.Nodes, ",") {
+			s = strings.TrimSpace(s)
+			if s == "" {
+				continue
+			}
+			node, err := odb.NodeByNodeIDOrNodename(ctx, s)
+			if err != nil {
+				log.Error("cannot resolve node", "node_id", s, logkey.Error, err)
+				return JSONProblemf(c, http.StatusInternalServerError, "cannot resolve node")
+			}
+			if node == nil {
+				return JSONProblemf(c, http.StatusNotFound, "node %s not found", s)
+			}
+			if !slices.Contains(nodeIDs, node.NodeID) {
+				nodeIDs = append(nodeIDs, node.NodeID)
+			}
+		}
+	}
+	switch {
+	case len(nodeIDs) < 2:
+		return JSONProblemf(c, http.StatusBadRequest, "at least 2 nodes must be selected with the nodes or cluster_id parameters")
+	case len(nodeIDs) > maxPackagesDiffNodes:
+		return JSONProblemf(c, http.StatusBadRequest, "too many nodes selected: %d, the maximum is %d", len(nodeIDs), maxPackagesDiffNodes)
+	}
+
+	for _, nodeID := range nodeIDs {
+		responsible, err := odb.NodeResponsible(ctx, nodeID, groups, isManager)
+		if err != nil {
+			log.Error("cannot check node responsibility", "node_id", nodeID, logkey.Error, err)
+			return JSONProblemf(c, http.StatusInternalServerError, "cannot check node responsibility")
+		}
+		if !responsible {
+			return JSONProblemf(c, http.StatusForbidden, "you are not responsible for node %s", nodeID)
+		}
+	}
+
+	installs, err := odb.PackagesDiff(ctx, nodeIDs)
+	if err != nil {
+		log.Error("cannot get packages diff", logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot get packages diff")
+	}
+
+	resp := server.PackagesDiff{
+		Nodes: make([]server.PackagesDiffNode, 0, len(nodeIDs)),
+		Data:  make([]server.PackageDiff, 0),
+	}
+	for _, nodeID := range nodeIDs {
+		node, err := odb.NodeByNodeID(ctx, nodeID)
+		if err != nil {
+			log.Error("cannot get node", "node_id", nodeID, logkey.Error, err)
+			return JSONProblemf(c, http.StatusInternalServerError, "cannot get node")
+		}
+		n := server.PackagesDiffNode{NodeId: nodeID}
+		if node != nil {
+			n.Nodename = node.Nodename
+		}
+		resp.Nodes = append(resp.Nodes, n)
+	}
+	for _, i := range installs {
+		install := server.PackageInstall{
+			NodeId:     i.NodeID,
+			Nodename:   i.Nodename,
+			PkgVersion: i.Version,
+			PkgArch:    i.Arch,
+		}
+		if n := len(resp.Data); n > 0 && resp.Data[n-1].PkgName == i.Name && resp.Data[n-1].PkgType == i.Type {
+			resp.Data[n-1].Installs = append(resp.Data[n-1].Installs, install)
+			continue
+		}
+		resp.Data = append(resp.Data, server.PackageDiff{
+			PkgName:  i.Name,
+			PkgType:  i.Type,
+			Installs: []server.PackageInstall{install},
+		})
+	}
+	return c.JSON(http.StatusOK, resp)
+}
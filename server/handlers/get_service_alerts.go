@@ -0,0 +1,45 @@
+package serverhandlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/server"
+	"github.com/opensvc/oc3/util/echolog"
+	"github.com/opensvc/oc3/util/logkey"
+)
+
+// GetServiceAlerts handles GET /services/{svc_id}/alerts
+func (a *Api) GetServiceAlerts(c echo.Context, svcId string, params server.GetServiceAlertsParams) error {
+	log := echolog.GetLogHandler(c, "GetServiceAlerts")
+	odb := a.getODB()
+	ctx := c.Request().Context()
+
+	groups := UserGroupsFromContext(c)
+	isManager := IsManager(c)
+
+	// Resolve the service svc_id, the path parameter may be a svcname
+	svcs, err := odb.GetService(ctx, svcId, cdb.ListParams{
+		Limit: 1, Groups: groups, IsManager: isManager,
+		Props: []string{"svc_id"}, SelectExprs: []string{"services.svc_id"},
+	})
+	if err != nil {
+		log.Error("cannot resolve service", "svc_id", svcId, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot resolve service")
+	}
+	if len(svcs) == 0 {
+		return JSONProblemf(c, http.StatusNotFound, "service %s not found", svcId)
+	}
+	svcID, _ := svcs[0]["svc_id"].(string)
+
+	filter := alertsFilter(params.DashType, params.DashEnv, params.MinSeverity, params.Acked)
+	return a.handleList(c, "GetServiceAlerts", "alert", listEndpointParams{
+		props: params.Props, limit: params.Limit, offset: params.Offset,
+		meta: params.Meta, stats: params.Stats, orderby: params.Orderby, groupby: params.Groupby,
+	}, func(ctx context.Context, p cdb.ListParams) ([]map[string]any, error) {
+		return odb.GetServiceAlerts(ctx, svcID, filter, p)
+	})
+}
@@ -0,0 +1,87 @@
+package serverhandlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/availability"
+	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/server"
+	"github.com/opensvc/oc3/util/echolog"
+	"github.com/opensvc/oc3/util/logkey"
+)
+
+// GetServiceAvailability handles GET /services/{svc_id}/availability
+func (a *Api) GetServiceAvailability(c echo.Context, svcId string, params server.GetServiceAvailabilityParams) error {
+	log := echolog.GetLogHandler(c, "GetServiceAvailability")
+	odb := a.getODB()
+	ctx := c.Request().Context()
+
+	first, last, err := availabilityPeriod(params.From, params.Until)
+	if err != nil {
+		return JSONProblem(c, http.StatusBadRequest, err.Error())
+	}
+
+	svcs, err := odb.GetService(ctx, svcId, cdb.ListParams{
+		Limit: 1, Groups: UserGroupsFromContext(c), IsManager: IsManager(c),
+		Props: []string{"svc_id", "svcname"}, SelectExprs: []string{"services.svc_id", "services.svcname"},
+	})
+	if err != nil {
+		log.Error("cannot resolve service", "svc_id", svcId, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot resolve service")
+	}
+	if len(svcs) == 0 {
+		return JSONProblemf(c, http.StatusNotFound, "service %s not found", svcId)
+	}
+	svcID, _ := svcs[0]["svc_id"].(string)
+	svcname, _ := svcs[0]["svcname"].(string)
+
+	sums, err := odb.AvailabilityDailySums(ctx, first, last, svcID)
+	if err != nil {
+		log.Error("cannot get availability", "svc_id", svcID, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot get availability")
+	}
+
+	var total availability.Durations
+	instances := make([]server.InstanceAvailability, 0)
+	for _, sum := range sums {
+		d := availabilityDurations(sum)
+		if sum.NodeID == "" {
+			total = d
+			continue
+		}
+		instances = append(instances, server.InstanceAvailability{
+			NodeId:       sum.NodeID,
+			Nodename:     sum.Nodename,
+			Up:           int(sum.Up),
+			Down:         int(sum.Down),
+			Degraded:     int(sum.Degraded),
+			Excluded:     int(sum.Excluded),
+			Unknown:      int(sum.Unknown),
+			Availability: availabilityPercent(d),
+		})
+	}
+
+	outages, err := availabilityOutages(ctx, odb, first, last, map[string]string{svcID: svcname})
+	if err != nil {
+		log.Error("cannot get outages", "svc_id", svcID, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot get outages")
+	}
+
+	return c.JSON(http.StatusOK, server.ServiceAvailability{
+		SvcId:        svcID,
+		Svcname:      svcname,
+		From:         first.Format(time.DateOnly),
+		Until:        last.Format(time.DateOnly),
+		Up:           int(total.Up.Seconds()),
+		Down:         int(total.Down.Seconds()),
+		Degraded:     int(total.Degraded.Seconds()),
+		Excluded:     int(total.Excluded.Seconds()),
+		Unknown:      int(total.Unknown.Seconds()),
+		Availability: availabilityPercent(total),
+		Instances:    instances,
+		Outages:      outages,
+	})
+}
@@ -0,0 +1,29 @@
+package serverhandlers
+
+import (
+	"context"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/cdb"
+)
+
+// actionResponsible returns true if the user is a manager or responsible for
+// the app of the action object, or of the action node for node actions and
+// objects without app. It applies the action queue entries visibility rule.
+func actionResponsible(ctx context.Context, c echo.Context, odb *cdb.DB, nodeID, svcID string) (bool, error) {
+	if IsManager(c) {
+		return true, nil
+	}
+	return odb.ActionTargetResponsible(ctx, nodeID, svcID, UserGroupsFromContext(c))
+}
+
+// notifyActionQueueChange publishes the action_queue_change event with the
+// action queue counters, like the runner does.
+func notifyActionQueueChange(ctx context.Context, odb *cdb.DB) error {
+	data, err := odb.ActionQEventData(ctx)
+	if err != nil {
+		return err
+	}
+	return odb.Session.NotifyTableChangeWithData(ctx, "action_queue", data)
+}
@@ -0,0 +1,116 @@
+package serverhandlers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/opensvc/oc3/availability"
+	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/server"
+)
+
+const (
+	// defaultAvailabilityDays is the number of days reported when the
+	// request has no from.
+	defaultAvailabilityDays = 30
+
+	// maxAvailabilityDays is the maximum number of days reported.
+	maxAvailabilityDays = 366
+)
+
+// availabilityPeriod returns the first and last days of the availability
+// report from the from and until query parameters.
+func availabilityPeriod(from *server.InQueryAvailabilityFrom, until *server.InQueryAvailabilityUntil) (time.Time, time.Time, error) {
+	now := time.Now()
+	last := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	if until != nil && *until != "" {
+		t, err := time.ParseInLocation(time.DateOnly, *until, time.Local)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid until %q: expect a date like 2024-01-31", *until)
+		}
+		last = t
+	}
+	first := last.AddDate(0, 0, -defaultAvailabilityDays+1)
+	if from != nil && *from != "" {
+		t, err := time.ParseInLocation(time.DateOnly, *from, time.Local)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid from %q: expect a date like 2024-01-01", *from)
+		}
+		first = t
+	}
+	switch {
+	case first.After(last):
+		return time.Time{}, time.Time{}, fmt.Errorf("from must not be after until")
+	case last.Sub(first) >= maxAvailabilityDays*24*time.Hour:
+		return time.Time{}, time.Time{}, fmt.Errorf("the period must not exceed %d days", maxAvailabilityDays)
+	}
+	return first, last, nil
+}
+
+func availabilityDurations(a cdb.AvailabilityDaily) availability.Durations {
+	return availability.Durations{
+		Up:       time.Duration(a.Up) * time.Second,
+		Down:     time.Duration(a.Down) * time.Second,
+		Degraded: time.Duration(a.Degraded) * time.Second,
+		Excluded: time.Duration(a.Excluded) * time.Second,
+		Unknown:  time.Duration(a.Unknown) * time.Second,
+	}
+}
+
+func availabilityPercent(d availability.Durations) *float64 {
+	if v, ok := d.Percent(); ok {
+		return &v
+	}
+	return nil
+}
+
+// availabilityOutages returns the outages of the services from their
+// services_log intervals in the [first, last] days, most recent first.
+func availabilityOutages(ctx context.Context, odb *cdb.DB, first, last time.Time, svcnames map[string]string) ([]server.AvailabilityOutage, error) {
+	svcIDs := make([]string, 0, len(svcnames))
+	for svcID := range svcnames {
+		svcIDs = append(svcIDs, svcID)
+	}
+	l := make([]server.AvailabilityOutage, 0)
+	if len(svcIDs) == 0 {
+		return l, nil
+	}
+	begin := first
+	end := last.AddDate(0, 0, 1)
+	intervals, err := odb.ServicesLogIntervals(ctx, begin, end, svcIDs...)
+	if err != nil {
+		return nil, err
+	}
+	acks, err := odb.AvailabilityAckWindows(ctx, begin, end, svcIDs...)
+	if err != nil {
+		return nil, err
+	}
+	intervalsBySvcID := make(map[string][]availability.Interval)
+	for _, i := range intervals {
+		intervalsBySvcID[i.SvcID] = append(intervalsBySvcID[i.SvcID], availability.Interval{
+			Status: availability.ServiceStatus(i.AvailStatus),
+			Begin:  i.Begin,
+			End:    i.End,
+		})
+	}
+	windowsBySvcID := make(map[string][]availability.Window)
+	for _, w := range acks {
+		windowsBySvcID[w.SvcID] = append(windowsBySvcID[w.SvcID], availability.Window{Begin: w.Begin, End: w.End})
+	}
+	for svcID, v := range intervalsBySvcID {
+		for _, o := range availability.Outages(v, windowsBySvcID[svcID], begin, end) {
+			l = append(l, server.AvailabilityOutage{
+				SvcId:    svcID,
+				Svcname:  svcnames[svcID],
+				Begin:    o.Begin,
+				End:      o.End,
+				Duration: int(o.End.Sub(o.Begin).Seconds()),
+				Acked:    o.Acked,
+			})
+		}
+	}
+	slices.SortFunc(l, func(a, b server.AvailabilityOutage) int { return b.Begin.Compare(a.Begin) })
+	return l, nil
+}
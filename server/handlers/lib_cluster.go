@@ -0,0 +1,22 @@
+package serverhandlers
+
+import (
+	"context"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/cdb"
+)
+
+// clusterVisible returns true if the cluster exists and the user is a
+// manager or responsible for the app of at least one of its nodes.
+func clusterVisible(ctx context.Context, c echo.Context, odb *cdb.DB, clusterID string) (bool, error) {
+	l, err := odb.GetCluster(ctx, clusterID, cdb.ListParams{
+		Limit: 1, Groups: UserGroupsFromContext(c), IsManager: IsManager(c),
+		Props: []string{"cluster_id"}, SelectExprs: []string{"clusters.cluster_id"},
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(l) > 0, nil
+}
@@ -0,0 +1,163 @@
+package serverhandlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/runner"
+	"github.com/opensvc/oc3/server"
+	"github.com/opensvc/oc3/util/echolog"
+	"github.com/opensvc/oc3/util/logkey"
+	"github.com/opensvc/oc3/xauth"
+)
+
+// PostActions handles POST /actions
+func (a *Api) PostActions(c echo.Context) error {
+	log := echolog.GetLogHandler(c, "PostActions")
+	ctx, cancel := context.WithTimeout(c.Request().Context(), a.SyncTimeout)
+	defer cancel()
+
+	if !IsAuthByUser(c) {
+		return JSONProblemf(c, http.StatusUnauthorized, "user authentication required")
+	}
+
+	var body server.PostActionsJSONRequestBody
+	if err := c.Bind(&body); err != nil {
+		log.Error("invalid request body", logkey.Error, err)
+		return JSONProblem(c, http.StatusBadRequest, err.Error())
+	}
+
+	actionType := cdb.ActionQTypePush
+	if body.ActionType != nil {
+		switch string(*body.ActionType) {
+		case cdb.ActionQTypePush, cdb.ActionQTypePull:
+			actionType = string(*body.ActionType)
+		default:
+			return JSONProblemf(c, http.StatusBadRequest, "invalid action_type: %s", *body.ActionType)
+		}
+	}
+	var svcID string
+	if body.SvcId != nil {
+		svcID = *body.SvcId
+	}
+	params := make(map[string]any)
+	if body.Params != nil {
+		params = *body.Params
+	}
+
+	log.Info("called", logkey.NodeID, body.NodeId, logkey.ObjectID, svcID, "action", body.Action)
+
+	odb := cdb.New(a.DB)
+	odb.CreateSession(a.Ev)
+
+	node, err := odb.NodeByNodeIDOrNodename(ctx, body.NodeId)
+	if err != nil {
+		log.Error("cannot resolve node", logkey.NodeID, body.NodeId, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot resolve node")
+	}
+	if node == nil {
+		return JSONProblemf(c, http.StatusNotFound, "node %s not found", body.NodeId)
+	}
+
+	var svcname string
+	if svcID != "" {
+		obj, err := odb.ObjectFromID(ctx, svcID)
+		if err != nil {
+			log.Error("cannot resolve object", logkey.ObjectID, svcID, logkey.Error, err)
+			return JSONProblemf(c, http.StatusInternalServerError, "cannot resolve object")
+		}
+		if obj == nil {
+			return JSONProblemf(c, http.StatusNotFound, "object %s not found", svcID)
+		}
+		svcname = obj.Svcname
+
+		found, err := odb.InstanceExists(ctx, svcID, node.NodeID)
+		if err != nil {
+			log.Error("cannot check instance", logkey.ObjectID, svcID, logkey.NodeID, node.NodeID, logkey.Error, err)
+			return JSONProblemf(c, http.StatusInternalServerError, "cannot check instance")
+		}
+		if !found {
+			return JSONProblemf(c, http.StatusNotFound, "object %s has no instance on node %s", svcID, node.Nodename)
+		}
+	}
+
+	responsible, err := actionResponsible(ctx, c, odb, node.NodeID, svcID)
+	if err != nil {
+		log.Error("cannot check action responsibility", logkey.NodeID, node.NodeID, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot check action responsibility")
+	}
+	if !responsible {
+		return JSONProblemf(c, http.StatusForbidden, "you are not responsible for this action target")
+	}
+
+	agentVersion, err := odb.NodeAgentVersion(ctx, node.NodeID)
+	if err != nil {
+		log.Error("cannot get node agent version", logkey.NodeID, node.NodeID, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot get node agent version")
+	}
+	argv, err := runner.RenderAction(body.Action, svcname, agentVersion, params)
+	if err != nil {
+		return JSONProblem(c, http.StatusBadRequest, err.Error())
+	}
+	b, err := json.Marshal(params)
+	if err != nil {
+		return JSONProblem(c, http.StatusBadRequest, err.Error())
+	}
+	actionParams := string(b)
+
+	entry := cdb.ActionQueue{
+		Command:      runner.QuoteCommand(argv),
+		ActionType:   actionType,
+		NodeId:       node.NodeID,
+		SvcId:        svcID,
+		Action:       &body.Action,
+		ActionParams: &actionParams,
+	}
+	if user := UserInfoFromContext(c); user != nil {
+		if userID, err := strconv.Atoi(user.GetExtensions().Get(xauth.XUserID)); err == nil {
+			entry.UserId = &userID
+		}
+	}
+
+	id, err := odb.ActionQInsert(ctx, entry)
+	switch {
+	case errors.Is(err, cdb.ErrActionQColumns):
+		log.Error("cannot queue action", logkey.Error, err)
+		return JSONProblemf(c, http.StatusServiceUnavailable, "cannot queue action: %s", cdb.ErrActionQColumns)
+	case err != nil:
+		log.Error("cannot queue action", logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot queue action")
+	}
+
+	userEmail, _ := c.Get(XUserEmail).(string)
+	if err := odb.Log(ctx, cdb.LogEntry{
+		Action: "action_queue.add",
+		User:   userEmail,
+		Fmt:    "action %(id)s queued on %(nodename)s: %(command)s",
+		Dict: map[string]any{
+			"id":       id,
+			"nodename": node.Nodename,
+			"command":  entry.Command,
+		},
+		Level: "info",
+	}); err != nil {
+		log.Error("cannot write audit log", logkey.Error, err)
+	}
+
+	if err := notifyActionQueueChange(ctx, odb); err != nil {
+		log.Error("cannot notify action_queue change", logkey.Error, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"id":          id,
+		"command":     entry.Command,
+		"action_type": actionType,
+		"status":      "W",
+	})
+}
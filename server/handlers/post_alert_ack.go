@@ -0,0 +1,147 @@
+package serverhandlers
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/server"
+	"github.com/opensvc/oc3/util/echolog"
+	"github.com/opensvc/oc3/util/logkey"
+)
+
+const (
+	// defaultAlertAckDuration is the acknowledgement duration when the
+	// request has no expire_at.
+	defaultAlertAckDuration = 24 * time.Hour
+)
+
+// alertResponsible returns true if the user is a manager or is responsible
+// for the node app or the service app of the alert.
+func alertResponsible(ctx context.Context, c echo.Context, odb *cdb.DB, alertID int64) (bool, error) {
+	if IsManager(c) {
+		return true, nil
+	}
+	return odb.AlertResponsible(ctx, alertID, UserGroupsFromContext(c))
+}
+
+// PostAlertAck handles POST /alerts/{alert_id}/ack
+//
+// The acknowledgement of a service availability alert is also recorded in
+// svcmon_log_ack, so the workers stop raising the alert until it expires.
+func (a *Api) PostAlertAck(c echo.Context, alertId server.InPathAlertId) error {
+	log := echolog.GetLogHandler(c, "PostAlertAck")
+	ctx, cancel := context.WithTimeout(c.Request().Context(), a.SyncTimeout)
+	defer cancel()
+
+	if !IsAuthByUser(c) {
+		return JSONProblemf(c, http.StatusUnauthorized, "user authentication required")
+	}
+
+	var body server.PostAlertAckJSONRequestBody
+	if err := c.Bind(&body); err != nil {
+		return JSONProblemf(c, http.StatusBadRequest, "invalid body: %s", err)
+	}
+	comment := strings.TrimSpace(body.Comment)
+	if comment == "" {
+		return JSONProblemf(c, http.StatusBadRequest, "comment is required")
+	}
+	now := time.Now()
+	end := now.Add(defaultAlertAckDuration)
+	if body.ExpireAt != nil {
+		if !body.ExpireAt.After(now) {
+			return JSONProblemf(c, http.StatusBadRequest, "expire_at must be in the future")
+		}
+		end = *body.ExpireAt
+	}
+
+	log.Info("called", "alert_id", alertId)
+
+	odb := cdb.New(a.DB)
+	odb.CreateSession(a.Ev)
+
+	alert, err := odb.AlertByID(ctx, alertId)
+	if err != nil {
+		log.Error("cannot get alert", "alert_id", alertId, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot get alert")
+	}
+	if alert == nil {
+		return JSONProblemf(c, http.StatusNotFound, "alert %d not found", alertId)
+	}
+
+	responsible, err := alertResponsible(ctx, c, odb, alertId)
+	if err != nil {
+		log.Error("cannot check alert responsibility", "alert_id", alertId, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot check alert responsibility")
+	}
+	if !responsible {
+		return JSONProblemf(c, http.StatusForbidden, "you are not responsible for this alert")
+	}
+
+	userEmail, _ := c.Get(XUserEmail).(string)
+	ack := cdb.DashboardAck{
+		AlertKey: alert.Key,
+		Type:     alert.Type,
+		NodeID:   alert.NodeID,
+		SvcID:    alert.SvcID,
+		Begin:    now,
+		End:      end,
+		Comment:  comment,
+		AckedBy:  userEmail,
+		AckedOn:  now,
+	}
+	ackID, err := odb.InsertDashboardAck(ctx, ack)
+	if err != nil {
+		log.Error("cannot insert alert ack", "alert_id", alertId, logkey.Error, err)
+		return JSONProblemf(c, http.StatusInternalServerError, "cannot acknowledge alert")
+	}
+
+	if alert.SvcID != "" && slices.Contains(cdb.AckUnavailabilityAlertTypes, alert.Type) {
+		account := body.Account != nil && *body.Account
+		if err := odb.InsertSvcmonLogAck(ctx, alert.SvcID, now, end, comment, userEmail, account); err != nil {
+			log.Error("cannot insert service unavailability ack", "alert_id", alertId, logkey.Error, err)
+			return JSONProblemf(c, http.StatusInternalServerError, "cannot acknowledge service unavailability")
+		}
+	}
+
+	entry := cdb.LogEntry{
+		Action: "dashboard.ack",
+		User:   userEmail,
+		Fmt:    "alert %(type)s acknowledged until %(end)s: %(comment)s",
+		Dict: map[string]any{
+			"type":    alert.Type,
+			"end":     end.Format(time.DateTime),
+			"comment": comment,
+		},
+		Level: "info",
+	}
+	if nodeID, err := uuid.Parse(alert.NodeID); err == nil {
+		entry.NodeID = &nodeID
+	}
+	if svcID, err := uuid.Parse(alert.SvcID); err == nil {
+		entry.SvcID = &svcID
+	}
+	if err := odb.Log(ctx, entry); err != nil {
+		log.Error("cannot write audit log", logkey.Error, err)
+	}
+
+	if err := odb.Session.NotifyChanges(ctx); err != nil {
+		log.Error("cannot notify changes", logkey.Error, err)
+	}
+
+	return c.JSON(http.StatusOK, server.AlertAck{
+		Id:         int(ackID),
+		AlertId:    int(alertId),
+		AlertKey:   alert.Key,
+		AckBegin:   now,
+		AckEnd:     end,
+		AckComment: comment,
+		AckedBy:    userEmail,
+	})
+}
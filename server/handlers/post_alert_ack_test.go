@@ -0,0 +1,200 @@
+package serverhandlers
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/server"
+)
+
+type (
+	// fakeConnector is a database/sql connector answering the queries with
+	// the query func, and recording the executed statements.
+	fakeConnector struct {
+		query func(query string, args []driver.NamedValue) ([]string, [][]driver.Value)
+
+		mu    sync.Mutex
+		execs []string
+	}
+
+	fakeConn struct {
+		c *fakeConnector
+	}
+
+	fakeRows struct {
+		columns []string
+		values  [][]driver.Value
+	}
+
+	fakeTx struct{}
+
+	fakeResult struct{}
+
+	fakeEv struct{}
+)
+
+func TestMain(m *testing.M) {
+	cdb.InitMetrics()
+	os.Exit(m.Run())
+}
+
+func (c *fakeConnector) Connect(context.Context) (driver.Conn, error) { return &fakeConn{c: c}, nil }
+func (c *fakeConnector) Driver() driver.Driver                        { return nil }
+
+func (c *fakeConnector) executed(prefix string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return slices.ContainsFunc(c.execs, func(s string) bool { return strings.HasPrefix(s, prefix) })
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("prepare not supported")
+}
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return fakeTx{}, nil }
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	columns, values := c.c.query(query, args)
+	return &fakeRows{columns: columns, values: values}, nil
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	c.c.mu.Lock()
+	defer c.c.mu.Unlock()
+	c.c.execs = append(c.c.execs, strings.TrimSpace(query))
+	return fakeResult{}, nil
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+func (fakeTx) Commit() error   { return nil }
+func (fakeTx) Rollback() error { return nil }
+
+func (fakeResult) LastInsertId() (int64, error) { return 1, nil }
+func (fakeResult) RowsAffected() (int64, error) { return 1, nil }
+
+func (fakeEv) EventPublish(string, map[string]any) error { return nil }
+
+// newServiceAlertConnector returns a fake database with the service
+// unavailable alert 1 of the service svc1, whose app has the grp1 group as
+// responsible. The alert has no node_id, like the alerts inserted by
+// DashboardUpdateObject.
+func newServiceAlertConnector() *fakeConnector {
+	return &fakeConnector{
+		query: func(query string, args []driver.NamedValue) ([]string, [][]driver.Value) {
+			switch {
+			case strings.HasPrefix(query, "SELECT d.id,"):
+				return []string{"id", "key", "type", "severity", "node_id", "nodename", "svc_id", "svcname",
+						"env", "app", "fmt", "dict", "created"},
+					[][]driver.Value{{int64(1), "k1", "service unavailable", int64(4), "", "", "svc1", "svcname1",
+						"PRD", "app1", "", "", time.Now()}}
+			case strings.HasPrefix(query, "SELECT COUNT(*)"):
+				// the service-only alert is matched by the service app
+				// responsibles only
+				var n int64
+				if strings.Contains(query, "dashboard.svc_id IN") && len(args) > 0 && args[0].Value == int64(1) &&
+					slices.ContainsFunc(args[1:], func(v driver.NamedValue) bool { return v.Value == "grp1" }) {
+					n = 1
+				}
+				return []string{"n"}, [][]driver.Value{{n}}
+			default:
+				return []string{"id"}, nil
+			}
+		},
+	}
+}
+
+func newAlertTestContext(method, target, body string, groups ...string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.Set(XAuthMode, AuthModeUser)
+	c.Set(XUserEmail, "user@example.com")
+	c.Set("groups", groups)
+	return c, rec
+}
+
+func TestPostAlertAckServiceAlert(t *testing.T) {
+	cases := []struct {
+		name       string
+		groups     []string
+		wantStatus int
+	}{
+		{name: "service app responsible", groups: []string{"grp1"}, wantStatus: http.StatusOK},
+		{name: "not responsible", groups: []string{"grp2"}, wantStatus: http.StatusForbidden},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			connector := newServiceAlertConnector()
+			db := sql.OpenDB(connector)
+			defer func() { _ = db.Close() }()
+			a := &Api{DB: db, Ev: fakeEv{}, SyncTimeout: time.Second}
+
+			c, rec := newAlertTestContext(http.MethodPost, "/alerts/1/ack", `{"comment": "maintenance"}`, tc.groups...)
+			if err := a.PostAlertAck(c, 1); err != nil {
+				t.Fatalf("PostAlertAck: %s", err)
+			}
+			if rec.Code != tc.wantStatus {
+				t.Fatalf("PostAlertAck status = %d, want %d: %s", rec.Code, tc.wantStatus, rec.Body)
+			}
+			acked := tc.wantStatus == http.StatusOK
+			if got := connector.executed("INSERT INTO dashboard_ack"); got != acked {
+				t.Errorf("dashboard_ack inserted = %v, want %v", got, acked)
+			}
+			if got := connector.executed("INSERT INTO svcmon_log_ack"); got != acked {
+				t.Errorf("svcmon_log_ack inserted = %v, want %v", got, acked)
+			}
+		})
+	}
+}
+
+func TestGetAlertEventsServiceAlert(t *testing.T) {
+	cases := []struct {
+		name       string
+		groups     []string
+		wantStatus int
+	}{
+		{name: "service app responsible", groups: []string{"grp1"}, wantStatus: http.StatusOK},
+		{name: "not responsible", groups: []string{"grp2"}, wantStatus: http.StatusForbidden},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := sql.OpenDB(newServiceAlertConnector())
+			defer func() { _ = db.Close() }()
+			a := &Api{ODB: cdb.New(db)}
+
+			c, rec := newAlertTestContext(http.MethodGet, "/alerts/1/events", "", tc.groups...)
+			if err := a.GetAlertEvents(c, 1, server.GetAlertEventsParams{}); err != nil {
+				t.Fatalf("GetAlertEvents: %s", err)
+			}
+			if rec.Code != tc.wantStatus {
+				t.Fatalf("GetAlertEvents status = %d, want %d: %s", rec.Code, tc.wantStatus, rec.Body)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package serverhandlers
 import (
 	"fmt"
 
+	"github.com/opensvc/oc3/cdb"
 	"github.com/opensvc/oc3/schema"
 )
 
@@ -53,6 +54,143 @@ type propMapping struct {
 }
 
 var propsMapping = map[string]propMapping{
+	"action": {
+		Available: []string{
+			"id", "status", "command", "action", "action_params", "action_type",
+			"date_queued", "date_dequeued", "ret", "stdout", "stderr",
+			"user_id", "connect_to", "node_id", "nodename", "svc_id", "svcname",
+		},
+		Default: []string{
+			"id", "status", "command", "action", "action_params", "action_type",
+			"date_queued", "date_dequeued", "ret", "user_id", "node_id", "nodename", "svc_id", "svcname",
+		},
+		Props: map[string]propDef{
+			"id":            col(schema.ActionQueueID),
+			"status":        colStr(schema.ActionQueueStatus),
+			"command":       colStr(schema.ActionQueueCommand),
+			"action":        colStr(schema.ActionQueueAction),
+			"action_params": colStr(schema.ActionQueueActionParams),
+			"action_type":   colStr(schema.ActionQueueActionType),
+			"date_queued":   colStr(schema.ActionQueueDateQueued),
+			"date_dequeued": colStr(schema.ActionQueueDateDequeued),
+			"ret":           col(schema.ActionQueueRet),
+			"stdout":        colStr(schema.ActionQueueStdout),
+			"stderr":        colStr(schema.ActionQueueStderr),
+			"user_id":       col(schema.ActionQueueUserID),
+			"connect_to":    colStr(schema.ActionQueueConnectTo),
+			"node_id":       colStr(schema.ActionQueueNodeID),
+			"nodename":      colStr(schema.NodesNodename),
+			"svc_id":        colStr(schema.ActionQueueSvcID),
+			"svcname":       colStr(schema.ServicesSvcname),
+		},
+	},
+	"alert": {
+		Available: []string{
+			"id", "dash_type", "dash_severity", "node_id", "svc_id", "dash_env",
+			"dash_fmt", "dash_dict", "dash_instance", "dash_created", "dash_updated",
+			"acked", "ack_comment", "acked_by", "ack_end",
+		},
+		Default: []string{
+			"id", "dash_type", "dash_severity", "node_id", "svc_id", "dash_env",
+			"dash_fmt", "dash_dict", "dash_created", "acked",
+		},
+		Props: map[string]propDef{
+			"id":            col(schema.DashboardID),
+			"dash_type":     colStr(schema.DashboardDashType),
+			"dash_severity": colInt(schema.DashboardDashSeverity),
+			"node_id":       colStr(schema.DashboardNodeID),
+			"svc_id":        colStr(schema.DashboardSvcID),
+			"dash_env":      colStr(schema.DashboardDashEnv),
+			"dash_fmt":      colStr(schema.DashboardDashFmt),
+			"dash_dict":     colStr(schema.DashboardDashDict),
+			"dash_instance": colStr(schema.DashboardDashInstance),
+			"dash_created":  colStr(schema.DashboardDashCreated),
+			"dash_updated":  colStr(schema.DashboardDashUpdated),
+			"acked":         {SQLExpr: "IF(" + cdb.DashboardAckExpr("id") + " IS NULL, 0, 1)", Kind: "int64"},
+			"ack_comment":   {SQLExpr: "COALESCE(" + cdb.DashboardAckExpr("ack_comment") + ", '')", Kind: "string"},
+			"acked_by":      {SQLExpr: "COALESCE(" + cdb.DashboardAckExpr("acked_by") + ", '')", Kind: "string"},
+			"ack_end":       {SQLExpr: "COALESCE(" + cdb.DashboardAckExpr("ack_end") + ", '')", Kind: "string"},
+		},
+	},
+	"alert_event": {
+		Available: []string{"id", "dash_md5", "node_id", "svc_id", "dash_begin", "dash_end"},
+		Props: map[string]propDef{
+			"id":         col(schema.DashboardEventsID),
+			"dash_md5":   colStr(schema.DashboardEventsDashMD5),
+			"node_id":    colStr(schema.DashboardEventsNodeID),
+			"svc_id":     colStr(schema.DashboardEventsSvcID),
+			"dash_begin": colStr(schema.DashboardEventsDashBegin),
+			"dash_end":   colStr(schema.DashboardEventsDashEnd),
+		},
+	},
+	"cluster": {
+		Available: []string{
+			"id", "cluster_id", "cluster_name", "cluster_data",
+			"nodes", "services", "node_count", "svc_count",
+			"last_daemon_status", "agent_versions",
+		},
+		Default: []string{
+			"id", "cluster_id", "cluster_name",
+			"nodes", "services", "node_count", "svc_count",
+			"last_daemon_status", "agent_versions",
+		},
+		Props: map[string]propDef{
+			"id":           col(schema.ClustersID),
+			"cluster_id":   colStr(schema.ClustersClusterID),
+			"cluster_name": colStr(schema.ClustersClusterName),
+			// cluster_nodes and cluster_services are the nodes and services
+			// the user is responsible for, see cdb.GetClusters. The
+			// cluster_data describes all the cluster nodes and services, so it
+			// is hidden if the user is not responsible for all the nodes.
+			"cluster_data":       {Col: schema.ClustersClusterData, SQLExpr: "IF(EXISTS (SELECT 1 FROM nodes n WHERE n.cluster_id = clusters.cluster_id AND n.node_id NOT IN (SELECT cn.node_id FROM cluster_nodes cn)), '', COALESCE(clusters.cluster_data, ''))", Kind: "string"},
+			"nodes":              {SQLExpr: "COALESCE((SELECT GROUP_CONCAT(n.nodename ORDER BY n.nodename) FROM cluster_nodes n WHERE n.cluster_id = clusters.cluster_id), '')", Kind: "string"},
+			"services":           {SQLExpr: "COALESCE((SELECT GROUP_CONCAT(s.svcname ORDER BY s.svcname) FROM cluster_services s WHERE s.cluster_id = clusters.cluster_id), '')", Kind: "string"},
+			"node_count":         {SQLExpr: "(SELECT COUNT(*) FROM cluster_nodes n WHERE n.cluster_id = clusters.cluster_id)", Kind: "int64"},
+			"svc_count":          {SQLExpr: "(SELECT COUNT(*) FROM cluster_services s WHERE s.cluster_id = clusters.cluster_id)", Kind: "int64"},
+			"last_daemon_status": {SQLExpr: "COALESCE((SELECT MAX(n.last_comm) FROM cluster_nodes n WHERE n.cluster_id = clusters.cluster_id), '')", Kind: "string"},
+			"agent_versions":     {SQLExpr: "COALESCE((SELECT GROUP_CONCAT(DISTINCT n.version ORDER BY n.version) FROM cluster_nodes n WHERE n.cluster_id = clusters.cluster_id), '')", Kind: "string"},
+		},
+	},
+	"heartbeat": {
+		Available: []string{
+			"id", "cluster_id", "node_id", "nodename", "peer_node_id", "peer_nodename",
+			"driver", "name", "desc", "state", "beating", "last_beating", "updated",
+		},
+		Props: map[string]propDef{
+			"id":            col(schema.HbmonID),
+			"cluster_id":    colStr(schema.HbmonClusterID),
+			"node_id":       colStr(schema.HbmonNodeID),
+			"nodename":      {SQLExpr: "COALESCE((SELECT n.nodename FROM nodes n WHERE n.node_id = hbmon.node_id), '')", Kind: "string"},
+			"peer_node_id":  colStr(schema.HbmonPeerNodeID),
+			"peer_nodename": {SQLExpr: "COALESCE((SELECT n.nodename FROM nodes n WHERE n.node_id = hbmon.peer_node_id), '')", Kind: "string"},
+			"driver":        colStr(schema.HbmonDriver),
+			"name":          colStr(schema.HbmonName),
+			"desc":          colStr(schema.HbmonDesc),
+			"state":         colStr(schema.HbmonState),
+			"beating":       colInt(schema.HbmonBeating),
+			"last_beating":  colStr(schema.HbmonLastBeating),
+			"updated":       colStr(schema.HbmonUpdated),
+		},
+	},
+	"heartbeat_log": {
+		Available: []string{
+			"id", "cluster_id", "node_id", "nodename", "peer_node_id", "peer_nodename",
+			"name", "state", "beating", "begin", "end",
+		},
+		Props: map[string]propDef{
+			"id":            col(schema.HbmonLogID),
+			"cluster_id":    colStr(schema.HbmonLogClusterID),
+			"node_id":       colStr(schema.HbmonLogNodeID),
+			"nodename":      {SQLExpr: "COALESCE((SELECT n.nodename FROM nodes n WHERE n.node_id = hbmon_log.node_id), '')", Kind: "string"},
+			"peer_node_id":  colStr(schema.HbmonLogPeerNodeID),
+			"peer_nodename": {SQLExpr: "COALESCE((SELECT n.nodename FROM nodes n WHERE n.node_id = hbmon_log.peer_node_id), '')", Kind: "string"},
+			"name":          colStr(schema.HbmonLogName),
+			"state":         colStr(schema.HbmonLogState),
+			"beating":       colInt(schema.HbmonLogBeating),
+			"begin":         colStr(schema.HbmonLogBegin),
+			"end":           colStr(schema.HbmonLogEnd),
+		},
+	},
 	"node": {
 		Available: []string{
 			"node_id", "nodename", "app", "node_env", "cluster_id",
@@ -215,6 +353,71 @@ var propsMapping = map[string]propMapping{
 			"updated":  colStr(schema.NodeHBAUpdated),
 		},
 	},
+	"node_user": {
+		Available: []string{"id", "node_id", "user_name", "user_id", "updated"},
+		Props: map[string]propDef{
+			"id":        col(schema.NodeUsersID),
+			"node_id":   colStr(schema.NodeUsersNodeID),
+			"user_name": colStr(schema.NodeUsersUserName),
+			"user_id":   col(schema.NodeUsersUserID),
+			"updated":   colStr(schema.NodeUsersUpdated),
+		},
+	},
+	"node_group": {
+		Available: []string{"id", "node_id", "group_name", "group_id", "updated"},
+		Props: map[string]propDef{
+			"id":         col(schema.NodeGroupsID),
+			"node_id":    colStr(schema.NodeGroupsNodeID),
+			"group_name": colStr(schema.NodeGroupsGroupName),
+			"group_id":   col(schema.NodeGroupsGroupID),
+			"updated":    colStr(schema.NodeGroupsUpdated),
+		},
+	},
+	"node_history": {
+		Available: []string{"id", "node_id", "prop", "old_value", "new_value", "changed_at"},
+		Props: map[string]propDef{
+			"id":         col(schema.NodePropertiesLogID),
+			"node_id":    colStr(schema.NodePropertiesLogNodeID),
+			"prop":       colStr(schema.NodePropertiesLogProp),
+			"old_value":  colStr(schema.NodePropertiesLogOldValue),
+			"new_value":  colStr(schema.NodePropertiesLogNewValue),
+			"changed_at": colStr(schema.NodePropertiesLogChangedAt),
+		},
+	},
+	"package": {
+		Available: []string{
+			"id", "node_id", "pkg_name", "pkg_version", "pkg_arch", "pkg_type",
+			"pkg_sig", "pkg_install_date", "pkg_updated",
+		},
+		Default: []string{"id", "node_id", "pkg_name", "pkg_version", "pkg_arch", "pkg_type", "pkg_install_date"},
+		Props: map[string]propDef{
+			"id":               col(schema.PackagesID),
+			"node_id":          colStr(schema.PackagesNodeID),
+			"pkg_name":         colStr(schema.PackagesPkgName),
+			"pkg_version":      colStr(schema.PackagesPkgVersion),
+			"pkg_arch":         colStr(schema.PackagesPkgArch),
+			"pkg_type":         colStr(schema.PackagesPkgType),
+			"pkg_sig":          colStr(schema.PackagesPkgSig),
+			"pkg_install_date": colStr(schema.PackagesPkgInstallDate),
+			"pkg_updated":      colStr(schema.PackagesPkgUpdated),
+		},
+		Joins: map[string]JoinDef{
+			"nodes": {
+				MappingKey: "node",
+			},
+		},
+	},
+	"patch": {
+		Available: []string{"id", "node_id", "patch_num", "patch_rev", "patch_install_date", "patch_updated"},
+		Props: map[string]propDef{
+			"id":                 col(schema.PatchesID),
+			"node_id":            colStr(schema.PatchesNodeID),
+			"patch_num":          colStr(schema.PatchesPatchNum),
+			"patch_rev":          colStr(schema.PatchesPatchRev),
+			"patch_install_date": colStr(schema.PatchesPatchInstallDate),
+			"patch_updated":      colStr(schema.PatchesPatchUpdated),
+		},
+	},
 	"array": {
 		Available: []string{
 			"id", "array_name", "array_comment", "array_model",
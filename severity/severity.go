@@ -0,0 +1,260 @@
+// Package severity resolves the dashboard alerts severity from the
+// configured rules, shared by the worker dashboards, the scheduler alert
+// tasks and the api preview endpoint.
+//
+// The rules are read from the alerts.severity configuration section. The
+// first rule matching an alert gives its severity or suppresses it. The
+// alerts matched by no rule keep their built-in severity.
+//
+//	alerts:
+//	  severity:
+//	    rules:
+//	      - name: app1 is critical
+//	        apps: [app1]
+//	        envs: [PRD, PPRD]
+//	        types: ["service *"]
+//	        severity: 4
+//	      - name: no dev check alerts
+//	        envs: [DEV]
+//	        types: ["check *"]
+//	        suppress: true
+package severity
+
+import (
+	"fmt"
+	"log/slog"
+	"path"
+	"slices"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/viper"
+)
+
+type (
+	// Rule gives a severity to, or suppresses, the alerts matching all its
+	// criteria. An empty criteria matches all alerts.
+	Rule struct {
+		Name string `mapstructure:"name" json:"name"`
+
+		// Types are the dashboard alert types, with optional shell
+		// wildcards like "check *"
+		Types []string `mapstructure:"types" json:"types,omitempty"`
+
+		Envs []string `mapstructure:"envs" json:"envs,omitempty"`
+		Apps []string `mapstructure:"apps" json:"apps,omitempty"`
+
+		// Tags match the alerts of the nodes or services with at least
+		// one of these tags
+		Tags []string `mapstructure:"tags" json:"tags,omitempty"`
+
+		// NodeTypes match the alerts of the nodes with one of these
+		// nodes.type values, like vm or physical
+		NodeTypes []string `mapstructure:"node_types" json:"node_types,omitempty"`
+
+		Severity *int `mapstructure:"severity" json:"severity,omitempty"`
+		Suppress bool `mapstructure:"suppress" json:"suppress,omitempty"`
+	}
+
+	// Subject is the alert evaluated by the rules.
+	Subject struct {
+		Type     string
+		Env      string
+		App      string
+		Tags     []string
+		NodeType string
+
+		// Severity is the built-in severity of the alert, kept if no rule
+		// matches
+		Severity int
+	}
+
+	// Decision is the resolved severity of an alert.
+	Decision struct {
+		Severity int
+		Suppress bool
+
+		// Index is the index of the matching rule, -1 if none matched
+		Index int
+
+		// Rule is the name of the matching rule, or rules[<index>] if not
+		// named
+		Rule string
+	}
+
+	// Resolver evaluates the rules. A nil Resolver keeps the built-in
+	// severities.
+	Resolver struct {
+		rules []Rule
+	}
+)
+
+const (
+	MaxSeverity = 5
+
+	configSection = "alerts.severity.rules"
+)
+
+var (
+	current struct {
+		sync.RWMutex
+		once     sync.Once
+		resolver *Resolver
+	}
+
+	// builtin are the severities of the worker dashboard alerts, by env,
+	// with a DEFAULT fallback.
+	builtin = map[string]map[string]int{
+		"service available but degraded": {"DEFAULT": 2, "PRD": 3},
+		"flex error":                     {"DEFAULT": 5, "PRD": 4},
+		"service placement":              {"DEFAULT": 1},
+		"service unavailable":            {"DEFAULT": 3, "PRD": 4},
+	}
+)
+
+// Builtin returns the built-in severity of the alert type for the env, or
+// 0 if the alert type has no built-in severity.
+func Builtin(alertType, env string) int {
+	m := builtin[alertType]
+	if m == nil {
+		return 0
+	}
+	if v, ok := m[env]; ok {
+		return v
+	}
+	return m["DEFAULT"]
+}
+
+// LoadResolver returns the Resolver of the configured rules.
+func LoadResolver() (*Resolver, error) {
+	var rules []Rule
+	if err := viper.UnmarshalKey(configSection, &rules); err != nil {
+		return nil, fmt.Errorf("%s: %w", configSection, err)
+	}
+	return New(rules)
+}
+
+// Current returns the Resolver of the configured rules. The rules are
+// loaded on first use, and reloaded when the configuration file changes.
+// The built-in severities are kept if the rules are invalid.
+func Current() *Resolver {
+	current.once.Do(func() {
+		if err := Reload(); err != nil {
+			slog.Warn(fmt.Sprintf("alert severity rules: %s", err))
+		}
+		if viper.ConfigFileUsed() == "" {
+			return
+		}
+		viper.OnConfigChange(func(fsnotify.Event) {
+			if err := Reload(); err != nil {
+				slog.Warn(fmt.Sprintf("alert severity rules: keep the previous rules: %s", err))
+			} else {
+				slog.Info("alert severity rules reloaded")
+			}
+		})
+		viper.WatchConfig()
+	})
+	current.RLock()
+	defer current.RUnlock()
+	return current.resolver
+}
+
+// Reload loads the configured rules, used by Current if valid.
+func Reload() error {
+	r, err := LoadResolver()
+	if err != nil {
+		return err
+	}
+	current.Lock()
+	defer current.Unlock()
+	current.resolver = r
+	return nil
+}
+
+// New returns a Resolver of the rules. It returns an error if a rule gives
+// no severity and does not suppress, or gives an invalid severity or type
+// pattern.
+func New(rules []Rule) (*Resolver, error) {
+	for i, r := range rules {
+		switch {
+		case r.Severity == nil && !r.Suppress:
+			return nil, fmt.Errorf("%s[%d]: expect severity or suppress", configSection, i)
+		case r.Severity != nil && r.Suppress:
+			return nil, fmt.Errorf("%s[%d]: severity and suppress are exclusive", configSection, i)
+		case r.Severity != nil && (*r.Severity < 0 || *r.Severity > MaxSeverity):
+			return nil, fmt.Errorf("%s[%d]: invalid severity %d: expect 0 to %d", configSection, i, *r.Severity, MaxSeverity)
+		}
+		for _, pattern := range r.Types {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("%s[%d]: invalid type pattern %q: %w", configSection, i, pattern, err)
+			}
+		}
+	}
+	return &Resolver{rules: rules}, nil
+}
+
+// Rules returns the rules, in evaluation order.
+func (r *Resolver) Rules() []Rule {
+	if r == nil {
+		return nil
+	}
+	return r.rules
+}
+
+// Enabled returns true if at least one rule is configured.
+func (r *Resolver) Enabled() bool {
+	return r != nil && len(r.rules) > 0
+}
+
+// NeedTags returns true if a rule matches on tags, so the callers only
+// fetch the tags when needed.
+func (r *Resolver) NeedTags() bool {
+	if r == nil {
+		return false
+	}
+	return slices.ContainsFunc(r.rules, func(rule Rule) bool { return len(rule.Tags) > 0 })
+}
+
+// Resolve returns the decision of the first rule matching the subject, or
+// the subject built-in severity if no rule matches.
+func (r *Resolver) Resolve(s Subject) Decision {
+	if r != nil {
+		for i, rule := range r.rules {
+			if !rule.Match(s) {
+				continue
+			}
+			d := Decision{Severity: s.Severity, Suppress: rule.Suppress, Index: i, Rule: rule.Name}
+			if d.Rule == "" {
+				d.Rule = fmt.Sprintf("rules[%d]", i)
+			}
+			if rule.Severity != nil {
+				d.Severity = *rule.Severity
+			}
+			return d
+		}
+	}
+	return Decision{Severity: s.Severity, Index: -1}
+}
+
+// Match returns true if the subject satisfies all the rule criteria.
+func (rule Rule) Match(s Subject) bool {
+	if len(rule.Envs) > 0 && !slices.Contains(rule.Envs, s.Env) {
+		return false
+	}
+	if len(rule.Apps) > 0 && !slices.Contains(rule.Apps, s.App) {
+		return false
+	}
+	if len(rule.NodeTypes) > 0 && !slices.Contains(rule.NodeTypes, s.NodeType) {
+		return false
+	}
+	if len(rule.Tags) > 0 && !slices.ContainsFunc(rule.Tags, func(tag string) bool { return slices.Contains(s.Tags, tag) }) {
+		return false
+	}
+	if len(rule.Types) > 0 && !slices.ContainsFunc(rule.Types, func(pattern string) bool {
+		matched, _ := path.Match(pattern, s.Type)
+		return matched
+	}) {
+		return false
+	}
+	return true
+}
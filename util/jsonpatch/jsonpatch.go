@@ -0,0 +1,337 @@
+// Package jsonpatch applies RFC 6902 JSON patches to json documents decoded
+// as map[string]any, []any and scalar values.
+package jsonpatch
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type (
+	// Operation is a RFC 6902 patch operation. The Value is used by the add,
+	// replace and test operations, the From by the move and copy operations.
+	Operation struct {
+		Op    string `json:"op"`
+		Path  string `json:"path"`
+		From  string `json:"from,omitempty"`
+		Value any    `json:"value,omitempty"`
+	}
+
+	// Patch is a list of operations applied in order.
+	Patch []Operation
+)
+
+var (
+	// ErrInvalid is returned for malformed operations, like an unknown op or
+	// an invalid json pointer.
+	ErrInvalid = errors.New("invalid operation")
+
+	// ErrConflict is returned for operations not applicable to the
+	// document, like a missing target or a failed test.
+	ErrConflict = errors.New("operation conflicts with document")
+)
+
+// Apply returns the document patched by the operations. The document is
+// modified in place, the returned value must be used as it differs from doc
+// when the root is replaced. On error the document may be partially patched.
+func (p Patch) Apply(doc any) (any, error) {
+	var err error
+	for i, op := range p {
+		if doc, err = op.apply(doc); err != nil {
+			return doc, fmt.Errorf("operation %d %s %s: %w", i, op.Op, op.Path, err)
+		}
+	}
+	return doc, nil
+}
+
+// Paths returns the decoded json pointers of the locations modified by the
+// operations, including the source locations of the move operations.
+func (p Patch) Paths() ([][]string, error) {
+	l := make([][]string, 0, len(p))
+	for i, op := range p {
+		tokens, err := ParsePointer(op.Path)
+		if err != nil {
+			return nil, fmt.Errorf("operation %d path: %w", i, err)
+		}
+		switch op.Op {
+		case "test":
+			continue
+		case "move":
+			from, err := ParsePointer(op.From)
+			if err != nil {
+				return nil, fmt.Errorf("operation %d from: %w", i, err)
+			}
+			l = append(l, from)
+		}
+		l = append(l, tokens)
+	}
+	return l, nil
+}
+
+// ParsePointer returns the unescaped reference tokens of a RFC 6901 json
+// pointer. The empty pointer, referencing the whole document, has no token.
+func ParsePointer(s string) ([]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(s, "/") {
+		return nil, fmt.Errorf("%w: json pointer %q must start with /", ErrInvalid, s)
+	}
+	tokens := strings.Split(s[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+	}
+	return tokens, nil
+}
+
+func (op Operation) apply(doc any) (any, error) {
+	tokens, err := ParsePointer(op.Path)
+	if err != nil {
+		return doc, err
+	}
+	switch op.Op {
+	case "add":
+		return add(doc, tokens, op.Value)
+	case "remove":
+		doc, _, err = remove(doc, tokens)
+		return doc, err
+	case "replace":
+		if doc, _, err = remove(doc, tokens); err != nil {
+			return doc, err
+		}
+		return add(doc, tokens, op.Value)
+	case "move":
+		from, err := ParsePointer(op.From)
+		if err != nil {
+			return doc, err
+		}
+		if len(from) < len(tokens) && slices.Equal(from, tokens[:len(from)]) {
+			return doc, fmt.Errorf("%w: can not move %s to its child %s", ErrInvalid, op.From, op.Path)
+		}
+		var v any
+		if doc, v, err = remove(doc, from); err != nil {
+			return doc, err
+		}
+		return add(doc, tokens, v)
+	case "copy":
+		from, err := ParsePointer(op.From)
+		if err != nil {
+			return doc, err
+		}
+		v, err := get(doc, from)
+		if err != nil {
+			return doc, err
+		}
+		return add(doc, tokens, deepCopy(v))
+	case "test":
+		v, err := get(doc, tokens)
+		if err != nil {
+			return doc, err
+		}
+		if !equal(v, op.Value) {
+			return doc, fmt.Errorf("%w: test failed", ErrConflict)
+		}
+		return doc, nil
+	default:
+		return doc, fmt.Errorf("%w: unknown op %q", ErrInvalid, op.Op)
+	}
+}
+
+// get returns the value referenced by tokens.
+func get(doc any, tokens []string) (any, error) {
+	v := doc
+	for _, token := range tokens {
+		switch c := v.(type) {
+		case map[string]any:
+			var ok bool
+			if v, ok = c[token]; !ok {
+				return nil, fmt.Errorf("%w: key %q not found", ErrConflict, token)
+			}
+		case []any:
+			i, err := index(token, len(c)-1)
+			if err != nil {
+				return nil, err
+			}
+			v = c[i]
+		default:
+			return nil, fmt.Errorf("%w: can not walk %q in a scalar value", ErrConflict, token)
+		}
+	}
+	return v, nil
+}
+
+// add sets the value referenced by tokens, inserting it if the parent is an
+// array, and returns the patched document.
+func add(doc any, tokens []string, value any) (any, error) {
+	if len(tokens) == 0 {
+		return value, nil
+	}
+	parent, err := get(doc, tokens[:len(tokens)-1])
+	if err != nil {
+		return doc, err
+	}
+	token := tokens[len(tokens)-1]
+	switch c := parent.(type) {
+	case map[string]any:
+		c[token] = value
+		return doc, nil
+	case []any:
+		i := len(c)
+		if token != "-" {
+			if i, err = index(token, len(c)); err != nil {
+				return doc, err
+			}
+		}
+		l := append(c[:i:i], append([]any{value}, c[i:]...)...)
+		return replaceArray(doc, tokens[:len(tokens)-1], l)
+	default:
+		return doc, fmt.Errorf("%w: can not add %q in a scalar value", ErrConflict, token)
+	}
+}
+
+// remove deletes the value referenced by tokens, and returns the patched
+// document and the removed value.
+func remove(doc any, tokens []string) (any, any, error) {
+	if len(tokens) == 0 {
+		return nil, doc, nil
+	}
+	parent, err := get(doc, tokens[:len(tokens)-1])
+	if err != nil {
+		return doc, nil, err
+	}
+	token := tokens[len(tokens)-1]
+	switch c := parent.(type) {
+	case map[string]any:
+		v, ok := c[token]
+		if !ok {
+			return doc, nil, fmt.Errorf("%w: key %q not found", ErrConflict, token)
+		}
+		delete(c, token)
+		return doc, v, nil
+	case []any:
+		i, err := index(token, len(c)-1)
+		if err != nil {
+			return doc, nil, err
+		}
+		v := c[i]
+		l := append(c[:i:i], c[i+1:]...)
+		doc, err = replaceArray(doc, tokens[:len(tokens)-1], l)
+		return doc, v, err
+	default:
+		return doc, nil, fmt.Errorf("%w: can not remove %q in a scalar value", ErrConflict, token)
+	}
+}
+
+// replaceArray sets the resized array referenced by tokens in its parent,
+// as the slices are not modified in place.
+func replaceArray(doc any, tokens []string, l []any) (any, error) {
+	if len(tokens) == 0 {
+		return l, nil
+	}
+	parent, err := get(doc, tokens[:len(tokens)-1])
+	if err != nil {
+		return doc, err
+	}
+	token := tokens[len(tokens)-1]
+	switch c := parent.(type) {
+	case map[string]any:
+		c[token] = l
+	case []any:
+		i, err := index(token, len(c)-1)
+		if err != nil {
+			return doc, err
+		}
+		c[i] = l
+	}
+	return doc, nil
+}
+
+// index returns the array index of the token, which must not exceed last.
+func index(token string, last int) (int, error) {
+	if token == "-" {
+		return 0, fmt.Errorf("%w: index - references a nonexistent element", ErrConflict)
+	}
+	if len(token) > 1 && token[0] == '0' {
+		return 0, fmt.Errorf("%w: index %q has leading zeros", ErrInvalid, token)
+	}
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 {
+		return 0, fmt.Errorf("%w: invalid index %q", ErrInvalid, token)
+	}
+	if i > last {
+		return 0, fmt.Errorf("%w: index %d out of bounds", ErrConflict, i)
+	}
+	return i, nil
+}
+
+// equal compares json values, ignoring the numeric types differences
+// between the decoded document and the operation value.
+func equal(a, b any) bool {
+	switch a := a.(type) {
+	case map[string]any:
+		b, ok := b.(map[string]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for k, v := range a {
+			if w, ok := b[k]; !ok || !equal(v, w) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		b, ok := b.([]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !equal(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		if fa, ok := toFloat(a); ok {
+			fb, ok := toFloat(b)
+			return ok && fa == fb
+		}
+		return a == b
+	}
+}
+
+func toFloat(v any) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+func deepCopy(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for k, e := range v {
+			m[k] = deepCopy(e)
+		}
+		return m
+	case []any:
+		l := make([]any, len(v))
+		for i, e := range v {
+			l[i] = deepCopy(e)
+		}
+		return l
+	default:
+		return v
+	}
+}
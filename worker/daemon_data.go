@@ -1,32 +1,101 @@
 package worker
 
-import "fmt"
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/opensvc/oc3/daemonstatus"
+)
 
 type (
-	data map[string]any
+	// daemonStatusFormat is a supported daemon status format, with the
+	// constructors of its data providers.
+	daemonStatusFormat struct {
+		daemonstatus.Format
+
+		newProvider func(json.RawMessage) (dataProvider, error)
+
+		// newInstanceProvider is the data provider constructor of the
+		// Instance data, nil if the format has no Instance data
+		newInstanceProvider func(json.RawMessage) (instancer, error)
+	}
 )
 
-func (d data) getString(key string) (s string, err error) {
-	if i, ok := d[key]; !ok {
-		err = fmt.Errorf("invalid schema: no key '%s'", key)
-	} else if s, ok = i.(string); !ok {
-		err = fmt.Errorf("invalid schema: %s not a string", key)
-	} else if len(s) == 0 {
-		err = fmt.Errorf("unexpected empty %s value", key)
+var (
+	// daemonStatusFormats is the registry of the daemon status formats
+	// processed by the worker. The feeder only accepts their versions.
+	daemonStatusFormats = []daemonStatusFormat{
+		{
+			Format: daemonstatus.Format{
+				Name:      "v2",
+				Prefix:    "2.",
+				Type:      reflect.TypeFor[daemonstatus.DataV2](),
+				Instance:  reflect.TypeFor[daemonstatus.InstanceV2](),
+				Objects:   []string{"services", daemonstatus.ObjectKey},
+				Instances: []string{"nodes", daemonstatus.NodeKey, "services", "status", daemonstatus.ObjectKey},
+			},
+			newProvider:         newDaemonDataV2,
+			newInstanceProvider: newInstanceStatusV2,
+		},
+		{
+			Format: daemonstatus.Format{
+				Name:      "v3",
+				Prefix:    "3.",
+				Type:      reflect.TypeFor[daemonstatus.DataV3](),
+				Objects:   []string{"cluster", "object", daemonstatus.ObjectKey},
+				Instances: []string{"cluster", "node", daemonstatus.NodeKey, "instance", daemonstatus.ObjectKey},
+			},
+			newProvider: newDaemonDataV3,
+		},
+		{
+			Format: daemonstatus.Format{
+				Name:      "v4",
+				Prefix:    "4.",
+				Type:      reflect.TypeFor[daemonstatus.DataV4](),
+				Objects:   []string{"objects", daemonstatus.ObjectKey},
+				Instances: []string{"objects", daemonstatus.ObjectKey, "instances", daemonstatus.NodeKey},
+			},
+			newProvider: newDaemonDataV4,
+		},
 	}
-	return
+)
+
+// DaemonStatusFormats returns the daemon status formats of the registry.
+func DaemonStatusFormats() daemonstatus.Formats {
+	l := make(daemonstatus.Formats, len(daemonStatusFormats))
+	for i, f := range daemonStatusFormats {
+		l[i] = f.Format
+	}
+	return l
 }
 
-func (d data) getDictSubKeys(key string) (l []string, err error) {
-	m, ok := d[key].(map[string]any)
-	if !ok {
-		err = fmt.Errorf("invalid schema: no key '%s'", key)
-		return
+// lookupDaemonStatusFormat returns the registry format of the opensvc client
+// version, and false if the version is not supported.
+func lookupDaemonStatusFormat(version string) (daemonStatusFormat, bool) {
+	for _, f := range daemonStatusFormats {
+		if strings.HasPrefix(version, f.Prefix) {
+			return f, true
+		}
 	}
-	for s := range m {
-		l = append(l, s)
+	return daemonStatusFormat{}, false
+}
+
+// valueOrNA returns s, or "n/a" if s is empty.
+func valueOrNA(s string) string {
+	if s == "" {
+		return "n/a"
 	}
-	return
+	return s
+}
+
+// statusGroupOrNA returns the status_group value of key, or "n/a" if the
+// key is not reported.
+func statusGroupOrNA(m map[string]string, key string) string {
+	if s, ok := m[key]; ok {
+		return s
+	}
+	return "n/a"
 }
 
 func mapTo(m map[string]any, k ...string) (any, bool) {
@@ -49,19 +118,6 @@ func mapToA(m map[string]any, defaultValue any, k ...string) any {
 	}
 }
 
-func mapToBool(m map[string]any, defaultValue bool, k ...string) bool {
-	return mapToA(m, defaultValue, k...).(bool)
-}
-
-// mapToBoolS returns "T" or "F"
-func mapToBoolS(m map[string]any, defaultValue bool, k ...string) string {
-	if mapToBool(m, defaultValue, k...) {
-		return "T"
-	} else {
-		return "F"
-	}
-}
-
 func mapToS(m map[string]any, defaultValue string, k ...string) string {
 	return mapToA(m, defaultValue, k...).(string)
 }
@@ -77,7 +133,3 @@ func mapToInt(m map[string]any, defaultValue int, k ...string) int {
 		return defaultValue
 	}
 }
-
-func mapToMap(m map[string]any, defaultValue map[string]any, k ...string) map[string]any {
-	return mapToA(m, defaultValue, k...).(map[string]any)
-}
@@ -11,74 +11,90 @@ type (
 	instanceData struct {
 		cdb.DBInstanceStatus
 
-		encap     map[string]any
-		resources map[string]any
+		encap     map[string]encapData
+		resources map[string]resourceData
 
 		// resourceMonitored map of rid to config resourceMonitored value
 		resourceMonitored map[string]bool
 
+		// encapResourceMonitored map of container rid to encap rid to config
+		// resourceMonitored value, as the encap rids may be the same in
+		// the containers and the instance
+		encapResourceMonitored map[string]map[string]bool
+
 		// fromOutsideStatus is the resource status of self from the parent hypervisor:
 		//    nodes.<parent-hypervisor>.services.status.<svc>.resources.<containerID>.status
 		fromOutsideStatus string
 	}
+
+	// resourceData is an instance resource status, common to the daemon
+	// status data versions.
+	resourceData struct {
+		status   string
+		label    string
+		resType  string
+		disable  bool
+		optional bool
+
+		// log is the resource status log lines
+		log []string
+	}
+
+	// encapData is the status of the encapsulated instance of a container
+	// resource, common to the daemon status data versions.
+	encapData struct {
+		hostname string
+		avail    string
+		overall  string
+		frozen   bool
+
+		// statusGroup is nil if not reported
+		statusGroup map[string]string
+
+		resources map[string]resourceData
+	}
 )
 
 func (i *instanceData) InstanceResources() []*cdb.DBInstanceResource {
 	var l []*cdb.DBInstanceResource
 	for rID, aResource := range i.resources {
-		l = append(l, aToInstanceResource(aResource.(map[string]any), i.SvcID, i.NodeID, "", rID,
+		l = append(l, toInstanceResource(aResource, i.SvcID, i.NodeID, "", rID,
 			i.resourceMonitored[rID]))
 
-		if i.encap != nil {
-			if encapRid, ok := i.encap[rID].(map[string]any); ok {
-				var vmName string
-				if s, ok := encapRid["hostname"].(string); ok {
-					vmName = s
-				}
-				if encapResources, ok := encapRid["resources"].(map[string]any); ok {
-					for encapRID, encapAResource := range encapResources {
-						l = append(l, aToInstanceResource(encapAResource.(map[string]any), i.SvcID, i.NodeID, vmName, encapRID,
-							i.resourceMonitored[encapRID]))
-					}
-				}
+		if encap, ok := i.encap[rID]; ok {
+			for encapRID, encapAResource := range encap.resources {
+				l = append(l, toInstanceResource(encapAResource, i.SvcID, i.NodeID, encap.hostname, encapRID,
+					i.encapResourceMonitored[rID][encapRID]))
 			}
 		}
 	}
 	return l
 }
 
-func aToInstanceResource(a map[string]any, svcID, nodeID, vmName, rID string, monitor bool) *cdb.DBInstanceResource {
+func toInstanceResource(r resourceData, svcID, nodeID, vmName, rID string, monitor bool) *cdb.DBInstanceResource {
 	res := &cdb.DBInstanceResource{
 		SvcID:    svcID,
 		NodeID:   nodeID,
 		VmName:   vmName,
 		RID:      rID,
-		Status:   mapToS(a, "", "status"),
-		Desc:     mapToS(a, "", "label"),
-		Disable:  mapToBoolS(a, false, "disable"),
-		Optional: mapToBoolS(a, false, "optional"),
-		ResType:  mapToS(a, "", "type"),
+		Status:   r.status,
+		Desc:     r.label,
+		Disable:  boolS(r.disable),
+		Optional: boolS(r.optional),
+		ResType:  r.resType,
+		Monitor:  boolS(monitor),
+		Log:      strings.Join(r.log, "\n"),
 	}
-	if monitor {
-		res.Monitor = "T"
+	return res
+}
+
+// boolS returns "T" or "F"
+func boolS(b bool) string {
+	if b {
+		return "T"
 	} else {
-		res.Monitor = "F"
-	}
-	if logs, ok := mapTo(a, "log"); ok {
-		switch l := logs.(type) {
-		case []string:
-			// v2 log entry
-			res.Log = strings.Join(l, "\n")
-		case []map[string]string:
-			// v3 log entry
-			lines := make([]string, 0, len(l))
-			for _, m := range l {
-				lines = append(lines, m["level"]+": "+m["message"])
-			}
-			res.Log = strings.Join(lines, "\n")
-		}
+		return "F"
 	}
-	return res
 }
 
 // Containers returns list of container instanceData that are defined by i.encap.
@@ -94,34 +110,32 @@ func (i *instanceData) Containers() []*instanceData {
 
 // Container returns the container instance status of i from i.encap[id].
 func (i *instanceData) Container(id string) *instanceData {
-	encap := i.encap[id].(map[string]any)
-	if encap == nil {
+	encap, ok := i.encap[id]
+	if !ok {
 		return nil
 	}
 	dbI := cdb.DBInstanceStatus{
-		NodeID: i.NodeID,
-		SvcID:  i.SvcID,
-	}
-	if vmName, ok := encap["hostname"].(string); ok {
-		dbI.MonVmName = vmName
+		NodeID:    i.NodeID,
+		SvcID:     i.SvcID,
+		MonVmName: encap.hostname,
 	}
 	// defines vm type from resources.<container#xx>.type = 'container.podman' -> podman
-	if containerType := strings.SplitN(mapToS(i.resources, "", id, "type"), ".", -1); len(containerType) > 1 {
+	if containerType := strings.SplitN(i.resources[id].resType, ".", -1); len(containerType) > 1 {
 		dbI.MonVmType = containerType[1]
 	}
 	mergeM := hypervisorContainerMergeMap
-	if encapAvail, ok := encap["avail"].(string); ok {
-		dbI.MonAvailStatus = mergeM[i.MonAvailStatus+","+encapAvail]
+	if encap.avail != "" {
+		dbI.MonAvailStatus = mergeM[i.MonAvailStatus+","+encap.avail]
 	} else {
 		dbI.MonAvailStatus = mergeM[i.MonAvailStatus+",n/a"]
 	}
-	if encapOverall, ok := encap["overall"].(string); ok {
-		dbI.MonOverallStatus = mergeM[i.MonOverallStatus+","+encapOverall]
+	if encap.overall != "" {
+		dbI.MonOverallStatus = mergeM[i.MonOverallStatus+","+encap.overall]
 	} else {
 		dbI.MonOverallStatus = mergeM[i.MonOverallStatus+",n/a"]
 	}
 
-	if statusGroup, ok := encap["status_group"].(map[string]string); ok {
+	if statusGroup := encap.statusGroup; statusGroup != nil {
 		dbI.MonIpStatus = mergeM[i.MonIpStatus+","+statusGroup["ip"]]
 		dbI.MonDiskStatus = mergeM[i.MonDiskStatus+","+statusGroup["disk"]]
 		dbI.MonFsStatus = mergeM[i.MonFsStatus+","+statusGroup["fs"]]
@@ -130,7 +144,7 @@ func (i *instanceData) Container(id string) *instanceData {
 		dbI.MonAppStatus = mergeM[i.MonAppStatus+","+statusGroup["app"]]
 		dbI.MonSyncStatus = mergeM[i.MonSyncStatus+","+statusGroup["sync"]]
 	} else {
-		// unexpected status_group map, ignore all encap status_group
+		// no status_group, ignore all encap status_group
 		dbI.MonIpStatus = mergeM[i.MonIpStatus+","]
 		dbI.MonDiskStatus = mergeM[i.MonDiskStatus+","]
 		dbI.MonFsStatus = mergeM[i.MonFsStatus+","]
@@ -146,20 +160,17 @@ func (i *instanceData) Container(id string) *instanceData {
 	//    2: global thawed + encap frozen
 	//    3: global frozen + encap frozen
 
-	encapFrozen, _ := encap["frozen"].(int)
-	switch encapFrozen {
-	case 0:
+	if !encap.frozen {
 		// encap is thawed => frozen result is the hypervisor frozen value
 		dbI.MonFrozen = i.MonFrozen
-	default:
+	} else {
 		// encap is frozen => frozen result is the global frozen value + 2
 		dbI.MonFrozen = i.MonFrozen + 2
 	}
 
-	var nilMap map[string]any
 	return &instanceData{
 		DBInstanceStatus:  dbI,
-		resources:         mapToMap(encap, nilMap, "resources"),
-		fromOutsideStatus: mapToS(i.resources, "", id, "status"),
+		resources:         encap.resources,
+		fromOutsideStatus: i.resources[id].status,
 	}
 }
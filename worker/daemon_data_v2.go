@@ -1,33 +1,47 @@
 package worker
 
 import (
+	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
-	"time"
 
 	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/daemonstatus"
 )
 
 type (
 	daemonDataV2 struct {
-		data data
+		data daemonstatus.DataV2
 	}
 	instanceStatusV2 struct {
-		data map[string]any
+		data *daemonstatus.InstanceV2
 	}
 )
 
+func newDaemonDataV2(b json.RawMessage) (dataProvider, error) {
+	var data daemonstatus.DataV2
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, fmt.Errorf("decode data v2: %w", err)
+	}
+	return &daemonDataV2{data: data}, nil
+}
+
+func newInstanceStatusV2(b json.RawMessage) (instancer, error) {
+	var data daemonstatus.InstanceV2
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, fmt.Errorf("decode data v2: %w", err)
+	}
+	return &instanceStatusV2{data: &data}, nil
+}
+
 func (d *daemonDataV2) nodeNames() (l []string, err error) {
-	return d.data.getDictSubKeys("nodes")
+	return slices.Collect(maps.Keys(d.data.Nodes)), nil
 }
 
 func (d *daemonDataV2) objectNames() (l []string, err error) {
-	var keys []string
-	keys, err = d.data.getDictSubKeys("services")
-	if err != nil {
-		return
-	}
-	for _, s := range keys {
+	for s := range d.data.Services {
 		if s == "cluster" {
 			continue
 		}
@@ -37,94 +51,72 @@ func (d *daemonDataV2) objectNames() (l []string, err error) {
 }
 
 func (d *daemonDataV2) clusterID() (s string, err error) {
-	return d.data.getString("cluster_id")
+	if d.data.ClusterID == "" {
+		return "", fmt.Errorf("unexpected empty cluster_id value")
+	}
+	return d.data.ClusterID, nil
 }
 
 func (d *daemonDataV2) clusterName() (s string, err error) {
-	return d.data.getString("cluster_name")
-}
-
-func (d *daemonDataV2) parseNodeFrozen(i any) string {
-	switch v := i.(type) {
-	case int:
-		if v > 0 {
-			return "T"
-		}
-	case float64:
-		if v > 0 {
-			return "T"
-		}
+	if d.data.ClusterName == "" {
+		return "", fmt.Errorf("unexpected empty cluster_name value")
 	}
-	return "F"
+	return d.data.ClusterName, nil
 }
 
 func (d *daemonDataV2) nodeFrozen(nodename string) (s string, err error) {
-	i, ok := mapTo(d.data, "nodes", nodename, "frozen")
+	node, ok := d.data.Nodes[nodename]
 	if !ok {
 		err = fmt.Errorf("can't retrieve frozen for %s", nodename)
 		return
-	} else {
-		return d.parseNodeFrozen(i), nil
 	}
+	if node.Frozen > 0 {
+		return "T", nil
+	}
+	return "F", nil
 }
 
 func (d *daemonDataV2) nodeHeartbeat(nodename string) ([]heartbeatData, error) {
-	i, ok := mapTo(d.data, "nodes", nodename, "hb")
-	if !ok {
+	node, ok := d.data.Nodes[nodename]
+	if !ok || node.Hb == nil {
 		return nil, fmt.Errorf("data v2 no such key: nodes.%s.hb", nodename)
 	}
-	iM, ok := i.(map[string]any)
-	if !ok {
-		return nil, fmt.Errorf("data v2 unexpected value for key nodes.%s.hb", nodename)
-	}
 	var l []heartbeatData
-	var nilMap map[string]any
-	for name, v := range iM {
-		stream, ok := v.(map[string]any)
-		if !ok {
-			continue
-		}
+	for name, stream := range node.Hb {
 		name = strings.TrimPrefix(name, "hb#")
-		family := mapToS(stream, "", "type")
-		state := mapToS(stream, "", "state")
 
 		// Add entry for the node hb state itself regardless of its peers
 		l = append(l, heartbeatData{
 			DBHeartbeat: cdb.DBHeartbeat{
 				NodeID: "",
-				Driver: family,
+				Driver: stream.Type,
 				Name:   name,
-				State:  state,
+				State:  stream.State,
 			},
 			nodename: nodename,
 		})
 
-		if state != "running" {
+		if stream.State != "running" {
 			continue
 		}
-		for peer, i := range mapToMap(stream, nilMap, "peers") {
-			v, ok := i.(map[string]any)
-			if !ok {
-				continue
-			}
-			if _, ok := mapTo(v, "beating"); !ok {
+		for peer, v := range stream.Peers {
+			if v.Beating == nil {
 				continue
 			}
 			var beating int8
-			if mapToBool(v, false, "beating") {
+			if *v.Beating {
 				beating = 1
 			} else {
 				beating = 2
 			}
-			lastBeating, _ := time.Parse(time.RFC3339Nano, mapToS(v, "", "last_at"))
 			l = append(l, heartbeatData{
 				DBHeartbeat: cdb.DBHeartbeat{
-					Driver:      family,
+					Driver:      stream.Type,
 					Name:        name,
-					State:       state,
+					State:       stream.State,
 					Beating:     beating,
-					Desc:        mapToS(v, "", "desc"),
-					LastBeating: lastBeating,
+					Desc:        v.Desc,
+					LastBeating: v.LastAt,
 				},
 				nodename:     nodename,
 				peerNodename: peer,
@@ -134,66 +126,41 @@ func (d *daemonDataV2) nodeHeartbeat(nodename string) ([]heartbeatData, error) {
 	return l, nil
 }
 
-func (d *daemonDataV2) getFromKeys(keys ...string) (v any, err error) {
-	if v, ok := mapTo(d.data, keys...); !ok {
-		return v, fmt.Errorf("getFromKeys can't expand from %v", keys)
-	} else {
-		return v, nil
-	}
-}
-
 // appFromObjectName returns object app value from nodes object instances status
 func (d *daemonDataV2) appFromObjectName(svcname string, nodes ...string) string {
 	for _, nodename := range nodes {
-		if a, ok := mapTo(d.data, "nodes", nodename, "services", "status", svcname, "app"); ok {
-			if app, ok := a.(string); ok {
-				return app
-			}
+		if i := d.data.Nodes[nodename].Services.Status[svcname]; i != nil && i.App != "" {
+			return i.App
 		}
 	}
 	return ""
 }
 
 func (d *daemonDataV2) objectStatus(objectName string) *cdb.DBObjStatus {
-	if i, ok := mapTo(d.data, "services", objectName); ok && i != nil {
-		if o, ok := i.(map[string]any); ok {
-			oStatus := &cdb.DBObjStatus{
-				AvailStatus:   "n/a",
-				OverallStatus: "n/a",
-				Placement:     "n/a",
-				Frozen:        "n/a",
-				Provisioned:   "n/a",
-			}
-			if s, ok := o["avail"].(string); ok {
-				oStatus.AvailStatus = s
-			}
-			if s, ok := o["overall"].(string); ok {
-				oStatus.OverallStatus = s
-			}
-			if s, ok := o["placement"].(string); ok {
-				oStatus.Placement = s
-			}
-			if s, ok := o["frozen"].(string); ok {
-				oStatus.Frozen = s
-			}
-			if prov, ok := o["provisioned"].(bool); ok {
-				if prov {
-					oStatus.Provisioned = "True"
-				} else {
-					oStatus.Provisioned = "False"
-				}
-			}
-			return oStatus
+	o := d.data.Services[objectName]
+	if o == nil {
+		return nil
+	}
+	oStatus := &cdb.DBObjStatus{
+		AvailStatus:   valueOrNA(o.Avail),
+		OverallStatus: valueOrNA(o.Overall),
+		Placement:     valueOrNA(o.Placement),
+		Frozen:        valueOrNA(o.Frozen),
+		Provisioned:   "n/a",
+	}
+	if prov, ok := o.Provisioned.(bool); ok {
+		if prov {
+			oStatus.Provisioned = "True"
+		} else {
+			oStatus.Provisioned = "False"
 		}
 	}
-	return nil
+	return oStatus
 }
 
 func (d *daemonDataV2) InstanceStatus(objectName string, nodename string) *instanceData {
-	var a map[string]any
-	if i, ok := mapTo(d.data, "nodes", nodename, "services", "status", objectName); !ok {
-		return nil
-	} else if a, ok = i.(map[string]any); !ok {
+	a := d.data.Nodes[nodename].Services.Status[objectName]
+	if a == nil {
 		return nil
 	}
 	iStatus := &instanceStatusV2{data: a}
@@ -201,42 +168,68 @@ func (d *daemonDataV2) InstanceStatus(objectName string, nodename string) *insta
 }
 
 func (d *instanceStatusV2) InstanceStatus(objectName string, nodename string) *instanceData {
-	var a, nilMap map[string]any
-	a = d.data
+	a := d.data
 	instanceStatus := &instanceData{
 		DBInstanceStatus:  cdb.DBInstanceStatus{},
 		resourceMonitored: make(map[string]bool),
 	}
 
-	instanceStatus.MonSmonStatus = mapToS(a, "", "monitor", "status")
-	instanceStatus.MonSmonGlobalExpect = mapToS(a, "", "monitor", "global_expect")
-	instanceStatus.MonAvailStatus = mapToS(a, "", "avail")
-	instanceStatus.MonOverallStatus = mapToS(a, "", "overall")
-	instanceStatus.MonIpStatus = mapToS(a, "n/a", "status_group", "ip")
-	instanceStatus.MonDiskStatus = mapToS(a, "n/a", "status_group", "disk")
-	instanceStatus.MonFsStatus = mapToS(a, "n/a", "status_group", "fs")
-	instanceStatus.MonShareStatus = mapToS(a, "n/a", "status_group", "share")
-	instanceStatus.MonContainerStatus = mapToS(a, "n/a", "status_group", "container")
-	instanceStatus.MonAppStatus = mapToS(a, "n/a", "status_group", "app")
-	instanceStatus.MonSyncStatus = mapToS(a, "n/a", "status_group", "sync")
-	instanceStatus.encap = mapToMap(a, nilMap, "encap")
-	instanceStatus.resources = mapToMap(a, nilMap, "resources")
-
-	switch v := mapToA(a, 0, "frozen").(type) {
-	case int:
-		if v > 0 {
-			instanceStatus.MonFrozen = 1
-		}
-	case float64:
-		if v > 0 {
-			instanceStatus.MonFrozen = 1
-		}
-	default:
+	instanceStatus.MonSmonStatus = a.Monitor.Status
+	instanceStatus.MonSmonGlobalExpect = a.Monitor.GlobalExpect
+	instanceStatus.MonAvailStatus = a.Avail
+	instanceStatus.MonOverallStatus = a.Overall
+	instanceStatus.MonIpStatus = statusGroupOrNA(a.StatusGroup, "ip")
+	instanceStatus.MonDiskStatus = statusGroupOrNA(a.StatusGroup, "disk")
+	instanceStatus.MonFsStatus = statusGroupOrNA(a.StatusGroup, "fs")
+	instanceStatus.MonShareStatus = statusGroupOrNA(a.StatusGroup, "share")
+	instanceStatus.MonContainerStatus = statusGroupOrNA(a.StatusGroup, "container")
+	instanceStatus.MonAppStatus = statusGroupOrNA(a.StatusGroup, "app")
+	instanceStatus.MonSyncStatus = statusGroupOrNA(a.StatusGroup, "sync")
+	instanceStatus.encap = encapFromV2(a.Encap)
+	instanceStatus.resources = resourcesFromV2(a.Resources)
+
+	if a.Frozen > 0 {
 		instanceStatus.MonFrozen = 1
 	}
 
-	for rid := range instanceStatus.resources {
-		instanceStatus.resourceMonitored[rid] = mapToBool(instanceStatus.resources, false, rid, "monitor")
+	for rid, r := range a.Resources {
+		instanceStatus.resourceMonitored[rid] = r.Monitor
 	}
 	return instanceStatus
 }
+
+func encapFromV2(m map[string]daemonstatus.EncapV2) map[string]encapData {
+	if m == nil {
+		return nil
+	}
+	encap := make(map[string]encapData, len(m))
+	for id, e := range m {
+		encap[id] = encapData{
+			hostname:    e.Hostname,
+			avail:       e.Avail,
+			overall:     e.Overall,
+			frozen:      e.Frozen > 0,
+			statusGroup: e.StatusGroup,
+			resources:   resourcesFromV2(e.Resources),
+		}
+	}
+	return encap
+}
+
+func resourcesFromV2(m map[string]daemonstatus.ResourceV2) map[string]resourceData {
+	if m == nil {
+		return nil
+	}
+	resources := make(map[string]resourceData, len(m))
+	for rid, r := range m {
+		resources[rid] = resourceData{
+			status:   r.Status,
+			label:    r.Label,
+			resType:  r.Type,
+			disable:  r.Disable,
+			optional: r.Optional,
+			log:      r.Log,
+		}
+	}
+	return resources
+}
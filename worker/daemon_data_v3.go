@@ -1,27 +1,32 @@
 package worker
 
 import (
+	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
-	"time"
 
 	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/daemonstatus"
 )
 
 type (
 	daemonDataV3 struct {
-		data    data
-		cluster data
+		cluster daemonstatus.ClusterV3
 	}
 )
 
-func (d *daemonDataV3) objectNames() (l []string, err error) {
-	var keys []string
-	keys, err = d.cluster.getDictSubKeys("object")
-	if err != nil {
-		return
+func newDaemonDataV3(b json.RawMessage) (dataProvider, error) {
+	var data daemonstatus.DataV3
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, fmt.Errorf("decode data v3: %w", err)
 	}
-	for _, s := range keys {
+	return &daemonDataV3{cluster: data.Cluster}, nil
+}
+
+func (d *daemonDataV3) objectNames() (l []string, err error) {
+	for s := range d.cluster.Object {
 		if s == "cluster" {
 			continue
 		}
@@ -31,115 +36,76 @@ func (d *daemonDataV3) objectNames() (l []string, err error) {
 }
 
 func (d *daemonDataV3) nodeNames() ([]string, error) {
-	return d.cluster.getDictSubKeys("node")
+	return slices.Collect(maps.Keys(d.cluster.Node)), nil
 }
 
 func (d *daemonDataV3) clusterID() (s string, err error) {
-	if i, ok := mapTo(d.cluster, "config", "id"); ok {
-		if s, ok = i.(string); ok {
-			return
-		}
-		err = fmt.Errorf("data v3 got unexpected cluster id type")
-		return
+	if d.cluster.Config.ID == "" {
+		return "", fmt.Errorf("data v3 unexpected empty cluster.config.id value")
 	}
-	err = fmt.Errorf("data v3 no such key: cluster.id")
-	return
+	return d.cluster.Config.ID, nil
 }
 
 func (d *daemonDataV3) clusterName() (s string, err error) {
-	if i, ok := mapTo(d.cluster, "config", "name"); ok {
-		if s, ok = i.(string); ok {
-			return
-		}
-		err = fmt.Errorf("data v3 got unexpected cluster name type")
-		return
+	if d.cluster.Config.Name == "" {
+		return "", fmt.Errorf("data v3 unexpected empty cluster.config.name value")
 	}
-	err = fmt.Errorf("data v3 no such key: cluster.name")
-	return
+	return d.cluster.Config.Name, nil
 }
 
 func (d *daemonDataV3) nodeFrozen(nodename string) (string, error) {
-	i, ok := mapTo(d.cluster, "node", nodename, "status", "frozen_at")
+	node, ok := d.cluster.Node[nodename]
 	if !ok {
 		return "", fmt.Errorf("data v3 no such key: node.%s.status.frozen_at", nodename)
-	} else {
-		if v, ok := i.(string); ok {
-			if frozenAt, err := time.Parse(time.RFC3339Nano, v); err != nil {
-				return "", fmt.Errorf("data v3 got unexpected node frozen at time: %s frozen_at value %s",
-					nodename, v)
-			} else if frozenAt.After(time.Time{}) {
-				return "T", nil
-			} else {
-				return "F", nil
-			}
-		} else {
-			return "", fmt.Errorf("data v3 got unexpected node frozen at value: %s", nodename)
-		}
 	}
+	if node.Status.FrozenAt.IsZero() {
+		return "F", nil
+	}
+	return "T", nil
 }
 
 func (d *daemonDataV3) nodeHeartbeat(nodename string) ([]heartbeatData, error) {
-	i, ok := mapTo(d.cluster, "node", nodename, "daemon", "heartbeat", "streams")
+	node, ok := d.cluster.Node[nodename]
 	if !ok {
 		return nil, fmt.Errorf("data v3 no such key: node.%s.daemon.heartbeat.streams", nodename)
 	}
-	if i == nil {
-		// no heartbeat streams
-		return nil, nil
-	}
-	iL, ok := i.([]any)
-	if !ok {
-		return nil, fmt.Errorf("data v3 unexpected value for key node.%s.daemon.heartbeat.streams", nodename)
-	}
-	l := make([]heartbeatData, 0, len(iL))
-	var nilMap map[string]any
-	for _, v := range iL {
-		stream, ok := v.(map[string]any)
-		if !ok {
-			continue
-		}
-		name := mapToS(stream, "", "id")
-		if name == "" {
+	streams := node.Daemon.Heartbeat.Streams
+	l := make([]heartbeatData, 0, len(streams))
+	for _, stream := range streams {
+		if stream.ID == "" {
 			return nil, fmt.Errorf("data v3 unexpected empty stream id for key node.%s.daemon.heartbeat.streams", nodename)
 		}
-		name = strings.TrimPrefix(name, "hb#")
-		family := mapToS(stream, "", "type")
-		state := mapToS(stream, "", "state")
+		name := strings.TrimPrefix(stream.ID, "hb#")
 
 		// Add entry for the node hb state itself regardless of its peers
 		l = append(l, heartbeatData{
 			DBHeartbeat: cdb.DBHeartbeat{
 				NodeID: "",
-				Driver: family,
+				Driver: stream.Type,
 				Name:   name,
-				State:  state,
+				State:  stream.State,
 			},
 			nodename: nodename,
 		})
 
-		if state != "running" {
+		if stream.State != "running" {
 			continue
 		}
-		for peer, i := range mapToMap(stream, nilMap, "peers") {
-			v, ok := i.(map[string]any)
-			if !ok {
-				continue
-			}
+		for peer, v := range stream.Peers {
 			var beating int8
-			if mapToBool(v, false, "is_beating") {
+			if v.IsBeating {
 				beating = 1
 			} else {
 				beating = 2
 			}
-			lastBeating, _ := time.Parse(time.RFC3339Nano, mapToS(v, "", "last_at"))
 			l = append(l, heartbeatData{
 				DBHeartbeat: cdb.DBHeartbeat{
-					Driver:      family,
+					Driver:      stream.Type,
 					Name:        name,
-					State:       state,
+					State:       stream.State,
 					Beating:     beating,
-					Desc:        mapToS(v, "", "desc"),
-					LastBeating: lastBeating,
+					Desc:        v.Desc,
+					LastBeating: v.LastAt,
 				},
 				nodename:     nodename,
 				peerNodename: peer,
@@ -151,106 +117,117 @@ func (d *daemonDataV3) nodeHeartbeat(nodename string) ([]heartbeatData, error) {
 
 func (d *daemonDataV3) appFromObjectName(objectName string, nodes ...string) string {
 	for _, nodename := range nodes {
-		if a, ok := mapTo(d.cluster, "node", nodename, "instance", objectName, "config", "app"); ok {
-			if app, ok := a.(string); ok {
-				return app
-			}
+		if i := d.cluster.Node[nodename].Instance[objectName]; i != nil && i.Config != nil && i.Config.App != "" {
+			return i.Config.App
 		}
 	}
 	return ""
 }
 
 func (d *daemonDataV3) objectStatus(objectName string) *cdb.DBObjStatus {
-	if i, ok := mapTo(d.cluster, "object", objectName); ok && i != nil {
-		if o, ok := i.(map[string]any); ok {
-			oStatus := &cdb.DBObjStatus{
-				AvailStatus:   "n/a",
-				OverallStatus: "n/a",
-				Placement:     "n/a",
-				Frozen:        "n/a",
-				Provisioned:   "n/a",
-			}
-			if s, ok := o["avail"].(string); ok {
-				oStatus.AvailStatus = s
-			}
-			if s, ok := o["overall"].(string); ok {
-				oStatus.OverallStatus = s
-			}
-			if s, ok := o["placement_state"].(string); ok {
-				oStatus.Placement = s
-			}
-			if s, ok := o["frozen"].(string); ok {
-				oStatus.Frozen = s
-			}
-			if prov, ok := o["provisioned"].(string); ok {
-				if prov == "true" {
-					oStatus.Provisioned = "True"
-				} else {
-					oStatus.Provisioned = "False"
-				}
-			}
-			return oStatus
-		}
+	o := d.cluster.Object[objectName]
+	if o == nil {
+		return nil
 	}
-	return nil
+	oStatus := &cdb.DBObjStatus{
+		AvailStatus:   valueOrNA(o.Avail),
+		OverallStatus: valueOrNA(o.Overall),
+		Placement:     valueOrNA(o.PlacementState),
+		Frozen:        valueOrNA(o.Frozen),
+		Provisioned:   "n/a",
+	}
+	switch o.Provisioned {
+	case "":
+	case "true":
+		oStatus.Provisioned = "True"
+	default:
+		oStatus.Provisioned = "False"
+	}
+	return oStatus
 }
 
 func (d *daemonDataV3) InstanceStatus(objectName string, nodename string) *instanceData {
-	var config, monitor, status, nilMap map[string]any
-	if i, ok := mapTo(d.cluster, "node", nodename, "instance", objectName, "status"); !ok {
-		return nil
-	} else if status, ok = i.(map[string]any); !ok {
-		return nil
-	}
-
-	if i, ok := mapTo(d.cluster, "node", nodename, "instance", objectName, "monitor"); !ok {
-		return nil
-	} else if monitor, ok = i.(map[string]any); !ok {
-		return nil
-	}
-
-	if i, ok := mapTo(d.cluster, "node", nodename, "instance", objectName, "config"); !ok {
-		return nil
-	} else if config, ok = i.(map[string]any); !ok {
+	i := d.cluster.Node[nodename].Instance[objectName]
+	if i == nil || i.Status == nil || i.Monitor == nil || i.Config == nil {
 		return nil
 	}
+	status := i.Status
 
 	instanceStatus := &instanceData{
 		DBInstanceStatus:  cdb.DBInstanceStatus{},
 		resourceMonitored: make(map[string]bool),
 	}
 
-	instanceStatus.MonAvailStatus = mapToS(status, "", "avail")
+	instanceStatus.MonAvailStatus = status.Avail
 
-	instanceStatus.MonOverallStatus = mapToS(status, "", "overall")
+	instanceStatus.MonOverallStatus = status.Overall
 
 	// TODO: verify v3 encap
-	instanceStatus.encap = mapToMap(status, nilMap, "encap")
+	instanceStatus.encap = encapFromV3(status.Encap)
 
-	instanceStatus.resources = mapToMap(status, nilMap, "resources")
+	instanceStatus.resources = resourcesFromV3(status.Resources)
 
-	if frozenAt, _ := time.Parse(time.RFC3339Nano, status["frozen_at"].(string)); frozenAt.After(time.Time{}) {
+	if !status.FrozenAt.IsZero() {
 		instanceStatus.MonFrozen = 1
-		instanceStatus.MonFrozenAt = frozenAt
+		instanceStatus.MonFrozenAt = status.FrozenAt
 	}
 
 	// TODO: verify defaults
-	instanceStatus.MonSmonStatus = mapToS(monitor, "", "state")
-	instanceStatus.MonSmonGlobalExpect = mapToS(monitor, "", "global_expect")
+	instanceStatus.MonSmonStatus = i.Monitor.State
+	instanceStatus.MonSmonGlobalExpect = i.Monitor.GlobalExpect
 
 	// TODO: status group from v2 (ip/disk/fs/share/container/app/sync)?
-	instanceStatus.MonIpStatus = mapToS(status, "n/a", "status_group", "ip")
-	instanceStatus.MonDiskStatus = mapToS(status, "n/a", "status_group", "disk")
-	instanceStatus.MonFsStatus = mapToS(status, "n/a", "status_group", "fs")
-	instanceStatus.MonShareStatus = mapToS(status, "n/a", "status_group", "share")
-	instanceStatus.MonContainerStatus = mapToS(status, "n/a", "status_group", "container")
-	instanceStatus.MonAppStatus = mapToS(status, "n/a", "status_group", "app")
-	instanceStatus.MonSyncStatus = mapToS(status, "n/a", "status_group", "sync")
+	instanceStatus.MonIpStatus = statusGroupOrNA(status.StatusGroup, "ip")
+	instanceStatus.MonDiskStatus = statusGroupOrNA(status.StatusGroup, "disk")
+	instanceStatus.MonFsStatus = statusGroupOrNA(status.StatusGroup, "fs")
+	instanceStatus.MonShareStatus = statusGroupOrNA(status.StatusGroup, "share")
+	instanceStatus.MonContainerStatus = statusGroupOrNA(status.StatusGroup, "container")
+	instanceStatus.MonAppStatus = statusGroupOrNA(status.StatusGroup, "app")
+	instanceStatus.MonSyncStatus = statusGroupOrNA(status.StatusGroup, "sync")
 
-	configResources := mapToMap(config, nilMap, "resources")
-	for rid := range instanceStatus.resources {
-		instanceStatus.resourceMonitored[rid] = mapToBool(configResources, false, rid, "is_monitored")
+	for rid := range status.Resources {
+		instanceStatus.resourceMonitored[rid] = i.Config.Resources[rid].IsMonitored
 	}
 
 	return instanceStatus
 }
+
+func encapFromV3(m map[string]daemonstatus.EncapV3) map[string]encapData {
+	if m == nil {
+		return nil
+	}
+	encap := make(map[string]encapData, len(m))
+	for id, e := range m {
+		encap[id] = encapData{
+			hostname:    e.Hostname,
+			avail:       e.Avail,
+			overall:     e.Overall,
+			frozen:      !e.FrozenAt.IsZero(),
+			statusGroup: e.StatusGroup,
+			resources:   resourcesFromV3(e.Resources),
+		}
+	}
+	return encap
+}
+
+func resourcesFromV3(m map[string]daemonstatus.ResourceV3) map[string]resourceData {
+	if m == nil {
+		return nil
+	}
+	resources := make(map[string]resourceData, len(m))
+	for rid, r := range m {
+		log := make([]string, 0, len(r.Log))
+		for _, e := range r.Log {
+			log = append(log, e.Level+": "+e.Message)
+		}
+		resources[rid] = resourceData{
+			status:   r.Status,
+			label:    r.Label,
+			resType:  r.Type,
+			disable:  r.Disable,
+			optional: r.Optional,
+			log:      log,
+		}
+	}
+	return resources
+}
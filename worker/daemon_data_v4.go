@@ -0,0 +1,238 @@
+package worker
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/daemonstatus"
+)
+
+type (
+	daemonDataV4 struct {
+		data daemonstatus.DataV4
+	}
+)
+
+func newDaemonDataV4(b json.RawMessage) (dataProvider, error) {
+	var data daemonstatus.DataV4
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, fmt.Errorf("decode data v4: %w", err)
+	}
+	return &daemonDataV4{data: data}, nil
+}
+
+func (d *daemonDataV4) objectNames() (l []string, err error) {
+	for s := range d.data.Objects {
+		if s == "cluster" {
+			continue
+		}
+		l = append(l, s)
+	}
+	return
+}
+
+func (d *daemonDataV4) nodeNames() ([]string, error) {
+	return slices.Collect(maps.Keys(d.data.Nodes)), nil
+}
+
+func (d *daemonDataV4) clusterID() (string, error) {
+	if d.data.Cluster.ID == "" {
+		return "", fmt.Errorf("data v4 unexpected empty cluster.id value")
+	}
+	return d.data.Cluster.ID, nil
+}
+
+func (d *daemonDataV4) clusterName() (string, error) {
+	if d.data.Cluster.Name == "" {
+		return "", fmt.Errorf("data v4 unexpected empty cluster.name value")
+	}
+	return d.data.Cluster.Name, nil
+}
+
+func (d *daemonDataV4) nodeFrozen(nodename string) (string, error) {
+	node := d.data.Nodes[nodename]
+	if node == nil {
+		return "", fmt.Errorf("data v4 no such key: nodes.%s", nodename)
+	}
+	if node.FrozenAt.IsZero() {
+		return "F", nil
+	}
+	return "T", nil
+}
+
+func (d *daemonDataV4) nodeHeartbeat(nodename string) ([]heartbeatData, error) {
+	node := d.data.Nodes[nodename]
+	if node == nil {
+		return nil, fmt.Errorf("data v4 no such key: nodes.%s", nodename)
+	}
+	l := make([]heartbeatData, 0, len(node.Heartbeats))
+	for _, stream := range node.Heartbeats {
+		if stream.ID == "" {
+			return nil, fmt.Errorf("data v4 unexpected empty stream id for key nodes.%s.heartbeats", nodename)
+		}
+		name := strings.TrimPrefix(stream.ID, "hb#")
+
+		// Add entry for the node hb state itself regardless of its peers
+		l = append(l, heartbeatData{
+			DBHeartbeat: cdb.DBHeartbeat{
+				Driver: stream.Type,
+				Name:   name,
+				State:  stream.State,
+			},
+			nodename: nodename,
+		})
+
+		if stream.State != "running" {
+			continue
+		}
+		for peer, v := range stream.Peers {
+			var beating int8
+			if v.IsBeating {
+				beating = 1
+			} else {
+				beating = 2
+			}
+			l = append(l, heartbeatData{
+				DBHeartbeat: cdb.DBHeartbeat{
+					Driver:      stream.Type,
+					Name:        name,
+					State:       stream.State,
+					Beating:     beating,
+					Desc:        v.Desc,
+					LastBeating: v.LastAt,
+				},
+				nodename:     nodename,
+				peerNodename: peer,
+			})
+		}
+	}
+	return l, nil
+}
+
+func (d *daemonDataV4) appFromObjectName(objectName string, nodes ...string) string {
+	o := d.data.Objects[objectName]
+	if o == nil {
+		return ""
+	}
+	for _, nodename := range nodes {
+		if i := o.Instances[nodename]; i != nil && i.App != "" {
+			return i.App
+		}
+	}
+	return ""
+}
+
+func (d *daemonDataV4) objectStatus(objectName string) *cdb.DBObjStatus {
+	o := d.data.Objects[objectName]
+	if o == nil {
+		return nil
+	}
+	oStatus := &cdb.DBObjStatus{
+		AvailStatus:   valueOrNA(o.Avail),
+		OverallStatus: valueOrNA(o.Overall),
+		Placement:     valueOrNA(o.PlacementState),
+		Frozen:        valueOrNA(o.Frozen),
+		Provisioned:   "n/a",
+	}
+	switch o.Provisioned {
+	case "":
+	case "true":
+		oStatus.Provisioned = "True"
+	default:
+		oStatus.Provisioned = "False"
+	}
+	return oStatus
+}
+
+func (d *daemonDataV4) InstanceStatus(objectName string, nodename string) *instanceData {
+	o := d.data.Objects[objectName]
+	if o == nil {
+		return nil
+	}
+	i := o.Instances[nodename]
+	if i == nil {
+		return nil
+	}
+	instanceStatus := &instanceData{
+		DBInstanceStatus:  cdb.DBInstanceStatus{},
+		resourceMonitored: make(map[string]bool),
+	}
+
+	instanceStatus.MonAvailStatus = i.Avail
+	instanceStatus.MonOverallStatus = i.Overall
+	instanceStatus.MonSmonStatus = i.Monitor.State
+	instanceStatus.MonSmonGlobalExpect = i.Monitor.GlobalExpect
+	instanceStatus.MonIpStatus = statusGroupOrNA(i.StatusGroup, "ip")
+	instanceStatus.MonDiskStatus = statusGroupOrNA(i.StatusGroup, "disk")
+	instanceStatus.MonFsStatus = statusGroupOrNA(i.StatusGroup, "fs")
+	instanceStatus.MonShareStatus = statusGroupOrNA(i.StatusGroup, "share")
+	instanceStatus.MonContainerStatus = statusGroupOrNA(i.StatusGroup, "container")
+	instanceStatus.MonAppStatus = statusGroupOrNA(i.StatusGroup, "app")
+	instanceStatus.MonSyncStatus = statusGroupOrNA(i.StatusGroup, "sync")
+	instanceStatus.encap = encapFromV4(i.Encap)
+	instanceStatus.resources = resourcesFromV4(i.Resources)
+
+	if !i.FrozenAt.IsZero() {
+		instanceStatus.MonFrozen = 1
+		instanceStatus.MonFrozenAt = i.FrozenAt
+	}
+
+	for rid, r := range i.Resources {
+		instanceStatus.resourceMonitored[rid] = r.IsMonitored
+	}
+	if len(i.Encap) > 0 {
+		instanceStatus.encapResourceMonitored = make(map[string]map[string]bool, len(i.Encap))
+	}
+	for containerRID, e := range i.Encap {
+		m := make(map[string]bool, len(e.Resources))
+		for rid, r := range e.Resources {
+			m[rid] = r.IsMonitored
+		}
+		instanceStatus.encapResourceMonitored[containerRID] = m
+	}
+	return instanceStatus
+}
+
+func encapFromV4(m map[string]daemonstatus.EncapV4) map[string]encapData {
+	if m == nil {
+		return nil
+	}
+	encap := make(map[string]encapData, len(m))
+	for id, e := range m {
+		encap[id] = encapData{
+			hostname:    e.Hostname,
+			avail:       e.Avail,
+			overall:     e.Overall,
+			frozen:      !e.FrozenAt.IsZero(),
+			statusGroup: e.StatusGroup,
+			resources:   resourcesFromV4(e.Resources),
+		}
+	}
+	return encap
+}
+
+func resourcesFromV4(m map[string]daemonstatus.ResourceV4) map[string]resourceData {
+	if m == nil {
+		return nil
+	}
+	resources := make(map[string]resourceData, len(m))
+	for rid, r := range m {
+		log := make([]string, 0, len(r.Log))
+		for _, e := range r.Log {
+			log = append(log, e.Level+": "+e.Message)
+		}
+		resources[rid] = resourceData{
+			status:   r.Status,
+			label:    r.Label,
+			resType:  r.Type,
+			disable:  r.Disable,
+			optional: r.Optional,
+			log:      log,
+		}
+	}
+	return resources
+}
@@ -0,0 +1,202 @@
+package worker
+
+import (
+	"encoding/json"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/opensvc/oc3/cdb"
+)
+
+func TestDaemonDataV4(t *testing.T) {
+	b, err := os.ReadFile("testdata/daemon_status_v4.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var payload daemonStatusPayload
+	if err := json.Unmarshal(b, &payload); err != nil {
+		t.Fatalf("payload Unmarshal: %s", err)
+	}
+	format, ok := lookupDaemonStatusFormat(payload.Version)
+	if !ok || format.Name != "v4" {
+		t.Fatalf("lookupDaemonStatusFormat %s = %s, %v, want v4", payload.Version, format.Name, ok)
+	}
+	var data map[string]any
+	if err := json.Unmarshal(payload.Data, &data); err != nil {
+		t.Fatalf("data Unmarshal: %s", err)
+	}
+	if err := format.Validate(payload.Version, data).Err(); err != nil {
+		t.Fatalf("Validate: %s", err)
+	}
+	d, err := newDaemonDataV4(payload.Data)
+	if err != nil {
+		t.Fatalf("newDaemonDataV4: %s", err)
+	}
+
+	t.Run("cluster", func(t *testing.T) {
+		if s, err := d.clusterID(); err != nil || s != "4a3b1c2e-7d1f-4c55-9b3a-2f0e6c8d9a11" {
+			t.Errorf("clusterID = %s, %v", s, err)
+		}
+		if s, err := d.clusterName(); err != nil || s != "prd1" {
+			t.Errorf("clusterName = %s, %v", s, err)
+		}
+	})
+
+	t.Run("nodes", func(t *testing.T) {
+		l, err := d.nodeNames()
+		if err != nil {
+			t.Fatalf("nodeNames: %s", err)
+		}
+		if slices.Sort(l); !slices.Equal(l, []string{"n1", "n2"}) {
+			t.Errorf("nodeNames = %v", l)
+		}
+		for nodename, want := range map[string]string{"n1": "F", "n2": "T"} {
+			if got, err := d.nodeFrozen(nodename); err != nil || got != want {
+				t.Errorf("nodeFrozen %s = %s, %v, want %s", nodename, got, err, want)
+			}
+		}
+		if _, err := d.nodeFrozen("n3"); err == nil {
+			t.Errorf("nodeFrozen n3: expected an error")
+		}
+	})
+
+	t.Run("heartbeats", func(t *testing.T) {
+		l, err := d.nodeHeartbeat("n1")
+		if err != nil {
+			t.Fatalf("nodeHeartbeat n1: %s", err)
+		}
+		if len(l) != 4 {
+			t.Fatalf("nodeHeartbeat n1 = %d entries, want 4", len(l))
+		}
+		for _, hb := range l {
+			if hb.nodename != "n1" || hb.Driver != "unicast" || hb.State != "running" {
+				t.Errorf("nodeHeartbeat n1 unexpected %+v", hb)
+			}
+			var want int8
+			switch {
+			case hb.peerNodename == "":
+			case hb.Name == "1.rx":
+				want = 1
+			case hb.Name == "1.tx":
+				want = 2
+			default:
+				t.Errorf("nodeHeartbeat n1 unexpected name %s", hb.Name)
+			}
+			if hb.Beating != want {
+				t.Errorf("nodeHeartbeat n1 %s peer %q beating = %d, want %d", hb.Name, hb.peerNodename, hb.Beating, want)
+			}
+			if hb.peerNodename == "n2" && hb.Name == "1.rx" {
+				if hb.Desc != ":10000 ← n2" || !hb.LastBeating.Equal(time.Date(2026, 3, 2, 10, 11, 13, 902131221, time.UTC)) {
+					t.Errorf("nodeHeartbeat n1 1.rx peer n2 = %+v", hb)
+				}
+			}
+		}
+		l, err = d.nodeHeartbeat("n2")
+		if err != nil {
+			t.Fatalf("nodeHeartbeat n2: %s", err)
+		}
+		if len(l) != 1 || l[0].Name != "1.rx" || l[0].State != "stopped" || l[0].peerNodename != "" {
+			t.Errorf("nodeHeartbeat n2 = %+v, want the stopped 1.rx stream without peers", l)
+		}
+	})
+
+	t.Run("objects", func(t *testing.T) {
+		l, err := d.objectNames()
+		if err != nil {
+			t.Fatalf("objectNames: %s", err)
+		}
+		if slices.Sort(l); !slices.Equal(l, []string{"prd/svc/vm", "prd/svc/web"}) {
+			t.Errorf("objectNames = %v", l)
+		}
+		want := map[string]cdb.DBObjStatus{
+			"prd/svc/web": {AvailStatus: "up", OverallStatus: "warn", Placement: "optimal", Frozen: "thawed", Provisioned: "True"},
+			"prd/svc/vm":  {AvailStatus: "up", OverallStatus: "up", Placement: "optimal", Frozen: "thawed", Provisioned: "False"},
+		}
+		for objectName, w := range want {
+			if got := d.objectStatus(objectName); got == nil || *got != w {
+				t.Errorf("objectStatus %s = %+v, want %+v", objectName, got, w)
+			}
+		}
+		if got := d.objectStatus("prd/svc/foo"); got != nil {
+			t.Errorf("objectStatus prd/svc/foo = %+v, want nil", got)
+		}
+		if got := d.appFromObjectName("prd/svc/web", "n2", "n1"); got != "webapp" {
+			t.Errorf("appFromObjectName prd/svc/web = %s, want webapp", got)
+		}
+	})
+
+	t.Run("instances", func(t *testing.T) {
+		i := d.InstanceStatus("prd/svc/web", "n1")
+		if i == nil {
+			t.Fatalf("InstanceStatus prd/svc/web@n1 is nil")
+		}
+		if i.MonAvailStatus != "up" || i.MonOverallStatus != "warn" || i.MonSmonStatus != "idle" || i.MonSmonGlobalExpect != "none" {
+			t.Errorf("InstanceStatus prd/svc/web@n1 status = %+v", i.DBInstanceStatus)
+		}
+		if i.MonIpStatus != "up" || i.MonFsStatus != "up" || i.MonAppStatus != "warn" || i.MonDiskStatus != "n/a" {
+			t.Errorf("InstanceStatus prd/svc/web@n1 status groups = %+v", i.DBInstanceStatus)
+		}
+		if i.MonFrozen != 0 {
+			t.Errorf("InstanceStatus prd/svc/web@n1 frozen = %d, want 0", i.MonFrozen)
+		}
+
+		i = d.InstanceStatus("prd/svc/web", "n2")
+		if i == nil {
+			t.Fatalf("InstanceStatus prd/svc/web@n2 is nil")
+		}
+		if i.MonFrozen != 1 || !i.MonFrozenAt.Equal(time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)) {
+			t.Errorf("InstanceStatus prd/svc/web@n2 frozen = %d at %s", i.MonFrozen, i.MonFrozenAt)
+		}
+
+		if i := d.InstanceStatus("prd/svc/vm", "n2"); i != nil {
+			t.Errorf("InstanceStatus prd/svc/vm@n2 = %+v, want nil", i)
+		}
+	})
+
+	t.Run("resources", func(t *testing.T) {
+		i := d.InstanceStatus("prd/svc/web", "n1")
+		got := make(map[string]*cdb.DBInstanceResource)
+		for _, r := range i.InstanceResources() {
+			got[r.RID] = r
+		}
+		if r := got["app#1"]; r == nil || r.Status != "warn" || r.ResType != "app.forking" || r.Optional != "T" || r.Monitor != "F" || r.Log != "warn: not running" {
+			t.Errorf("prd/svc/web@n1 app#1 = %+v", r)
+		}
+		if r := got["ip#1"]; r == nil || r.Status != "up" || r.Desc != "10.0.0.10/24 eth0" || r.Monitor != "T" {
+			t.Errorf("prd/svc/web@n1 ip#1 = %+v", r)
+		}
+		if len(got) != 3 {
+			t.Errorf("prd/svc/web@n1 resources = %d, want 3", len(got))
+		}
+	})
+
+	t.Run("encap", func(t *testing.T) {
+		i := d.InstanceStatus("prd/svc/vm", "n1")
+		if i == nil {
+			t.Fatalf("InstanceStatus prd/svc/vm@n1 is nil")
+		}
+		got := make(map[string]string)
+		for _, r := range i.InstanceResources() {
+			got[r.VmName+"/"+r.RID] = r.Monitor
+		}
+		want := map[string]string{
+			"/app#1":       "F",
+			"/container#1": "F",
+			"vm1/app#1":    "T",
+		}
+		if len(got) != len(want) {
+			t.Errorf("prd/svc/vm@n1 resources monitor = %v, want %v", got, want)
+		}
+		for k, v := range want {
+			if got[k] != v {
+				t.Errorf("prd/svc/vm@n1 resource %s monitor = %q, want %q", k, got[k], v)
+			}
+		}
+		l := i.Containers()
+		if len(l) != 1 || l[0].MonVmName != "vm1" || l[0].MonVmType != "kvm" {
+			t.Errorf("prd/svc/vm@n1 containers = %+v", l)
+		}
+	})
+}
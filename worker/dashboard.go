@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/severity"
 )
 
 type (
@@ -11,39 +12,20 @@ type (
 		Type() string
 		Fmt() string
 		Dict() string
-		Severity() int
-	}
-)
 
-const (
-	dashObjObjectDegraded = iota
-	dashObjObjectFlexError
-	dashObjObjectPlacement
-	dashObjObjectUnavailable
-)
-
-var (
-	severity = map[int]map[string]int{
-		dashObjObjectDegraded:    map[string]int{"DEFAULT": 2, "PRD": 3},
-		dashObjObjectFlexError:   map[string]int{"DEFAULT": 5, "PRD": 4},
-		dashObjObjectPlacement:   map[string]int{"DEFAULT": 1},
-		dashObjObjectUnavailable: map[string]int{"DEFAULT": 3, "PRD": 4},
+		// Severity is the built-in severity, before the severity rules
+		Severity() int
 	}
 )
 
-func severityFromEnv(dashType int, objEnv string) int {
-	severityForType := severity[dashType]
-	if severityForType == nil {
-		return 0
+// newDashboardObjectUpdate returns the dashboard update of the object alert,
+// with the severity given by the severity rules, or nil if a rule
+// suppresses the alert.
+func newDashboardObjectUpdate(o *cdb.DBObject, d dashboarder, r *severity.Resolver, tags []string) *cdb.Dashboard {
+	decision := resolveObjectAlert(o, d.Type(), d.Severity(), r, tags)
+	if decision.Suppress {
+		return nil
 	}
-	if v, ok := severityForType[objEnv]; ok {
-		return v
-	} else {
-		return severityForType["DEFAULT"]
-	}
-}
-
-func newDashboardObjectUpdate(o *cdb.DBObject, d dashboarder) *cdb.Dashboard {
 	now := time.Now()
 	return &cdb.Dashboard{
 		ObjectID: o.SvcID,
@@ -51,8 +33,20 @@ func newDashboardObjectUpdate(o *cdb.DBObject, d dashboarder) *cdb.Dashboard {
 		Fmt:      d.Fmt(),
 		Dict:     d.Dict(),
 		Env:      o.Env,
-		Severity: d.Severity(),
+		Severity: decision.Severity,
 		Created:  now,
 		Updated:  now,
 	}
 }
+
+// resolveObjectAlert returns the severity rules decision for the object
+// alert of the type and built-in severity.
+func resolveObjectAlert(o *cdb.DBObject, alertType string, builtin int, r *severity.Resolver, tags []string) severity.Decision {
+	return r.Resolve(severity.Subject{
+		Type:     alertType,
+		Env:      o.Env,
+		App:      o.App,
+		Tags:     tags,
+		Severity: builtin,
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/severity"
 )
 
 type (
@@ -25,5 +26,5 @@ func (d *DashboardObjectPlacement) Dict() string {
 }
 
 func (d *DashboardObjectPlacement) Severity() int {
-	return severityFromEnv(dashObjObjectPlacement, d.obj.Env)
+	return severity.Builtin(d.Type(), d.obj.Env)
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/severity"
 )
 
 type (
@@ -25,5 +26,5 @@ func (d *DashboardObjectUnavailable) Dict() string {
 }
 
 func (d *DashboardObjectUnavailable) Severity() int {
-	return severityFromEnv(dashObjObjectUnavailable, d.obj.Env)
+	return severity.Builtin(d.Type(), d.obj.Env)
 }
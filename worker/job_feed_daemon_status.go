@@ -14,6 +14,7 @@ import (
 
 	"github.com/opensvc/oc3/cachekeys"
 	"github.com/opensvc/oc3/cdb"
+	"github.com/opensvc/oc3/severity"
 	"github.com/opensvc/oc3/util/logkey"
 )
 
@@ -42,6 +43,13 @@ type (
 		clusterName() (s string, err error)
 	}
 
+	// daemonStatusPayload is the posted daemon status, with the data
+	// decoded by the version dataProvider.
+	daemonStatusPayload struct {
+		Version string          `json:"version"`
+		Data    json.RawMessage `json:"data"`
+	}
+
 	dataProvider interface {
 		dataLister
 		clusterer
@@ -66,6 +74,15 @@ type (
 		rawChanges string
 		rawData    []byte
 
+		// incremental is true when the daemon status was only patched since
+		// the last processing: the instances of the objects not touched by
+		// the changes are only pinged.
+		incremental bool
+
+		// touched are the object names of the changes, including the object
+		// names of the instance changes.
+		touched map[string]struct{}
+
 		data dataProvider
 
 		byNodename map[string]*cdb.DBNode
@@ -102,6 +119,7 @@ func newDaemonStatus(nodeID string) *jobFeedDaemonStatus {
 		nodeID: nodeID,
 
 		changes: make(map[string]struct{}),
+		touched: make(map[string]struct{}),
 
 		byNodename: make(map[string]*cdb.DBNode),
 		byNodeID:   make(map[string]*cdb.DBNode),
@@ -168,30 +186,44 @@ func (d *jobFeedDaemonStatus) getChanges(ctx context.Context) error {
 	d.rawChanges = s
 	for _, change := range strings.Fields(s) {
 		d.changes[change] = struct{}{}
+		objectName, _, _ := strings.Cut(change, "@")
+		d.touched[objectName] = struct{}{}
+	}
+	if n, err := d.redis.HDel(ctx, cachekeys.FeedDaemonStatusFullH, d.nodeID).Result(); err != nil {
+		return fmt.Errorf("getChanges: HDEL %s %s: %w", cachekeys.FeedDaemonStatusFullH, d.nodeID, err)
+	} else {
+		d.incremental = n == 0
 	}
 	return nil
 }
 
+// needInstancesUpdate returns true if the object instances need to be
+// updated from the data, false if they only need a ping.
+func (d *jobFeedDaemonStatus) needInstancesUpdate(obj *cdb.DBObject) bool {
+	if !d.incremental || obj.AvailStatus == "undef" {
+		return true
+	}
+	_, ok := d.touched[obj.Svcname]
+	return ok
+}
+
 func (d *jobFeedDaemonStatus) getData(ctx context.Context) error {
 	var (
-		err  error
-		data map[string]any
+		err     error
+		payload daemonStatusPayload
 	)
 	if b, err := d.redis.HGet(ctx, cachekeys.FeedDaemonStatusH, d.nodeID).Bytes(); err != nil {
 		return fmt.Errorf("getData: HGET %s %s: %w", cachekeys.FeedDaemonStatusH, d.nodeID, err)
-	} else if err = json.Unmarshal(b, &data); err != nil {
+	} else if err = json.Unmarshal(b, &payload); err != nil {
 		return fmt.Errorf("getData: unexpected data from %s %s: %w", cachekeys.FeedDaemonStatusH, d.nodeID, err)
 	} else {
 		d.rawData = b
-		var nilMap map[string]any
-		clientVersion := mapToS(data, "", "version")
-		switch {
-		case strings.HasPrefix(clientVersion, "3."):
-			d.data = &daemonDataV3{data: data, cluster: mapToMap(data, nilMap, "data", "cluster")}
-		case strings.HasPrefix(clientVersion, "2."):
-			d.data = &daemonDataV2{data: mapToMap(data, nilMap, "data")}
-		default:
-			return fmt.Errorf("no mapper for version %s", clientVersion)
+		format, ok := lookupDaemonStatusFormat(payload.Version)
+		if !ok {
+			return fmt.Errorf("no mapper for version %s", payload.Version)
+		}
+		if d.data, err = format.newProvider(payload.Data); err != nil {
+			return fmt.Errorf("getData: %s %s: %w", cachekeys.FeedDaemonStatusH, d.nodeID, err)
 		}
 	}
 	if d.clusterID, err = d.data.clusterID(); err != nil {
@@ -279,7 +311,7 @@ func (d *jobFeedDaemonStatus) dbFetchNodes(ctx context.Context) (err error) {
 		return fmt.Errorf("dbFetchNodes %s [%s] can't update node last comm for node ids %s: %w", d.callerNode, d.nodeID, nodeIDs, err)
 	}
 
-	slog.Debug(fmt.Sprintf("handleDaemonStatus run details: %s changes: [%s]", d.callerNode, d.rawChanges))
+	slog.Debug(fmt.Sprintf("handleDaemonStatus run details: %s changes: [%s] incremental: %v", d.callerNode, d.rawChanges, d.incremental))
 	return nil
 }
 
@@ -451,11 +483,13 @@ func (d *jobFeedDaemonStatus) dbFindInstances(ctx context.Context) error {
 	var (
 		objectIDs = make([]string, 0)
 	)
-	if len(d.byObjectID) == 0 {
-		return nil
+	for objectID, obj := range d.byObjectID {
+		if d.needInstancesUpdate(obj) {
+			objectIDs = append(objectIDs, objectID)
+		}
 	}
-	for objectID := range d.byObjectID {
-		objectIDs = append(objectIDs, objectID)
+	if len(objectIDs) == 0 {
+		return nil
 	}
 	instances, err := d.oDb.InstancesFromObjectIDs(ctx, objectIDs...)
 	if err != nil {
@@ -629,11 +663,13 @@ func (d *jobFeedDaemonStatus) dbUpdateInstances(ctx context.Context) error {
 
 	dashboardUpdateObjectFlexStartedL := make([]*cdb.DashboardUpdateObjectFlexStartedParams, 0)
 
-	objectIDL := make([]string, 0, len(d.byInstanceID))
-	for i := range d.byInstanceID {
-		objectIDL = append(objectIDL, i)
+	objectIDL := make([]string, 0, len(d.byObjectID))
+	for i, obj := range d.byObjectID {
+		if d.needInstancesUpdate(obj) {
+			objectIDL = append(objectIDL, i)
+		}
 	}
-	if inAckPeriodL, err := d.oDb.ObjectInAckUnavailabilityPeriod(ctx, d.nodeID); err != nil {
+	if inAckPeriodL, err := d.oDb.ObjectInAckUnavailabilityPeriod(ctx, objectIDL...); err != nil {
 		return fmt.Errorf("dbUpdateInstances ObjectInAckUnavailabilityPeriod: %w", err)
 	} else {
 		for _, i := range inAckPeriodL {
@@ -641,9 +677,24 @@ func (d *jobFeedDaemonStatus) dbUpdateInstances(ctx context.Context) error {
 		}
 	}
 
+	severityResolver := severity.Current()
+	var tagsByObjectID map[string][]string
+	if severityResolver.NeedTags() {
+		var err error
+		if tagsByObjectID, err = d.oDb.ServiceTagNames(ctx, objectIDL...); err != nil {
+			return fmt.Errorf("dbUpdateInstances ServiceTagNames: %w", err)
+		}
+	}
+
 	for objectName, obj := range d.byObjectName {
-		count++
 		objID := obj.SvcID
+		if !d.needInstancesUpdate(obj) {
+			for nodeID := range d.byNodeID {
+				objectIDsToPingByNodeID[nodeID] = append(objectIDsToPingByNodeID[nodeID], objID)
+			}
+			continue
+		}
+		count++
 		instanceMonitorStates := make(map[string]bool)
 		for nodeID, node := range d.byNodeID {
 			if node == nil {
@@ -726,29 +777,38 @@ func (d *jobFeedDaemonStatus) dbUpdateInstances(ctx context.Context) error {
 			dashObj = &DashboardObjectUnavailable{obj: obj}
 			if inAckPeriod || slices.Contains([]string{"up", "n/a"}, obj.AvailStatus) {
 				dotDeleteL = append(dotDeleteL, &cdb.DashboardObjectType{ObjectID: objID, DashType: dashObj.Type()})
+			} else if u := newDashboardObjectUpdate(obj, dashObj, severityResolver, tagsByObjectID[objID]); u == nil {
+				dotDeleteL = append(dotDeleteL, &cdb.DashboardObjectType{ObjectID: objID, DashType: dashObj.Type()})
 			} else {
-				dashboardObjectUpdateL = append(dashboardObjectUpdateL, newDashboardObjectUpdate(obj, dashObj))
+				dashboardObjectUpdateL = append(dashboardObjectUpdateL, u)
 			}
 
 			dashObj = &DashboardObjectPlacement{obj: obj}
 			if inAckPeriod || slices.Contains([]string{"optimal", "n/a"}, obj.Placement) {
 				dotDeleteL = append(dotDeleteL, &cdb.DashboardObjectType{ObjectID: objID, DashType: dashObj.Type()})
+			} else if u := newDashboardObjectUpdate(obj, dashObj, severityResolver, tagsByObjectID[objID]); u == nil {
+				dotDeleteL = append(dotDeleteL, &cdb.DashboardObjectType{ObjectID: objID, DashType: dashObj.Type()})
 			} else {
-				dashboardObjectUpdateL = append(dashboardObjectUpdateL, newDashboardObjectUpdate(obj, dashObj))
+				dashboardObjectUpdateL = append(dashboardObjectUpdateL, u)
 			}
 
 			dashObj = &DashboardObjectDegraded{obj: obj}
 			if inAckPeriod || (slices.Contains([]string{"up", "n/a"}, obj.AvailStatus) && slices.Contains([]string{"up", "n/a"}, obj.OverallStatus)) {
 				dotDeleteL = append(dotDeleteL, &cdb.DashboardObjectType{ObjectID: objID, DashType: dashObj.Type()})
+			} else if u := newDashboardObjectUpdate(obj, dashObj, severityResolver, tagsByObjectID[objID]); u == nil {
+				dotDeleteL = append(dotDeleteL, &cdb.DashboardObjectType{ObjectID: objID, DashType: dashObj.Type()})
 			} else {
-				dashboardObjectUpdateL = append(dashboardObjectUpdateL, newDashboardObjectUpdate(obj, dashObj))
+				dashboardObjectUpdateL = append(dashboardObjectUpdateL, u)
 			}
 
-			dashboardUpdateObjectFlexStartedL = append(dashboardUpdateObjectFlexStartedL, &cdb.DashboardUpdateObjectFlexStartedParams{
-				SvcID: objID,
-				Sev:   severityFromEnv(dashObjObjectFlexError, obj.Env),
-				Env:   obj.Env,
-			})
+			flexDecision := resolveObjectAlert(obj, "flex error", severity.Builtin("flex error", obj.Env), severityResolver, tagsByObjectID[objID])
+			if !flexDecision.Suppress {
+				dashboardUpdateObjectFlexStartedL = append(dashboardUpdateObjectFlexStartedL, &cdb.DashboardUpdateObjectFlexStartedParams{
+					SvcID: objID,
+					Sev:   flexDecision.Severity,
+					Env:   obj.Env,
+				})
+			}
 
 			// Dropped feature: update_dash_flex_cpu
 		}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
-	"strings"
 
 	"github.com/opensvc/oc3/cachekeys"
 	"github.com/opensvc/oc3/cdb"
@@ -77,21 +76,20 @@ func (d *jobFeedInstanceStatus) Operations() []operation {
 
 func (d *jobFeedInstanceStatus) getData(ctx context.Context) error {
 	var (
-		data map[string]any
+		payload daemonStatusPayload
 	)
 	if b, err := d.redis.HGet(ctx, cachekeys.FeedInstanceStatusH, d.idX).Bytes(); err != nil {
 		return fmt.Errorf("getData: HGET %s %s: %w", cachekeys.FeedInstanceStatusH, d.idX, err)
-	} else if err = json.Unmarshal(b, &data); err != nil {
+	} else if err = json.Unmarshal(b, &payload); err != nil {
 		return fmt.Errorf("getData: unexpected data from %s %s: %w", cachekeys.FeedInstanceStatusH, d.idX, err)
 	} else {
 		d.rawData = b
-		var nilMap map[string]any
-		clientVersion := mapToS(data, "", "version")
-		switch {
-		case strings.HasPrefix(clientVersion, "2."):
-			d.data = &instanceStatusV2{data: mapToMap(data, nilMap, "data")}
-		default:
-			return fmt.Errorf("no mapper for version %s", clientVersion)
+		format, ok := lookupDaemonStatusFormat(payload.Version)
+		if !ok || format.newInstanceProvider == nil {
+			return fmt.Errorf("no mapper for version %s", payload.Version)
+		}
+		if d.data, err = format.newInstanceProvider(payload.Data); err != nil {
+			return fmt.Errorf("getData: %s %s: %w", cachekeys.FeedInstanceStatusH, d.idX, err)
 		}
 	}
 
@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strconv"
+	"time"
 
 	"github.com/go-redis/redis/v8"
 
@@ -19,9 +22,13 @@ type (
 		JobBase
 		JobRedis
 		JobDB
+		JobEv
 
 		nodeID string
 		data   map[string]any
+
+		// propertyChanges are the node properties changed by the feed
+		propertyChanges []cdb.NodePropertyChange
 	}
 )
 
@@ -59,7 +66,9 @@ func (d *jobFeedSystem) Operations() []operation {
 		{name: "hba", do: d.hba, condition: hasProp("hba"), blocking: true},
 		{name: "targets", do: d.targets, condition: hasProp("targets"), blocking: true},
 		{name: "package", do: d.pkg, condition: hasProp("package"), blocking: true},
+		{name: "patches", do: d.patches, condition: hasProp("patches"), blocking: true},
 		{name: "pushFromTableChanges", do: d.pushFromTableChanges},
+		{name: "pushPropertyChanges", do: d.pushPropertyChanges, condition: func() bool { return len(d.propertyChanges) > 0 }},
 	}
 }
 
@@ -119,6 +128,59 @@ func (d *jobFeedSystem) pkg(ctx context.Context) error {
 	return nil
 }
 
+func (d *jobFeedSystem) patches(ctx context.Context) error {
+	const tableName = "patches"
+	patchList, ok := d.data["patches"].([]any)
+	if !ok {
+		slog.Warn("unsupported json format for patches")
+		return nil
+	}
+	nodeID := d.nodeID
+	now := d.now
+
+	for i := range patchList {
+		line, ok := patchList[i].(map[string]any)
+		if !ok {
+			slog.Warn("unsupported patch entry format")
+			return nil
+		}
+		if _, ok := line["installed_at"]; !ok {
+			// optional, stored as NULL
+			line["installed_at"] = nil
+		}
+		line["node_id"] = nodeID
+		line["patch_updated"] = now
+		patchList[i] = line
+	}
+
+	request := mariadb.InsertOrUpdate{
+		Table: tableName,
+		Mappings: mariadb.Mappings{
+			mariadb.Mapping{To: "node_id"},
+			mariadb.Mapping{To: "patch_updated"},
+			mariadb.Mapping{To: "patch_num", From: "name"},
+			mariadb.Mapping{To: "patch_rev", From: "revision"},
+			mariadb.Mapping{To: "patch_install_date", From: "installed_at", Modify: mariadb.ModifyFromRFC3339},
+		},
+		Keys: []string{"node_id", "patch_num", "patch_rev"},
+		Data: patchList,
+	}
+
+	if count, err := request.ExecContextAndCountRowsAffected(ctx, d.db); err != nil {
+		return err
+	} else if count > 0 {
+		d.oDb.Session.SetChanges(request.Table)
+	}
+
+	if count, err := d.oDb.ExecContextAndCountRowsAffected(ctx, "DELETE FROM patches WHERE node_id = ? AND patch_updated < ?", nodeID, now); err != nil {
+		return err
+	} else if count > 0 {
+		d.oDb.Session.SetChanges(tableName)
+	}
+
+	return nil
+}
+
 func (d *jobFeedSystem) targets(ctx context.Context) error {
 	const tableName = "stor_zone"
 	data, ok := d.data["targets"].([]any)
@@ -500,12 +562,118 @@ func (d *jobFeedSystem) properties(ctx context.Context) error {
 		Data: data,
 	}
 
+	// the tracked properties are compared as formatted by the database.
+	var props []string
+	for _, m := range request.Mappings {
+		switch m.To {
+		case "node_id", "updated", "action_type":
+		default:
+			props = append(props, m.To)
+		}
+	}
+	before, err := d.oDb.NodeProperties(ctx, nodeID, props)
+	if err != nil {
+		return err
+	}
+
 	if count, err := request.ExecContextAndCountRowsAffected(ctx, d.db); err != nil {
 		return err
 	} else if count > 0 {
 		d.oDb.Session.SetChanges(tableName)
 	}
 
+	if before == nil {
+		// new node, nothing to compare with
+		return nil
+	}
+
+	// The loaded row holds the values of the properties fed unchanged.
+	// Only the properties whose fed value differs from the loaded one are
+	// read back, to compare them as formatted by the database.
+	var changed []string
+	for _, m := range request.Mappings {
+		if !slices.Contains(props, m.To) {
+			continue
+		}
+		v, ok := data[m.To]
+		if !ok {
+			// optional property not fed, unchanged
+			continue
+		}
+		if s, ok := propertyText(m, v); ok && equalNullString(s, before[m.To]) {
+			continue
+		}
+		changed = append(changed, m.To)
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+	after, err := d.oDb.NodeProperties(ctx, nodeID, changed)
+	if err != nil {
+		return err
+	}
+	changes := cdb.DiffNodeProperties(changed, before, after)
+	if err := d.oDb.InsertNodePropertyChanges(ctx, nodeID, now, changes); err != nil {
+		return err
+	}
+	d.propertyChanges = changes
+	return nil
+}
+
+// propertyText returns the text of the fed property value v stored by the
+// mapping m, and false if the text can't be predicted.
+func propertyText(m mariadb.Mapping, v any) (*string, bool) {
+	if m.Get != nil {
+		var err error
+		if v, err = m.Get(v); err != nil {
+			return nil, false
+		}
+	}
+	if m.Modify != nil {
+		_, values, err := m.Modify(v)
+		if err != nil || len(values) != 1 {
+			return nil, false
+		}
+		v = values[0]
+	}
+	var s string
+	switch v := v.(type) {
+	case nil:
+		return nil, true
+	case string:
+		s = v
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	case bool, int, int64:
+		s = fmt.Sprint(v)
+	case time.Time:
+		s = v.Format(time.DateTime)
+	default:
+		return nil, false
+	}
+	return &s, true
+}
+
+func equalNullString(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// pushPropertyChanges publishes the node_property_change event with the
+// node property changes.
+func (d *jobFeedSystem) pushPropertyChanges(_ context.Context) error {
+	if d.ev == nil {
+		return fmt.Errorf("pushPropertyChanges: eventPublisher is not configured")
+	}
+	data := map[string]any{
+		"node_id": d.nodeID,
+		"changes": d.propertyChanges,
+	}
+	if err := d.ev.EventPublish("node_property_change", data); err != nil {
+		return fmt.Errorf("EventPublish send node_property_change: %w", err)
+	}
 	return nil
 }
 
@@ -532,6 +700,7 @@ func (d *jobFeedSystem) getData(ctx context.Context) error {
 		case "hba":
 		case "targets":
 		case "package":
+		case "patches":
 		default:
 			// TODO: add metrics
 			slog.Debug(fmt.Sprintf("parse data: ignore key '%s'", k))